@@ -0,0 +1,251 @@
+package sdk
+
+import (
+	"sync"
+
+	"github.com/control-monkey/controlmonkey-sdk-go/controlmonkey"
+	"github.com/control-monkey/controlmonkey-sdk-go/controlmonkey/session"
+	"github.com/control-monkey/controlmonkey-sdk-go/services/blueprint"
+	"github.com/control-monkey/controlmonkey-sdk-go/services/control_policy"
+	"github.com/control-monkey/controlmonkey-sdk-go/services/control_policy_group"
+	"github.com/control-monkey/controlmonkey-sdk-go/services/custom_abac_configuration"
+	"github.com/control-monkey/controlmonkey-sdk-go/services/custom_role"
+	"github.com/control-monkey/controlmonkey-sdk-go/services/disaster_recovery"
+	"github.com/control-monkey/controlmonkey-sdk-go/services/external_credentials"
+	"github.com/control-monkey/controlmonkey-sdk-go/services/namespace"
+	"github.com/control-monkey/controlmonkey-sdk-go/services/namespace_permissions"
+	"github.com/control-monkey/controlmonkey-sdk-go/services/notification"
+	"github.com/control-monkey/controlmonkey-sdk-go/services/organization"
+	"github.com/control-monkey/controlmonkey-sdk-go/services/run_task"
+	"github.com/control-monkey/controlmonkey-sdk-go/services/stack"
+	"github.com/control-monkey/controlmonkey-sdk-go/services/stack_discovery_configuration"
+	"github.com/control-monkey/controlmonkey-sdk-go/services/team"
+	"github.com/control-monkey/controlmonkey-sdk-go/services/template"
+	"github.com/control-monkey/controlmonkey-sdk-go/services/variable"
+)
+
+// ServiceName identifies a service of the Client, and is the name of the
+// package implementing it.
+type ServiceName string
+
+// Names of the services of the Client.
+const (
+	ServiceBlueprint                   ServiceName = "blueprint"
+	ServiceControlPolicy               ServiceName = "control_policy"
+	ServiceControlPolicyGroup          ServiceName = "control_policy_group"
+	ServiceCustomAbacConfiguration     ServiceName = "custom_abac_configuration"
+	ServiceCustomRole                  ServiceName = "custom_role"
+	ServiceDisasterRecovery            ServiceName = "disaster_recovery"
+	ServiceExternalCredentials         ServiceName = "external_credentials"
+	ServiceNamespace                   ServiceName = "namespace"
+	ServiceNamespacePermissions        ServiceName = "namespace_permissions"
+	ServiceNotification                ServiceName = "notification"
+	ServiceOrganization                ServiceName = "organization"
+	ServiceRunTask                     ServiceName = "run_task"
+	ServiceStack                       ServiceName = "stack"
+	ServiceStackDiscoveryConfiguration ServiceName = "stack_discovery_configuration"
+	ServiceTeam                        ServiceName = "team"
+	ServiceTemplate                    ServiceName = "template"
+	ServiceVariable                    ServiceName = "variable"
+)
+
+// Client bundles the clients of every service of the ControlMonkey API. The
+// service clients are created on first use from a single Session, so they
+// share its HTTP client, credentials and middlewares.
+//
+// Example:
+//
+//	c := sdk.New(session.New())
+//	stacks, err := c.Stacks().ListStacks(ctx, nil, nil, nil)
+//
+// A Client is safe for concurrent use once configured.
+type Client struct {
+	session   *session.Session
+	cfgs      []*controlmonkey.Config
+	overrides map[ServiceName][]*controlmonkey.Config
+
+	blueprints                   lazy[blueprint.Service]
+	controlPolicies              lazy[control_policy.Service]
+	controlPolicyGroups          lazy[control_policy_group.Service]
+	customAbacConfigurations     lazy[custom_abac_configuration.Service]
+	customRoles                  lazy[custom_role.Service]
+	disasterRecovery             lazy[disaster_recovery.Service]
+	externalCredentials          lazy[external_credentials.Service]
+	namespaces                   lazy[namespace.Service]
+	namespacePermissions         lazy[namespace_permissions.Service]
+	notifications                lazy[notification.Service]
+	organization                 lazy[organization.Service]
+	runTasks                     lazy[run_task.Service]
+	stacks                       lazy[stack.Service]
+	stackDiscoveryConfigurations lazy[stack_discovery_configuration.Service]
+	teams                        lazy[team.Service]
+	templates                    lazy[template.Service]
+	variables                    lazy[variable.Service]
+}
+
+// New creates a new instance of Client. If sess is nil, a Session with the
+// default configuration is used. Optional controlmonkey.Config values apply
+// to every service.
+func New(sess *session.Session, cfgs ...*controlmonkey.Config) *Client {
+	if sess == nil {
+		sess = session.New()
+	}
+	return &Client{
+		session:   sess,
+		cfgs:      cfgs,
+		overrides: make(map[ServiceName][]*controlmonkey.Config),
+	}
+}
+
+// Session returns the Session the service clients are created from.
+func (c *Client) Session() *session.Session {
+	return c.session
+}
+
+// WithServiceConfig sets configuration overrides for a single service,
+// merged on top of the configuration given to New. It must be called before
+// the service is first used, and is not safe for concurrent use.
+func (c *Client) WithServiceConfig(name ServiceName, cfgs ...*controlmonkey.Config) *Client {
+	c.overrides[name] = append(c.overrides[name], cfgs...)
+	return c
+}
+
+// configs returns the configuration of a service.
+func (c *Client) configs(name ServiceName) []*controlmonkey.Config {
+	cfgs := make([]*controlmonkey.Config, 0, len(c.cfgs)+len(c.overrides[name]))
+	cfgs = append(cfgs, c.cfgs...)
+	return append(cfgs, c.overrides[name]...)
+}
+
+// Blueprints returns the client of the blueprint service.
+func (c *Client) Blueprints() blueprint.Service {
+	return c.blueprints.get(func() blueprint.Service {
+		return blueprint.New(c.session, c.configs(ServiceBlueprint)...)
+	})
+}
+
+// ControlPolicies returns the client of the control_policy service.
+func (c *Client) ControlPolicies() control_policy.Service {
+	return c.controlPolicies.get(func() control_policy.Service {
+		return control_policy.New(c.session, c.configs(ServiceControlPolicy)...)
+	})
+}
+
+// ControlPolicyGroups returns the client of the control_policy_group service.
+func (c *Client) ControlPolicyGroups() control_policy_group.Service {
+	return c.controlPolicyGroups.get(func() control_policy_group.Service {
+		return control_policy_group.New(c.session, c.configs(ServiceControlPolicyGroup)...)
+	})
+}
+
+// CustomAbacConfigurations returns the client of the
+// custom_abac_configuration service.
+func (c *Client) CustomAbacConfigurations() custom_abac_configuration.Service {
+	return c.customAbacConfigurations.get(func() custom_abac_configuration.Service {
+		return custom_abac_configuration.New(c.session, c.configs(ServiceCustomAbacConfiguration)...)
+	})
+}
+
+// CustomRoles returns the client of the custom_role service.
+func (c *Client) CustomRoles() custom_role.Service {
+	return c.customRoles.get(func() custom_role.Service {
+		return custom_role.New(c.session, c.configs(ServiceCustomRole)...)
+	})
+}
+
+// DisasterRecovery returns the client of the disaster_recovery service.
+func (c *Client) DisasterRecovery() disaster_recovery.Service {
+	return c.disasterRecovery.get(func() disaster_recovery.Service {
+		return disaster_recovery.New(c.session, c.configs(ServiceDisasterRecovery)...)
+	})
+}
+
+// ExternalCredentials returns the client of the external_credentials service.
+func (c *Client) ExternalCredentials() external_credentials.Service {
+	return c.externalCredentials.get(func() external_credentials.Service {
+		return external_credentials.New(c.session, c.configs(ServiceExternalCredentials)...)
+	})
+}
+
+// Namespaces returns the client of the namespace service.
+func (c *Client) Namespaces() namespace.Service {
+	return c.namespaces.get(func() namespace.Service {
+		return namespace.New(c.session, c.configs(ServiceNamespace)...)
+	})
+}
+
+// NamespacePermissions returns the client of the namespace_permissions
+// service.
+func (c *Client) NamespacePermissions() namespace_permissions.Service {
+	return c.namespacePermissions.get(func() namespace_permissions.Service {
+		return namespace_permissions.New(c.session, c.configs(ServiceNamespacePermissions)...)
+	})
+}
+
+// Notifications returns the client of the notification service.
+func (c *Client) Notifications() notification.Service {
+	return c.notifications.get(func() notification.Service {
+		return notification.New(c.session, c.configs(ServiceNotification)...)
+	})
+}
+
+// Organization returns the client of the organization service.
+func (c *Client) Organization() organization.Service {
+	return c.organization.get(func() organization.Service {
+		return organization.New(c.session, c.configs(ServiceOrganization)...)
+	})
+}
+
+// RunTasks returns the client of the run_task service.
+func (c *Client) RunTasks() run_task.Service {
+	return c.runTasks.get(func() run_task.Service {
+		return run_task.New(c.session, c.configs(ServiceRunTask)...)
+	})
+}
+
+// Stacks returns the client of the stack service.
+func (c *Client) Stacks() stack.Service {
+	return c.stacks.get(func() stack.Service {
+		return stack.New(c.session, c.configs(ServiceStack)...)
+	})
+}
+
+// StackDiscoveryConfigurations returns the client of the
+// stack_discovery_configuration service.
+func (c *Client) StackDiscoveryConfigurations() stack_discovery_configuration.Service {
+	return c.stackDiscoveryConfigurations.get(func() stack_discovery_configuration.Service {
+		return stack_discovery_configuration.New(c.session, c.configs(ServiceStackDiscoveryConfiguration)...)
+	})
+}
+
+// Teams returns the client of the team service.
+func (c *Client) Teams() team.Service {
+	return c.teams.get(func() team.Service {
+		return team.New(c.session, c.configs(ServiceTeam)...)
+	})
+}
+
+// Templates returns the client of the template service.
+func (c *Client) Templates() template.Service {
+	return c.templates.get(func() template.Service {
+		return template.New(c.session, c.configs(ServiceTemplate)...)
+	})
+}
+
+// Variables returns the client of the variable service.
+func (c *Client) Variables() variable.Service {
+	return c.variables.get(func() variable.Service {
+		return variable.New(c.session, c.configs(ServiceVariable)...)
+	})
+}
+
+// lazy holds a value created on first use.
+type lazy[T any] struct {
+	once sync.Once
+	v    T
+}
+
+func (l *lazy[T]) get(newFn func() T) T {
+	l.once.Do(func() { l.v = newFn() })
+	return l.v
+}
@@ -0,0 +1,152 @@
+package sdk_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	sdk "github.com/control-monkey/controlmonkey-sdk-go"
+	"github.com/control-monkey/controlmonkey-sdk-go/controlmonkey"
+	"github.com/control-monkey/controlmonkey-sdk-go/controlmonkey/client"
+	"github.com/control-monkey/controlmonkey-sdk-go/controlmonkey/credentials"
+	"github.com/control-monkey/controlmonkey-sdk-go/controlmonkey/session"
+	"github.com/control-monkey/controlmonkey-sdk-go/controlmonkey/testing/fakeapi"
+	"github.com/control-monkey/controlmonkey-sdk-go/services/namespace"
+	"github.com/control-monkey/controlmonkey-sdk-go/services/stack"
+)
+
+func TestClientLazyServices(t *testing.T) {
+	c := sdk.New(nil)
+	if c.Session() == nil {
+		t.Fatal("want: default session, got: nil")
+	}
+
+	var wg sync.WaitGroup
+	stacks := make([]stack.Service, 8)
+	for i := range stacks {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			stacks[i] = c.Stacks()
+		}(i)
+	}
+	wg.Wait()
+
+	for _, s := range stacks[1:] {
+		if s != stacks[0] {
+			t.Fatalf("want: a single stack client, got: %p and %p", stacks[0], s)
+		}
+	}
+}
+
+func TestClient(t *testing.T) {
+	ctx := context.Background()
+	fake := fakeapi.NewServer(fakeapi.WithToken("token"))
+	defer fake.Close()
+
+	c := sdk.New(session.New(controlmonkey.DefaultConfig().
+		WithBaseURL(fake.URL).
+		WithCredentials(credentials.NewStaticCredentials("token"))))
+
+	ns, err := c.Namespaces().CreateNamespace(ctx, &namespace.Namespace{Name: controlmonkey.String("dev")})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	_, err = c.Stacks().CreateStack(ctx, &stack.Stack{
+		Name:        controlmonkey.String("app"),
+		NamespaceId: ns.ID,
+		IacType:     controlmonkey.String("terraform"),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	list, err := c.Stacks().ListStacks(ctx, nil, nil, ns.ID)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(list) != 1 {
+		t.Errorf("want: 1 stack, got: %d", len(list))
+	}
+}
+
+func TestServiceConfigIsolation(t *testing.T) {
+	ctx := context.Background()
+	fake1, fake2 := fakeapi.NewServer(), fakeapi.NewServer(fakeapi.WithToken("other"))
+	defer fake1.Close()
+	defer fake2.Close()
+
+	sess := session.New(controlmonkey.DefaultConfig().
+		WithBaseURL(fake1.URL).
+		WithCredentials(credentials.NewStaticCredentials("token")))
+	baseURL := sess.Config.BaseURL.String()
+
+	c := sdk.New(sess).WithServiceConfig(sdk.ServiceNamespace, new(controlmonkey.Config).
+		WithBaseURL(fake2.URL).
+		WithCredentials(credentials.NewStaticCredentials("other")))
+
+	in := &namespace.Namespace{Name: controlmonkey.String("dev")}
+	if _, err := c.Namespaces().CreateNamespace(ctx, in); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := namespace.New(sess).CreateNamespace(ctx, in); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	tests := map[string]struct {
+		fake *fakeapi.Server
+		want int
+	}{
+		"session":  {fake: fake1, want: 1},
+		"override": {fake: fake2, want: 1},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := len(tc.fake.Items("/namespace")); got != tc.want {
+				t.Errorf("want: %d namespaces, got: %d", tc.want, got)
+			}
+		})
+	}
+
+	if got := sess.Config.BaseURL.String(); got != baseURL {
+		t.Errorf("want: session base URL %s, got: %s", baseURL, got)
+	}
+}
+
+func TestClientEmptyResponse(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"response":{"items":[]}}`)
+	}))
+	defer srv.Close()
+
+	c := sdk.New(session.New(controlmonkey.DefaultConfig().
+		WithBaseURL(srv.URL).
+		WithCredentials(credentials.NewStaticCredentials("token"))))
+
+	tests := map[string]func(context.Context) (interface{}, error){
+		"stack.ReadStack": func(ctx context.Context) (interface{}, error) {
+			return c.Stacks().ReadStack(ctx, "stk-1")
+		},
+		"stack.CreatePlan": func(ctx context.Context) (interface{}, error) {
+			return c.Stacks().CreatePlan(ctx, &stack.CreatePlanInput{StackId: controlmonkey.String("stk-1")})
+		},
+		"organization.ReadOrgConfiguration": func(ctx context.Context) (interface{}, error) {
+			return c.Organization().ReadOrgConfiguration(ctx)
+		},
+	}
+
+	for name, call := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := call(context.Background())
+			if !errors.Is(err, client.ErrEmptyResponse) {
+				t.Errorf("want: %v, got: %v", client.ErrEmptyResponse, err)
+			}
+		})
+	}
+}
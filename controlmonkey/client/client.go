@@ -2,11 +2,13 @@ package client
 
 import (
 	"context"
+	"io"
 	"net/http"
-	"net/http/httputil"
 	"net/url"
+	"time"
 
 	"github.com/control-monkey/controlmonkey-sdk-go/controlmonkey"
+	"github.com/control-monkey/controlmonkey-sdk-go/controlmonkey/credentials"
 )
 
 // Client provides a client to the API.
@@ -39,47 +41,235 @@ func (c *Client) Do(ctx context.Context, r *Request) (*http.Response, error) {
 }
 
 // Do2 runs a request with our client.
-func (c *Client) Do2(ctx context.Context, r *Request, shouldWrapWithEntity bool) (*http.Response, error) {
-	req, err := r.toHTTP(ctx, c.config, shouldWrapWithEntity)
+//
+// Failed attempts are retried according to the configured RetryPolicy. The
+// request body is rewound before each retry, and waiting between attempts is
+// interrupted as soon as ctx is done. When a RateLimiter is configured, every
+// attempt waits for it, and rate limited responses pause it for as long as
+// the API requested. Configured middlewares wrap every attempt, and the
+// configured Instrumentation observes the whole operation. Requests are not
+// sent when the config is invalid; see controlmonkey.Config.Validate.
+//
+// The request options carried by ctx, if any, are honoured; see
+// controlmonkey.WithRequestOptions. The idempotency key and the capture are
+// claimed by the request, so they are not reused by later requests sent with
+// ctx.
+//
+// When the API rejects the credentials, they are refreshed and the request
+// is sent once more with the new token, if any, without counting as a new
+// attempt.
+//
+// When ctx has no deadline, the operation times out after the timeout set by
+// the config for it, in which case a TimeoutError is returned. The timeout
+// also covers reading the response body, and is released once it is closed.
+func (c *Client) Do2(ctx context.Context, r *Request, shouldWrapWithEntity bool) (resp *http.Response, err error) {
+	ctx, cancel := c.withTimeout(ctx, r.Operation)
+	defer func() {
+		if err != nil || resp == nil || resp.Body == nil {
+			cancel()
+			err = timeoutError(ctx, err)
+			return
+		}
+		resp.Body = &timeoutBody{ReadCloser: resp.Body, ctx: ctx, cancel: cancel}
+	}()
+
+	middlewares := c.config.Middlewares
+	if inst := c.config.Instrumentation; inst != nil {
+		var end func(*http.Response, error)
+		ctx, end = inst.StartOperation(ctx, r.Operation)
+		defer func() { end(resp, err) }()
+
+		middlewares = append(middlewares[:len(middlewares):len(middlewares)], inst.Middleware)
+	}
+
+	opts := controlmonkey.RequestOptionsFromContext(ctx)
+	opts.IdempotencyKey, opts.Capture = opts.ClaimIdempotencyKey(), opts.ClaimCapture()
+	if opts.Capture != nil {
+		ctx = withCapture(ctx, opts.Capture)
+	}
+	cfg := c.configFor(opts)
+	if err = cfg.Validate(); err != nil {
+		return nil, err
+	}
+
+	req, err := r.toHTTP(ctx, cfg, shouldWrapWithEntity)
 	if err != nil {
 		return nil, err
 	}
+	for key, values := range opts.Header {
+		req.Header[key] = values
+	}
+	if opts.IdempotencyKey != "" {
+		req.Header.Set(controlmonkey.IdempotencyKeyHeader, opts.IdempotencyKey)
+	}
+
+	var attempt int
+	if capture := opts.Capture; capture != nil {
+		defer func() { recordResponse(capture, resp, attempt) }()
+	}
+
+	send := controlmonkey.Chain(c.send, middlewares...)
+	policy := cfg.RetryPolicy
+	reauthenticated := opts.Header.Get("Authorization") != ""
+	for attempt = 1; ; attempt++ {
+		req = req.WithContext(controlmonkey.WithAttempt(req.Context(), attempt))
+
+		if limiter := c.config.RateLimiter; limiter != nil {
+			if err = limiter.Wait(ctx); err != nil {
+				return nil, err
+			}
+		}
+
+		resp, err = send(req)
+
+		if resp != nil && resp.StatusCode == http.StatusTooManyRequests {
+			c.pause(req, resp)
+		}
+
+		if resp != nil && resp.StatusCode == http.StatusUnauthorized && !reauthenticated {
+			reauthenticated = true
+			if reauthenticate(req, cfg.Credentials) {
+				drainBody(resp)
+				if err = rewindBody(req); err != nil {
+					return nil, err
+				}
+				// Sending the request again with the new token does not
+				// use up an attempt.
+				attempt--
+				continue
+			}
+		}
+
+		if !policy.ShouldRetry(req, resp, err, attempt) || !canRewindBody(req) {
+			return resp, err
+		}
+
+		delay := policy.Delay(resp, attempt)
+		c.logRetry(req, resp, err, attempt, policy.MaxAttempts, delay)
+		drainBody(resp)
+
+		if err = sleep(ctx, delay); err != nil {
+			return nil, err
+		}
+		if err = rewindBody(req); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// configFor returns the config of a request sent with the given options.
+func (c *Client) configFor(opts *controlmonkey.RequestOptions) *controlmonkey.Config {
+	if opts.Credentials == nil && opts.RetryPolicy == nil && opts.IdempotencyKey == "" {
+		return c.config
+	}
+
+	cfg := *c.config
+	if opts.Credentials != nil {
+		cfg.Credentials = opts.Credentials
+	}
+	if opts.RetryPolicy != nil {
+		cfg.RetryPolicy = opts.RetryPolicy
+	}
+	if opts.IdempotencyKey != "" && cfg.RetryPolicy != nil {
+		// The API deduplicates requests carrying the same key, so they are
+		// safe to retry.
+		policy := *cfg.RetryPolicy
+		policy.RetryNonIdempotent = true
+		cfg.RetryPolicy = &policy
+	}
+	return &cfg
+}
+
+// reauthenticate refreshes the credentials after the API rejected them, and
+// sets the new token on req. It reports whether req may be sent again, i.e.
+// a different token was retrieved.
+func reauthenticate(req *http.Request, creds *credentials.Credentials) bool {
+	if !canRewindBody(req) {
+		return false
+	}
+
+	// The credentials may have been refreshed by a concurrent request.
+	v, err := creds.Get()
+	if err == nil && "Bearer "+v.Token == req.Header.Get("Authorization") {
+		creds.Refresh()
+		v, err = creds.Get()
+	}
+	if err != nil || v.Token == "" {
+		return false
+	}
+
+	auth := "Bearer " + v.Token
+	if auth == req.Header.Get("Authorization") {
+		return false
+	}
+	req.Header.Set("Authorization", auth)
+	return true
+}
+
+// send sends a single HTTP request. It is the innermost handler of the
+// middleware chain, so the logged request is the one actually sent.
+func (c *Client) send(req *http.Request) (*http.Response, error) {
 	c.logRequest(req)
+	start := time.Now()
 	resp, err := c.config.HTTPClient.Do(req)
-	c.logResponse(resp)
+	c.logResponse(req, resp, err, time.Since(start))
 	return resp, err
 }
 
-func (c *Client) logf(format string, args ...interface{}) {
-	if c.config.Logger != nil {
-		c.config.Logger.Printf(format, args...)
+// pause pauses the configured rate limiter, if any, for as long as the API
+// requested in a rate limited response.
+func (c *Client) pause(req *http.Request, resp *http.Response) {
+	limiter := c.config.RateLimiter
+	if limiter == nil {
+		return
+	}
+	if d, ok := controlmonkey.RetryAfter(resp); ok {
+		limiter.PauseFor(d)
+		c.logPause(req, resp, d)
 	}
 }
 
-const logReqMsg = `CONTROL MONKEY: Request "%s %s" details:
----[ REQUEST ]---------------------------------------
-%s
------------------------------------------------------`
+// canRewindBody reports whether the body of req can be sent again.
+func canRewindBody(req *http.Request) bool {
+	return req.Body == nil || req.Body == http.NoBody || req.GetBody != nil
+}
 
-func (c *Client) logRequest(req *http.Request) {
-	if c.config.Logger != nil && req != nil {
-		out, err := httputil.DumpRequestOut(req, true)
-		if err == nil {
-			c.logf(logReqMsg, req.Method, req.URL, string(out))
-		}
+// rewindBody resets the body of req so it can be sent again.
+func rewindBody(req *http.Request) error {
+	if req.GetBody == nil {
+		return nil
 	}
+	body, err := req.GetBody()
+	if err != nil {
+		return err
+	}
+	req.Body = body
+	return nil
+}
+
+// drainBody reads and closes the response body so the underlying connection
+// can be reused by the next attempt.
+func drainBody(resp *http.Response) {
+	if resp == nil || resp.Body == nil {
+		return
+	}
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 4096))
+	resp.Body.Close()
 }
 
-const logRespMsg = `CONTROL MONKEY: Response "%s %s" details:
----[ RESPONSE ]----------------------------------------
-%s
--------------------------------------------------------`
+// sleep waits for the given duration or until ctx is done, whichever happens
+// first.
+func sleep(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return ctx.Err()
+	}
+	t := time.NewTimer(d)
+	defer t.Stop()
 
-func (c *Client) logResponse(resp *http.Response) {
-	if c.config.Logger != nil && resp != nil {
-		out, err := httputil.DumpResponse(resp, true)
-		if err == nil {
-			c.logf(logRespMsg, resp.Request.Method, resp.Request.URL, string(out))
-		}
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-t.C:
+		return nil
 	}
 }
@@ -0,0 +1,904 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/control-monkey/controlmonkey-sdk-go/controlmonkey"
+	"github.com/control-monkey/controlmonkey-sdk-go/controlmonkey/credentials"
+	"github.com/control-monkey/controlmonkey-sdk-go/controlmonkey/log"
+)
+
+func newTestConfig(url string) *controlmonkey.Config {
+	cfg := controlmonkey.DefaultConfig()
+	cfg.WithBaseURL(url)
+	cfg.WithCredentials(credentials.NewStaticCredentials("token"))
+	cfg.WithRetryPolicy(&controlmonkey.RetryPolicy{
+		MaxAttempts:          3,
+		BaseDelay:            time.Millisecond,
+		MaxDelay:             5 * time.Millisecond,
+		RetryableStatusCodes: controlmonkey.DefaultRetryableStatusCodes(),
+		RetryNetworkErrors:   true,
+	})
+	return cfg
+}
+
+func TestClientRetry(t *testing.T) {
+	tests := map[string]struct {
+		method         string
+		obj            interface{}
+		statuses       []int
+		nonIdempotent  bool
+		wantStatus     int
+		wantAttempts   int32
+		wantBodyOnEach string
+	}{
+		"get_success": {
+			method:       http.MethodGet,
+			statuses:     []int{http.StatusOK},
+			wantStatus:   http.StatusOK,
+			wantAttempts: 1,
+		},
+		"get_retry_then_success": {
+			method:       http.MethodGet,
+			statuses:     []int{http.StatusServiceUnavailable, http.StatusBadGateway, http.StatusOK},
+			wantStatus:   http.StatusOK,
+			wantAttempts: 3,
+		},
+		"get_retry_exhausted": {
+			method:       http.MethodGet,
+			statuses:     []int{http.StatusServiceUnavailable, http.StatusServiceUnavailable, http.StatusServiceUnavailable, http.StatusOK},
+			wantStatus:   http.StatusServiceUnavailable,
+			wantAttempts: 3,
+		},
+		"get_not_retryable_status": {
+			method:       http.MethodGet,
+			statuses:     []int{http.StatusBadRequest, http.StatusOK},
+			wantStatus:   http.StatusBadRequest,
+			wantAttempts: 1,
+		},
+		"post_not_retried_by_default": {
+			method:       http.MethodPost,
+			obj:          map[string]string{"name": "stack"},
+			statuses:     []int{http.StatusServiceUnavailable, http.StatusOK},
+			wantStatus:   http.StatusServiceUnavailable,
+			wantAttempts: 1,
+		},
+		"post_retried_when_opted_in": {
+			method:         http.MethodPost,
+			obj:            map[string]string{"name": "stack"},
+			statuses:       []int{http.StatusServiceUnavailable, http.StatusOK},
+			nonIdempotent:  true,
+			wantStatus:     http.StatusOK,
+			wantAttempts:   2,
+			wantBodyOnEach: "{\"entity\":{\"name\":\"stack\"}}\n",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var attempts int32
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				n := atomic.AddInt32(&attempts, 1)
+				if test.wantBodyOnEach != "" {
+					body, _ := io.ReadAll(r.Body)
+					if e, a := test.wantBodyOnEach, string(body); e != a {
+						t.Errorf("attempt %d: want body: %q, got: %q", n, e, a)
+					}
+				}
+				w.WriteHeader(test.statuses[n-1])
+			}))
+			defer srv.Close()
+
+			cfg := newTestConfig(srv.URL)
+			cfg.RetryPolicy.RetryNonIdempotent = test.nonIdempotent
+
+			r := NewRequest(test.method, "/stack")
+			r.Obj = test.obj
+
+			resp, err := New(cfg).Do(context.Background(), r)
+			if err != nil {
+				t.Fatalf("want: nil, got: %v", err)
+			}
+			defer resp.Body.Close()
+
+			if e, a := test.wantStatus, resp.StatusCode; e != a {
+				t.Errorf("want status: %d, got: %d", e, a)
+			}
+			if e, a := test.wantAttempts, atomic.LoadInt32(&attempts); e != a {
+				t.Errorf("want attempts: %d, got: %d", e, a)
+			}
+		})
+	}
+}
+
+func TestClientRetryContextCanceled(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer srv.Close()
+
+	cfg := newTestConfig(srv.URL)
+	cfg.RetryPolicy.BaseDelay = time.Hour
+	cfg.RetryPolicy.MaxDelay = time.Hour
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	_, err := New(cfg).Do(ctx, NewRequest(http.MethodGet, "/stack"))
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("want: %v, got: %v", context.DeadlineExceeded, err)
+	}
+}
+
+func TestClientRateLimited(t *testing.T) {
+	tests := map[string]struct {
+		method       string
+		opts         []controlmonkey.RequestOption
+		wantStatus   int
+		wantAttempts int32
+	}{
+		"idempotent": {
+			method:       http.MethodGet,
+			wantStatus:   http.StatusOK,
+			wantAttempts: 2,
+		},
+		"non_idempotent": {
+			method:       http.MethodPost,
+			wantStatus:   http.StatusTooManyRequests,
+			wantAttempts: 1,
+		},
+		"idempotency_key": {
+			method:       http.MethodPost,
+			opts:         []controlmonkey.RequestOption{controlmonkey.WithIdempotencyKey("key")},
+			wantStatus:   http.StatusOK,
+			wantAttempts: 2,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var attempts int32
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if atomic.AddInt32(&attempts, 1) == 1 {
+					w.Header().Set("Retry-After", "0")
+					w.WriteHeader(http.StatusTooManyRequests)
+					return
+				}
+				w.WriteHeader(http.StatusOK)
+			}))
+			defer srv.Close()
+
+			cfg := newTestConfig(srv.URL)
+			cfg.WithRateLimiter(controlmonkey.NewRateLimiter(0, 0))
+
+			r := NewRequest(test.method, "/variable")
+			r.Obj = map[string]string{"key": "value"}
+
+			ctx := controlmonkey.WithRequestOptions(context.Background(), test.opts...)
+			resp, err := New(cfg).Do(ctx, r)
+			if err != nil {
+				t.Fatalf("want: nil, got: %v", err)
+			}
+			defer resp.Body.Close()
+
+			if e, a := test.wantStatus, resp.StatusCode; e != a {
+				t.Errorf("want status: %d, got: %d", e, a)
+			}
+			if e, a := test.wantAttempts, atomic.LoadInt32(&attempts); e != a {
+				t.Errorf("want attempts: %d, got: %d", e, a)
+			}
+		})
+	}
+}
+
+func TestClientMiddleware(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Tenant", r.Header.Get("X-Tenant"))
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+
+	var calls []string
+	record := func(name string) controlmonkey.Middleware {
+		return func(next controlmonkey.Handler) controlmonkey.Handler {
+			return func(req *http.Request) (*http.Response, error) {
+				calls = append(calls, name+":"+controlmonkey.OperationName(req.Context()))
+				req.Header.Set("X-Tenant", name)
+				resp, err := next(req)
+				calls = append(calls, name+":"+resp.Header.Get("X-Tenant"))
+				return resp, err
+			}
+		}
+	}
+
+	cfg := newTestConfig(srv.URL)
+	cfg.WithMiddleware(record("outer"), record("inner"))
+
+	r := NewRequest(http.MethodGet, "/stack")
+	r.Operation = "stack.ListStacks"
+
+	resp, err := New(cfg).Do(context.Background(), r)
+	if err != nil {
+		t.Fatalf("want: nil, got: %v", err)
+	}
+	defer resp.Body.Close()
+
+	want := []string{
+		"outer:stack.ListStacks",
+		"inner:stack.ListStacks",
+		"inner:inner",
+		"outer:inner",
+	}
+	if e, a := want, calls; !reflect.DeepEqual(e, a) {
+		t.Errorf("want: %v, got: %v", e, a)
+	}
+}
+
+func TestClientLogRedaction(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		if !strings.Contains(string(body), "super-secret-value") {
+			t.Errorf("request body was redacted on the wire: %s", body)
+		}
+		w.Write([]byte(`{"response":{"items":[{"id":"rt-1","hmacKey":"super-secret-hmac","url":"https://hooks.example.com/secret"}]}}`))
+	}))
+	defer srv.Close()
+
+	tests := map[string]struct {
+		level   log.Level
+		fields  []string
+		want    []string
+		notWant []string
+	}{
+		"bodies": {
+			level:   log.LevelBodies,
+			want:    []string{"Authorization: [REDACTED]", `"value":"[REDACTED]"`, `"hmacKey":"[REDACTED]"`, "rt-1", "https://hooks.example.com/secret"},
+			notWant: []string{"Bearer token", "super-secret-value", "super-secret-hmac"},
+		},
+		"bodies_with_extra_fields": {
+			level:   log.LevelBodies,
+			fields:  []string{"url"},
+			want:    []string{`"url":"[REDACTED]"`},
+			notWant: []string{"Bearer token", "https://hooks.example.com/secret"},
+		},
+		"headers": {
+			level:   log.LevelHeaders,
+			want:    []string{"Authorization: [REDACTED]", "200 OK"},
+			notWant: []string{"Bearer token", "[REDACTED]\"", "rt-1"},
+		},
+		"requests": {
+			level:   log.LevelRequests,
+			want:    []string{`"POST ` + srv.URL + `/variable" 200 OK (took `},
+			notWant: []string{"Authorization", "rt-1"},
+		},
+		"off": {
+			level:   log.LevelOff,
+			notWant: []string{"CONTROL MONKEY"},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var buf strings.Builder
+			cfg := newTestConfig(srv.URL)
+			cfg.WithLogger(log.LoggerFunc(func(format string, args ...interface{}) {
+				fmt.Fprintf(&buf, format+"\n", args...)
+			}))
+			cfg.WithLogLevel(test.level)
+			cfg.WithSensitiveFields(test.fields...)
+
+			r := NewRequest(http.MethodPost, "/variable")
+			r.Obj = map[string]string{"key": "k", "value": "super-secret-value"}
+
+			resp, err := New(cfg).Do(context.Background(), r)
+			if err != nil {
+				t.Fatalf("want: nil, got: %v", err)
+			}
+			defer resp.Body.Close()
+
+			body, _ := io.ReadAll(resp.Body)
+			if !strings.Contains(string(body), "super-secret-hmac") {
+				t.Errorf("response body was not restored after logging: %s", body)
+			}
+
+			out := buf.String()
+			for _, s := range test.want {
+				if !strings.Contains(out, s) {
+					t.Errorf("want log to contain %q, got:\n%s", s, out)
+				}
+			}
+			for _, s := range test.notWant {
+				if strings.Contains(out, s) {
+					t.Errorf("want log not to contain %q, got:\n%s", s, out)
+				}
+			}
+		})
+	}
+}
+
+func TestClientStructuredLogging(t *testing.T) {
+	var attempts int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&attempts, 1) == 1 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.Write([]byte(`{"request":{"id":"req-123"},"response":{"items":[]}}`))
+	}))
+	defer srv.Close()
+
+	var buf bytes.Buffer
+	cfg := newTestConfig(srv.URL)
+	cfg.WithLogger(log.NewSlogLogger(slog.New(slog.NewJSONHandler(&buf, nil))))
+	cfg.WithRateLimiter(controlmonkey.NewRateLimiter(0, 0))
+
+	r := NewRequest(http.MethodGet, "/stack")
+	r.Operation = "stack.ListStacks"
+
+	resp, err := New(cfg).Do(context.Background(), r)
+	if err != nil {
+		t.Fatalf("want: nil, got: %v", err)
+	}
+	defer resp.Body.Close()
+
+	var events []map[string]interface{}
+	dec := json.NewDecoder(&buf)
+	for dec.More() {
+		var event map[string]interface{}
+		if err := dec.Decode(&event); err != nil {
+			t.Fatalf("want: nil, got: %v", err)
+		}
+		events = append(events, event)
+	}
+	if e, a := 4, len(events); e != a {
+		t.Fatalf("want events: %d, got: %d", e, a)
+	}
+
+	want := []map[string]interface{}{
+		{"msg": "controlmonkey request", "level": "WARN", "method": "GET", "path": "/stack", "status": float64(429), "attempt": float64(1), "operation": "stack.ListStacks"},
+		{"msg": "controlmonkey rate limited", "level": "WARN", "method": "GET", "path": "/stack", "status": float64(429), "attempt": float64(1), "operation": "stack.ListStacks", "delay": float64(0)},
+		{"msg": "controlmonkey retry", "level": "WARN", "method": "GET", "path": "/stack", "status": float64(429), "attempt": float64(1), "operation": "stack.ListStacks", "max_attempts": float64(3)},
+		{"msg": "controlmonkey request", "level": "INFO", "method": "GET", "path": "/stack", "status": float64(200), "attempt": float64(2), "operation": "stack.ListStacks", "request_id": "req-123"},
+	}
+	for i, event := range events {
+		for k, v := range want[i] {
+			if e, a := v, event[k]; !reflect.DeepEqual(e, a) {
+				t.Errorf("event %d: want %s: %v, got: %v", i, k, e, a)
+			}
+		}
+		key := "duration"
+		if event["msg"] != "controlmonkey request" {
+			key = "delay"
+		}
+		if _, ok := event[key]; !ok {
+			t.Errorf("event %d: want %s", i, key)
+		}
+	}
+
+	body, _ := io.ReadAll(resp.Body)
+	if !strings.Contains(string(body), "req-123") {
+		t.Errorf("response body was not restored after logging: %s", body)
+	}
+}
+
+func TestClientStructuredLoggingRequestID(t *testing.T) {
+	tests := map[string]struct {
+		level  log.Level
+		header string
+		want   string
+	}{
+		"header": {
+			level:  log.LevelRequests,
+			header: "req-header",
+			want:   "req-header",
+		},
+		// The body is not buffered to find the ID unless bodies are logged.
+		"body_not_logged": {
+			level: log.LevelRequests,
+		},
+		"body_logged": {
+			level: log.LevelBodies,
+			want:  "req-body",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if test.header != "" {
+					w.Header().Set("X-Request-Id", test.header)
+				}
+				w.Write([]byte(`{"request":{"id":"req-body"},"response":{"items":[]}}`))
+			}))
+			defer srv.Close()
+
+			var buf bytes.Buffer
+			cfg := newTestConfig(srv.URL)
+			cfg.WithLogger(log.NewSlogLogger(slog.New(slog.NewJSONHandler(&buf, nil))))
+			cfg.WithLogLevel(test.level)
+
+			resp, err := New(cfg).Do(context.Background(), NewRequest(http.MethodGet, "/stack"))
+			if err != nil {
+				t.Fatalf("want: nil, got: %v", err)
+			}
+			resp.Body.Close()
+
+			var event map[string]interface{}
+			if err := json.Unmarshal(buf.Bytes(), &event); err != nil {
+				t.Fatalf("want: nil, got: %v", err)
+			}
+			got, _ := event["request_id"].(string)
+			if e, a := test.want, got; e != a {
+				t.Errorf("want: %v, got: %v", e, a)
+			}
+		})
+	}
+}
+
+func TestClientTimeout(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-time.After(50 * time.Millisecond):
+			fmt.Fprint(w, `{"response":{"items":[]}}`)
+		case <-r.Context().Done():
+		}
+	}))
+	defer srv.Close()
+
+	const operation = "stack.CreatePlan"
+
+	tests := map[string]struct {
+		requestTimeout   time.Duration
+		operationTimeout time.Duration
+		callerTimeout    time.Duration
+		wantTimeout      time.Duration
+	}{
+		"request_timeout": {
+			requestTimeout: 10 * time.Millisecond,
+			wantTimeout:    10 * time.Millisecond,
+		},
+		"operation_timeout": {
+			requestTimeout:   time.Minute,
+			operationTimeout: 10 * time.Millisecond,
+			wantTimeout:      10 * time.Millisecond,
+		},
+		"operation_timeout_longer": {
+			requestTimeout:   10 * time.Millisecond,
+			operationTimeout: time.Minute,
+		},
+		"caller_deadline": {
+			requestTimeout: 10 * time.Millisecond,
+			callerTimeout:  time.Minute,
+		},
+		"disabled": {
+			requestTimeout: controlmonkey.NoTimeout,
+		},
+		"default": {},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			cfg := newTestConfig(srv.URL).WithRequestTimeout(test.requestTimeout)
+			if test.operationTimeout != 0 {
+				cfg.WithOperationTimeout(operation, test.operationTimeout)
+			}
+
+			ctx := context.Background()
+			if test.callerTimeout != 0 {
+				var cancel context.CancelFunc
+				ctx, cancel = context.WithTimeout(ctx, test.callerTimeout)
+				defer cancel()
+			}
+
+			r := NewRequest(http.MethodPost, "/stack/plan")
+			r.Operation = operation
+			_, err := DoItems[map[string]interface{}](ctx, New(cfg), r)
+
+			if test.wantTimeout == 0 {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+
+			var timeoutErr *TimeoutError
+			if !errors.As(err, &timeoutErr) {
+				t.Fatalf("want: timeout error, got: %v", err)
+			}
+			if e, a := operation, timeoutErr.Operation; e != a {
+				t.Errorf("want: %v, got: %v", e, a)
+			}
+			if e, a := test.wantTimeout, timeoutErr.Duration; e != a {
+				t.Errorf("want: %v, got: %v", e, a)
+			}
+			if !errors.Is(err, context.DeadlineExceeded) || !IsTimeout(err) {
+				t.Errorf("want: deadline exceeded, got: %v", err)
+			}
+		})
+	}
+}
+
+func TestClientTimeoutBody(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"response":`)
+		w.(http.Flusher).Flush()
+		<-r.Context().Done()
+	}))
+	defer srv.Close()
+
+	cfg := newTestConfig(srv.URL).WithRequestTimeout(20 * time.Millisecond)
+	resp, err := New(cfg).Do(context.Background(), NewRequest(http.MethodGet, "/stack"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if _, err := io.ReadAll(resp.Body); !IsTimeout(err) {
+		t.Errorf("want: timeout error, got: %v", err)
+	}
+}
+
+func TestClientRequestOptions(t *testing.T) {
+	type request struct {
+		header http.Header
+	}
+
+	tests := map[string]struct {
+		method   string
+		opts     []controlmonkey.RequestOption
+		statuses []int
+
+		wantHeader    map[string]string
+		wantAttempts  int
+		wantStatus    int
+		wantRequestID string
+	}{
+		"header": {
+			method:       http.MethodGet,
+			opts:         []controlmonkey.RequestOption{controlmonkey.WithRequestHeader("X-Correlation-Id", "abc")},
+			statuses:     []int{http.StatusOK},
+			wantHeader:   map[string]string{"X-Correlation-Id": "abc", "Authorization": "Bearer token"},
+			wantAttempts: 1,
+			wantStatus:   http.StatusOK,
+		},
+		"credentials": {
+			method:       http.MethodGet,
+			opts:         []controlmonkey.RequestOption{controlmonkey.WithRequestCredentials(credentials.NewStaticCredentials("other"))},
+			statuses:     []int{http.StatusOK},
+			wantHeader:   map[string]string{"Authorization": "Bearer other"},
+			wantAttempts: 1,
+			wantStatus:   http.StatusOK,
+		},
+		"retries_disabled": {
+			method:       http.MethodGet,
+			opts:         []controlmonkey.RequestOption{controlmonkey.WithRetriesDisabled()},
+			statuses:     []int{http.StatusServiceUnavailable, http.StatusOK},
+			wantAttempts: 1,
+			wantStatus:   http.StatusServiceUnavailable,
+		},
+		"post_not_retried": {
+			method:       http.MethodPost,
+			statuses:     []int{http.StatusServiceUnavailable, http.StatusOK},
+			wantAttempts: 1,
+			wantStatus:   http.StatusServiceUnavailable,
+		},
+		"post_idempotency_key_retried": {
+			method:       http.MethodPost,
+			opts:         []controlmonkey.RequestOption{controlmonkey.WithIdempotencyKey("key-1")},
+			statuses:     []int{http.StatusServiceUnavailable, http.StatusOK},
+			wantHeader:   map[string]string{controlmonkey.IdempotencyKeyHeader: "key-1"},
+			wantAttempts: 2,
+			wantStatus:   http.StatusOK,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var requests []request
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				requests = append(requests, request{header: r.Header.Clone()})
+				status := test.statuses[len(requests)-1]
+				w.Header().Set("X-Request-Id", fmt.Sprintf("req-%d", len(requests)))
+				w.WriteHeader(status)
+				fmt.Fprint(w, `{"response":{"items":[]}}`)
+			}))
+			defer srv.Close()
+
+			var capture controlmonkey.CapturedResponse
+			opts := append(test.opts, controlmonkey.WithResponseCapture(&capture))
+			ctx := controlmonkey.WithRequestOptions(context.Background(), opts...)
+
+			r := NewRequest(test.method, "/stack")
+			r.Obj = map[string]string{"name": "app"}
+			resp, err := New(newTestConfig(srv.URL)).Do(ctx, r)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			resp.Body.Close()
+
+			if e, a := test.wantStatus, resp.StatusCode; e != a {
+				t.Errorf("want: %v, got: %v", e, a)
+			}
+			if e, a := test.wantAttempts, len(requests); e != a {
+				t.Fatalf("want: %v attempts, got: %v", e, a)
+			}
+			for key, want := range test.wantHeader {
+				if got := requests[len(requests)-1].header.Get(key); got != want {
+					t.Errorf("%s: want: %v, got: %v", key, want, got)
+				}
+			}
+
+			if capture.Response != resp {
+				t.Errorf("want: captured response %p, got: %p", resp, capture.Response)
+			}
+			if e, a := test.wantAttempts, capture.Attempts; e != a {
+				t.Errorf("want: %v, got: %v", e, a)
+			}
+			if e, a := fmt.Sprintf("req-%d", test.wantAttempts), capture.RequestID; e != a {
+				t.Errorf("want: %v, got: %v", e, a)
+			}
+		})
+	}
+}
+
+func TestClientRequestOptionsNested(t *testing.T) {
+	ctx := controlmonkey.WithRequestOptions(context.Background(),
+		controlmonkey.WithRequestHeader("X-A", "a"))
+	nested := controlmonkey.WithRequestOptions(ctx,
+		controlmonkey.WithRequestHeader("X-B", "b"))
+
+	if got := controlmonkey.RequestOptionsFromContext(ctx).Header.Get("X-B"); got != "" {
+		t.Errorf("want: parent options unchanged, got: %v", got)
+	}
+	header := controlmonkey.RequestOptionsFromContext(nested).Header
+	if header.Get("X-A") != "a" || header.Get("X-B") != "b" {
+		t.Errorf("want: both headers, got: %v", header)
+	}
+}
+
+func TestClientRequestOptionsSingleUse(t *testing.T) {
+	var keys []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		keys = append(keys, r.Header.Get(controlmonkey.IdempotencyKeyHeader))
+		w.Header().Set("X-Request-Id", fmt.Sprintf("req-%d", len(keys)))
+		fmt.Fprint(w, `{"response":{"items":[]}}`)
+	}))
+	defer srv.Close()
+
+	c := New(newTestConfig(srv.URL))
+	send := func(ctx context.Context) {
+		t.Helper()
+		resp, err := RequireOK(c.Do(ctx, NewRequest(http.MethodPost, "/stack")))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		resp.Body.Close()
+	}
+
+	var capture, other controlmonkey.CapturedResponse
+	ctx := controlmonkey.WithRequestOptions(context.Background(),
+		controlmonkey.WithIdempotencyKey("key-1"),
+		controlmonkey.WithResponseCapture(&capture))
+	send(ctx)
+	send(ctx)
+	send(controlmonkey.WithRequestOptions(ctx, controlmonkey.WithRequestHeader("X-A", "a")))
+	send(controlmonkey.WithRequestOptions(ctx,
+		controlmonkey.WithIdempotencyKey("key-2"),
+		controlmonkey.WithResponseCapture(&other)))
+
+	if want := []string{"key-1", "", "", "key-2"}; fmt.Sprint(keys) != fmt.Sprint(want) {
+		t.Errorf("want: %q, got: %q", want, keys)
+	}
+	if e, a := "req-1", capture.RequestID; e != a {
+		t.Errorf("want: %v, got: %v", e, a)
+	}
+	if e, a := "req-4", other.RequestID; e != a {
+		t.Errorf("want: %v, got: %v", e, a)
+	}
+}
+
+func TestRequireOKCapturesRequestID(t *testing.T) {
+	tests := map[string]struct {
+		status int
+		header string
+		body   string
+		do     func(context.Context, *Client) error
+
+		wantRequestID string
+	}{
+		"do_items": {
+			status: http.StatusOK,
+			body:   `{"request":{"id":"req-body"},"response":{"items":[{"id":1}]}}`,
+			do: func(ctx context.Context, c *Client) error {
+				_, err := DoItems[testItem](ctx, c, NewRequest(http.MethodGet, "/items"))
+				return err
+			},
+			wantRequestID: "req-body",
+		},
+		"require_ok": {
+			status: http.StatusOK,
+			body:   `{"request":{"id":"req-body"},"response":{"items":[]}}`,
+			do: func(ctx context.Context, c *Client) error {
+				resp, err := RequireOK(c.Do(ctx, NewRequest(http.MethodDelete, "/items/1")))
+				if err == nil {
+					resp.Body.Close()
+				}
+				return err
+			},
+			wantRequestID: "req-body",
+		},
+		"require_ok_error": {
+			status: http.StatusBadRequest,
+			body:   `{"request":{"id":"req-body"},"response":{"errors":[{"code":"validation_error","message":"bad"}]}}`,
+			do: func(ctx context.Context, c *Client) error {
+				_, err := RequireOK(c.Do(ctx, NewRequest(http.MethodDelete, "/items/1")))
+				return err
+			},
+			wantRequestID: "req-body",
+		},
+		"header_first": {
+			status: http.StatusOK,
+			header: "req-header",
+			body:   `{"request":{"id":"req-body"},"response":{"items":[]}}`,
+			do: func(ctx context.Context, c *Client) error {
+				_, err := DoItems[testItem](ctx, c, NewRequest(http.MethodGet, "/items"))
+				return err
+			},
+			wantRequestID: "req-header",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if test.header != "" {
+					w.Header().Set("X-Request-Id", test.header)
+				}
+				w.WriteHeader(test.status)
+				fmt.Fprint(w, test.body)
+			}))
+			defer srv.Close()
+
+			var capture controlmonkey.CapturedResponse
+			ctx := controlmonkey.WithRequestOptions(context.Background(), controlmonkey.WithResponseCapture(&capture))
+			err := test.do(ctx, New(newTestConfig(srv.URL)))
+			if (err != nil) != (test.status != http.StatusOK) {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if e, a := test.wantRequestID, capture.RequestID; e != a {
+				t.Errorf("want: %v, got: %v", e, a)
+			}
+		})
+	}
+}
+
+// rotatingProvider returns the next token of tokens on every call to
+// Retrieve, then keeps returning the last one.
+type rotatingProvider struct {
+	tokens []string
+	calls  int
+}
+
+func (p *rotatingProvider) Retrieve() (credentials.Value, error) {
+	token := p.tokens[min(p.calls, len(p.tokens)-1)]
+	p.calls++
+	return credentials.Value{Token: token}, nil
+}
+
+func (p *rotatingProvider) String() string { return "rotating" }
+
+func TestClientReauthenticate(t *testing.T) {
+	tests := map[string]struct {
+		tokens     []string
+		method     string
+		wantTokens []string
+		wantStatus int
+	}{
+		"rotated_token": {
+			tokens:     []string{"old", "new"},
+			method:     http.MethodGet,
+			wantTokens: []string{"old", "new"},
+			wantStatus: http.StatusOK,
+		},
+		"rotated_token_post": {
+			tokens:     []string{"old", "new"},
+			method:     http.MethodPost,
+			wantTokens: []string{"old", "new"},
+			wantStatus: http.StatusOK,
+		},
+		"same_token": {
+			tokens:     []string{"old"},
+			method:     http.MethodGet,
+			wantTokens: []string{"old"},
+			wantStatus: http.StatusUnauthorized,
+		},
+		"retried_once": {
+			tokens:     []string{"old", "older", "oldest"},
+			method:     http.MethodGet,
+			wantTokens: []string{"old", "older"},
+			wantStatus: http.StatusUnauthorized,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var tokens []string
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				token := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+				tokens = append(tokens, token)
+				if token != "new" {
+					w.WriteHeader(http.StatusUnauthorized)
+				}
+			}))
+			defer srv.Close()
+
+			cfg := newTestConfig(srv.URL)
+			cfg.WithCredentials(credentials.NewCredentials(&rotatingProvider{tokens: test.tokens}))
+
+			r := NewRequest(test.method, "/stack")
+			r.Obj = map[string]string{"name": "app"}
+			resp, err := New(cfg).Do(context.Background(), r)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			resp.Body.Close()
+
+			if e, a := test.wantStatus, resp.StatusCode; e != a {
+				t.Errorf("want: %v, got: %v", e, a)
+			}
+			if e, a := test.wantTokens, tokens; !reflect.DeepEqual(e, a) {
+				t.Errorf("want: %v, got: %v", e, a)
+			}
+		})
+	}
+}
+
+func TestClientReauthenticateRetry(t *testing.T) {
+	var statuses []int
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		status := http.StatusOK
+		switch {
+		case r.Header.Get("Authorization") != "Bearer new":
+			status = http.StatusUnauthorized
+		case len(statuses) == 1:
+			status = http.StatusServiceUnavailable
+		}
+		statuses = append(statuses, status)
+		w.WriteHeader(status)
+	}))
+	defer srv.Close()
+
+	cfg := newTestConfig(srv.URL)
+	cfg.RetryPolicy.MaxAttempts = 2
+	cfg.WithCredentials(credentials.NewCredentials(&rotatingProvider{tokens: []string{"old", "new"}}))
+
+	var capture controlmonkey.CapturedResponse
+	ctx := controlmonkey.WithRequestOptions(context.Background(), controlmonkey.WithResponseCapture(&capture))
+	resp, err := New(cfg).Do(ctx, NewRequest(http.MethodGet, "/stack"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	resp.Body.Close()
+
+	want := []int{http.StatusUnauthorized, http.StatusServiceUnavailable, http.StatusOK}
+	if !reflect.DeepEqual(want, statuses) {
+		t.Errorf("want: %v, got: %v", want, statuses)
+	}
+	if e, a := 2, capture.Attempts; e != a {
+		t.Errorf("want: %v, got: %v", e, a)
+	}
+}
@@ -0,0 +1,132 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+)
+
+// ErrEmptyResponse is returned by DoOne, and by the service methods returning
+// a single item, when the request succeeded but the response holds no items.
+// It is distinct from ErrNotFound, which is matched by errors reported by the
+// API.
+var ErrEmptyResponse = errors.New("controlmonkey: empty response")
+
+// DoItems sends r, checks the response status, and decodes the items of the
+// response into a slice of T. The request goes through the same
+// authentication, retries, middlewares and logging as the service methods,
+// which allows calling endpoints that are not wrapped by the SDK yet:
+//
+//	r := client.NewRequest(http.MethodGet, "/stack")
+//	r.Params.Set("namespaceId", namespaceID)
+//	stacks, err := client.DoItems[stack.Stack](ctx, c, r)
+func DoItems[T any](ctx context.Context, c *Client, r *Request) ([]*T, error) {
+	items, err := doRaw(ctx, c, r)
+	if err != nil {
+		return nil, err
+	}
+	return decodeItems[T](items)
+}
+
+// DoItemsWithDecoder is like DoItems, but decodes each item of the response
+// using decode, for operations whose items are not a T.
+func DoItemsWithDecoder[T any](ctx context.Context, c *Client, r *Request, decode func(json.RawMessage) (*T, error)) ([]*T, error) {
+	items, err := doRaw(ctx, c, r)
+	if err != nil {
+		return nil, err
+	}
+	return decodeItemsWith(items, decode)
+}
+
+// DoOne is like DoItems, but returns the first item of the response only.
+// It returns ErrEmptyResponse if the response holds no items.
+func DoOne[T any](ctx context.Context, c *Client, r *Request) (*T, error) {
+	items, err := doRaw(ctx, c, r)
+	if err != nil {
+		return nil, err
+	}
+	if len(items) == 0 {
+		return nil, ErrEmptyResponse
+	}
+
+	out := new(T)
+	if err := json.Unmarshal(items[0], out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DecodeItems decodes the items of a successful response into a slice of T.
+// It does not close the body of resp.
+func DecodeItems[T any](resp *http.Response) ([]*T, error) {
+	items, err := rawItems(resp)
+	if err != nil {
+		return nil, err
+	}
+	return decodeItems[T](items)
+}
+
+// doRaw sends r, checks the response status, and returns the raw items of
+// the response.
+func doRaw(ctx context.Context, c *Client, r *Request) ([]json.RawMessage, error) {
+	resp, err := RequireOK(c.Do(ctx, r))
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	rw, err := decodeResponse(resp)
+	if err != nil {
+		return nil, err
+	}
+	return rw.Response.Items, nil
+}
+
+// rawItems returns the raw items of resp.
+func rawItems(resp *http.Response) ([]json.RawMessage, error) {
+	rw, err := decodeResponse(resp)
+	if err != nil {
+		return nil, err
+	}
+	return rw.Response.Items, nil
+}
+
+// decodeResponse decodes the body of resp.
+func decodeResponse(resp *http.Response) (*Response, error) {
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	rw := new(Response)
+	if err := json.Unmarshal(body, rw); err != nil {
+		return nil, err
+	}
+	return rw, nil
+}
+
+// decodeItems decodes each raw item into a T.
+func decodeItems[T any](items []json.RawMessage) ([]*T, error) {
+	return decodeItemsWith(items, func(item json.RawMessage) (*T, error) {
+		v := new(T)
+		if err := json.Unmarshal(item, v); err != nil {
+			return nil, err
+		}
+		return v, nil
+	})
+}
+
+// decodeItemsWith decodes each raw item using decode.
+func decodeItemsWith[T any](items []json.RawMessage, decode func(json.RawMessage) (*T, error)) ([]*T, error) {
+	out := make([]*T, len(items))
+	for i, item := range items {
+		v, err := decode(item)
+		if err != nil {
+			return nil, err
+		}
+		out[i] = v
+	}
+	return out, nil
+}
@@ -0,0 +1,166 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestDoItems(t *testing.T) {
+	tests := map[string]struct {
+		status    int
+		body      string
+		wantItems []int
+		wantErr   error
+	}{
+		"items": {
+			status:    http.StatusOK,
+			body:      `{"response":{"items":[{"id":1},{"id":2}]}}`,
+			wantItems: []int{1, 2},
+		},
+		"empty": {
+			status:    http.StatusOK,
+			body:      `{"response":{"items":[]}}`,
+			wantItems: []int{},
+		},
+		"not_found": {
+			status:  http.StatusNotFound,
+			body:    `{"response":{"errors":[{"code":"not_found","message":"no such item"}]}}`,
+			wantErr: ErrNotFound,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(tc.status)
+				fmt.Fprint(w, tc.body)
+			}))
+			defer srv.Close()
+
+			c := New(newTestConfig(srv.URL))
+			items, err := DoItems[testItem](context.Background(), c, NewRequest(http.MethodGet, "/item"))
+			if !errors.Is(err, tc.wantErr) {
+				t.Fatalf("want: %v, got: %v", tc.wantErr, err)
+			}
+			if tc.wantErr != nil {
+				return
+			}
+
+			got := make([]int, len(items))
+			for i, item := range items {
+				got[i] = item.ID
+			}
+			if fmt.Sprint(got) != fmt.Sprint(tc.wantItems) {
+				t.Errorf("want: %v, got: %v", tc.wantItems, got)
+			}
+		})
+	}
+}
+
+func TestDoItemsWithDecoder(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"response":{"items":[{"email":"a@example.com"},{"email":"b@example.com"}]}}`)
+	}))
+	defer srv.Close()
+
+	c := New(newTestConfig(srv.URL))
+	items, err := DoItemsWithDecoder(context.Background(), c, NewRequest(http.MethodGet, "/item"),
+		func(in json.RawMessage) (*testItem, error) {
+			var v struct {
+				Email string `json:"email"`
+			}
+			if err := json.Unmarshal(in, &v); err != nil {
+				return nil, err
+			}
+			return &testItem{ID: len(v.Email)}, nil
+		})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	got := make([]int, len(items))
+	for i, item := range items {
+		got[i] = item.ID
+	}
+	if want := []int{13, 13}; fmt.Sprint(got) != fmt.Sprint(want) {
+		t.Errorf("want: %v, got: %v", want, got)
+	}
+}
+
+func TestDoOne(t *testing.T) {
+	tests := map[string]struct {
+		status  int
+		body    string
+		wantID  int
+		wantErr error
+	}{
+		"first_item": {
+			status: http.StatusOK,
+			body:   `{"response":{"items":[{"id":1},{"id":2}]}}`,
+			wantID: 1,
+		},
+		"empty": {
+			status:  http.StatusOK,
+			body:    `{"response":{"items":[]}}`,
+			wantErr: ErrEmptyResponse,
+		},
+		"not_found": {
+			status:  http.StatusNotFound,
+			body:    `{"response":{"errors":[{"code":"not_found","message":"no such item"}]}}`,
+			wantErr: ErrNotFound,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(tc.status)
+				fmt.Fprint(w, tc.body)
+			}))
+			defer srv.Close()
+
+			c := New(newTestConfig(srv.URL))
+			item, err := DoOne[testItem](context.Background(), c, NewRequest(http.MethodGet, "/item"))
+			if !errors.Is(err, tc.wantErr) {
+				t.Fatalf("want: %v, got: %v", tc.wantErr, err)
+			}
+			if tc.wantErr != nil {
+				if item != nil {
+					t.Errorf("want: nil item, got: %v", item)
+				}
+				if tc.wantErr == ErrEmptyResponse && IsNotFound(err) {
+					t.Errorf("want: empty response not to be a not found error")
+				}
+				return
+			}
+			if item.ID != tc.wantID {
+				t.Errorf("want: %d, got: %d", tc.wantID, item.ID)
+			}
+		})
+	}
+}
+
+func TestDecodeItems(t *testing.T) {
+	resp := &http.Response{
+		StatusCode: http.StatusOK,
+		Body:       io.NopCloser(bytes.NewBufferString(`{"response":{"items":[{"id":7}]}}`)),
+	}
+
+	items, err := DecodeItems[testItem](resp)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(items) != 1 || items[0].ID != 7 {
+		t.Errorf("want: [7], got: %v", items)
+	}
+}
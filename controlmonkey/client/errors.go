@@ -0,0 +1,129 @@
+package client
+
+import (
+	"errors"
+	"net/http"
+)
+
+// Error codes returned by the ControlMonkey API.
+const (
+	ErrorCodeNotFound        = "not_found"
+	ErrorCodeAlreadyExist    = "already_exist"
+	ErrorCodeValidationError = "validation_error"
+)
+
+var (
+	// ErrNotFound matches, using errors.Is, API errors reporting that the
+	// requested resource does not exist.
+	ErrNotFound = errors.New("controlmonkey: resource not found")
+
+	// ErrAlreadyExists matches, using errors.Is, API errors reporting that the
+	// resource already exists.
+	ErrAlreadyExists = errors.New("controlmonkey: resource already exists")
+
+	// ErrValidation matches, using errors.Is, API errors reporting that the
+	// request is invalid.
+	ErrValidation = errors.New("controlmonkey: validation error")
+)
+
+// Is reports whether the error matches target, which allows API errors to be
+// compared against ErrNotFound, ErrAlreadyExists and ErrValidation using
+// errors.Is.
+//
+// The HTTP status code is only considered for errors reported by the API
+// (ErrorKindAPI): a 404 page returned by a proxy does not mean the resource
+// does not exist.
+func (e Error) Is(target error) bool {
+	switch target {
+	case ErrNotFound:
+		return e.Code == ErrorCodeNotFound || e.hasStatus(http.StatusNotFound)
+	case ErrAlreadyExists:
+		return e.Code == ErrorCodeAlreadyExist || e.hasStatus(http.StatusConflict)
+	case ErrValidation:
+		return e.Code == ErrorCodeValidationError || e.hasStatus(http.StatusUnprocessableEntity)
+	default:
+		return false
+	}
+}
+
+// hasStatus reports whether the error was reported by the API with the given
+// HTTP status code.
+func (e Error) hasStatus(status int) bool {
+	return e.Kind == ErrorKindAPI && e.StatusCode() == status
+}
+
+// StatusCode returns the HTTP status code of the response the error was
+// extracted from, or zero if unknown.
+func (e Error) StatusCode() int {
+	if e.Response == nil {
+		return 0
+	}
+	return e.Response.StatusCode
+}
+
+// Unwrap returns the individual errors, which allows errors.Is and errors.As
+// to inspect each of them.
+func (es Errors) Unwrap() []error {
+	errs := make([]error, len(es))
+	for i, e := range es {
+		errs[i] = e
+	}
+	return errs
+}
+
+// IsNotFound reports whether err is, or wraps, an API error reporting that
+// the requested resource does not exist.
+func IsNotFound(err error) bool { return errors.Is(err, ErrNotFound) }
+
+// IsAlreadyExists reports whether err is, or wraps, an API error reporting
+// that the resource already exists.
+func IsAlreadyExists(err error) bool { return errors.Is(err, ErrAlreadyExists) }
+
+// IsValidationError reports whether err is, or wraps, an API error reporting
+// that the request is invalid.
+func IsValidationError(err error) bool { return errors.Is(err, ErrValidation) }
+
+// StatusCode returns the HTTP status code of the first API error found in
+// err, and whether one was found.
+func StatusCode(err error) (int, bool) {
+	var e Error
+	if errors.As(err, &e) && e.StatusCode() != 0 {
+		return e.StatusCode(), true
+	}
+	return 0, false
+}
+
+// ValidationErrors returns the validation errors found in err, each carrying
+// the offending field (if any) and message.
+func ValidationErrors(err error) []Error {
+	var out []Error
+	var walk func(error)
+	walk = func(err error) {
+		switch t := err.(type) {
+		case nil:
+		case Errors:
+			for _, e := range t {
+				walk(e)
+			}
+		case Error:
+			if t.Is(ErrValidation) {
+				out = append(out, t)
+			}
+		case interface{ Unwrap() error }:
+			walk(t.Unwrap())
+		case interface{ Unwrap() []error }:
+			for _, e := range t.Unwrap() {
+				walk(e)
+			}
+		}
+	}
+	walk(err)
+	return out
+}
+
+// IsTransportError reports whether err is, or wraps, an error reported by an
+// intermediary (e.g. a load balancer or proxy) rather than by the API itself.
+func IsTransportError(err error) bool {
+	var e Error
+	return errors.As(err, &e) && e.Kind == ErrorKindTransport
+}
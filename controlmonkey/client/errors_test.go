@@ -0,0 +1,220 @@
+package client
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func newTestResponse(status int) *http.Response {
+	return &http.Response{
+		StatusCode: status,
+		Request: &http.Request{
+			Method: http.MethodGet,
+			URL:    &url.URL{Path: "/stack/stk-1"},
+		},
+	}
+}
+
+func TestErrorHelpers(t *testing.T) {
+	tests := map[string]struct {
+		err           error
+		notFound      bool
+		alreadyExists bool
+		validation    bool
+		status        int
+	}{
+		"nil": {},
+		"other": {
+			err: errors.New("boom"),
+		},
+		"not_found_code": {
+			err:      Errors{{Response: newTestResponse(http.StatusBadRequest), Code: ErrorCodeNotFound}},
+			notFound: true,
+			status:   http.StatusBadRequest,
+		},
+		"not_found_status": {
+			err:      Errors{{Response: newTestResponse(http.StatusNotFound), Code: "404"}},
+			notFound: true,
+			status:   http.StatusNotFound,
+		},
+		"not_found_status_transport": {
+			err:    Errors{{Response: newTestResponse(http.StatusNotFound), Code: "404", Kind: ErrorKindTransport}},
+			status: http.StatusNotFound,
+		},
+		"already_exists_wrapped": {
+			err:           fmt.Errorf("create stack: %w", Errors{{Response: newTestResponse(http.StatusBadRequest), Code: ErrorCodeAlreadyExist}}),
+			alreadyExists: true,
+			status:        http.StatusBadRequest,
+		},
+		"validation_among_many": {
+			err: Errors{
+				{Response: newTestResponse(http.StatusBadRequest), Code: "other"},
+				{Response: newTestResponse(http.StatusBadRequest), Code: ErrorCodeValidationError, Field: "name"},
+			},
+			validation: true,
+			status:     http.StatusBadRequest,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			if e, a := test.notFound, IsNotFound(test.err); e != a {
+				t.Errorf("IsNotFound: want: %v, got: %v", e, a)
+			}
+			if e, a := test.alreadyExists, IsAlreadyExists(test.err); e != a {
+				t.Errorf("IsAlreadyExists: want: %v, got: %v", e, a)
+			}
+			if e, a := test.validation, IsValidationError(test.err); e != a {
+				t.Errorf("IsValidationError: want: %v, got: %v", e, a)
+			}
+			status, ok := StatusCode(test.err)
+			if e, a := test.status, status; e != a {
+				t.Errorf("StatusCode: want: %v, got: %v", e, a)
+			}
+			if e, a := test.status != 0, ok; e != a {
+				t.Errorf("StatusCode: want ok: %v, got: %v", e, a)
+			}
+		})
+	}
+}
+
+func TestErrorsAs(t *testing.T) {
+	err := fmt.Errorf("read stack: %w", Errors{{Response: newTestResponse(http.StatusNotFound), Code: ErrorCodeNotFound, RequestID: "req-1"}})
+
+	var e Error
+	if !errors.As(err, &e) {
+		t.Fatal("want: true, got: false")
+	}
+	if want, got := "req-1", e.RequestID; want != got {
+		t.Errorf("want: %q, got: %q", want, got)
+	}
+}
+
+func TestValidationErrors(t *testing.T) {
+	resp := newTestResponse(http.StatusBadRequest)
+	err := fmt.Errorf("create stack: %w", Errors{
+		{Response: resp, Code: ErrorCodeValidationError, Field: "name", Message: "name is required"},
+		{Response: resp, Code: "other"},
+		{Response: resp, Code: ErrorCodeValidationError, Field: "iacType", Message: "invalid iac type"},
+	})
+
+	var got []string
+	for _, e := range ValidationErrors(err) {
+		got = append(got, e.Field+": "+e.Message)
+	}
+	want := []string{"name: name is required", "iacType: invalid iac type"}
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("want: %v, got: %v", want, got)
+	}
+}
+
+func TestExtractError(t *testing.T) {
+	html := "<html><body>" + strings.Repeat("502 Bad Gateway ", 100) + "</body></html>"
+
+	tests := map[string]struct {
+		status    int
+		header    http.Header
+		body      string
+		want      Errors
+		transport bool
+	}{
+		"api_error": {
+			status: http.StatusBadRequest,
+			body:   `{"request":{"id":"req-1"},"response":{"errors":[{"code":"validation_error","message":"invalid","field":"name"}]}}`,
+			want: Errors{{
+				Kind:      ErrorKindAPI,
+				RequestID: "req-1",
+				Code:      ErrorCodeValidationError,
+				Message:   "invalid",
+				Field:     "name",
+			}},
+		},
+		"api_error_without_details": {
+			status: http.StatusNotFound,
+			body:   `{"request":{"id":"req-2"},"response":{}}`,
+			want: Errors{{
+				Kind:      ErrorKindAPI,
+				RequestID: "req-2",
+				Code:      "404",
+				Message:   "Not Found",
+			}},
+		},
+		"html_from_proxy": {
+			status: http.StatusBadGateway,
+			header: http.Header{"X-Request-Id": {"lb-1"}},
+			body:   html,
+			want: Errors{{
+				Kind:      ErrorKindTransport,
+				RequestID: "lb-1",
+				Code:      "502",
+				Message:   "Bad Gateway",
+				Body:      html[:maxErrorBodySnippet] + "...",
+			}},
+			transport: true,
+		},
+		"json_without_envelope": {
+			status: http.StatusServiceUnavailable,
+			body:   `{"message":"Service Unavailable"}`,
+			want: Errors{{
+				Kind:    ErrorKindTransport,
+				Code:    "503",
+				Message: "Service Unavailable",
+				Body:    `{"message":"Service Unavailable"}`,
+			}},
+			transport: true,
+		},
+		"empty_body": {
+			status: http.StatusGatewayTimeout,
+			want: Errors{{
+				Kind:    ErrorKindTransport,
+				Code:    "504",
+				Message: "Gateway Timeout",
+			}},
+			transport: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			resp := newTestResponse(test.status)
+			resp.Header = test.header
+			if resp.Header == nil {
+				resp.Header = make(http.Header)
+			}
+			resp.Body = io.NopCloser(strings.NewReader(test.body))
+
+			err := extractError(resp)
+
+			var got Errors
+			if !errors.As(err, &got) {
+				t.Fatalf("want: Errors, got: %T", err)
+			}
+			for i := range got {
+				if got[i].Response != resp {
+					t.Errorf("want response to be set")
+				}
+				got[i].Response, got[i].Header = nil, nil
+			}
+			if !reflect.DeepEqual(test.want, got) {
+				t.Errorf("want: %+v, got: %+v", test.want, got)
+			}
+			if e, a := test.transport, IsTransportError(err); e != a {
+				t.Errorf("IsTransportError: want: %v, got: %v", e, a)
+			}
+			if e, a := test.status, resp.StatusCode; e != a {
+				t.Errorf("want status: %d, got: %d", e, a)
+			}
+
+			body, _ := io.ReadAll(resp.Body)
+			if e, a := test.body, string(body); e != a {
+				t.Errorf("response body was not restored, want: %q, got: %q", e, a)
+			}
+		})
+	}
+}
@@ -0,0 +1,234 @@
+package client
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httputil"
+	"time"
+
+	"github.com/control-monkey/controlmonkey-sdk-go/controlmonkey"
+	"github.com/control-monkey/controlmonkey-sdk-go/controlmonkey/log"
+	"github.com/control-monkey/controlmonkey-sdk-go/controlmonkey/util/redact"
+)
+
+func (c *Client) logf(format string, args ...interface{}) {
+	if c.config.Logger != nil {
+		c.config.Logger.Printf(format, args...)
+	}
+}
+
+// logLevel returns the configured verbosity of request and response logging.
+func (c *Client) logLevel() log.Level {
+	if c.config.Logger == nil {
+		return log.LevelOff
+	}
+	if c.config.LogLevel == 0 {
+		return log.LevelBodies
+	}
+	return c.config.LogLevel
+}
+
+// structuredLogger returns the configured logger if it is a structured one,
+// or nil otherwise.
+func (c *Client) structuredLogger() log.StructuredLogger {
+	sl, _ := c.config.Logger.(log.StructuredLogger)
+	return sl
+}
+
+// sensitiveFields returns the JSON fields to redact from logged bodies.
+func (c *Client) sensitiveFields() []string {
+	return append(redact.DefaultFields(), c.config.SensitiveFields...)
+}
+
+const logReqMsg = `CONTROL MONKEY: Request "%s %s" details:
+---[ REQUEST ]---------------------------------------
+%s
+-----------------------------------------------------`
+
+func (c *Client) logRequest(req *http.Request) {
+	level := c.logLevel()
+	if level < log.LevelHeaders || req == nil || c.structuredLogger() != nil {
+		return
+	}
+
+	out, err := c.dumpRequest(req, level >= log.LevelBodies)
+	if err == nil {
+		c.logf(logReqMsg, req.Method, req.URL, string(out))
+	}
+}
+
+const logRespMsg = `CONTROL MONKEY: Response "%s %s" details (took %s):
+---[ RESPONSE ]----------------------------------------
+%s
+-------------------------------------------------------`
+
+const logRespLineMsg = `CONTROL MONKEY: "%s %s" %s (took %s)`
+
+const logRespErrMsg = `CONTROL MONKEY: "%s %s" failed (took %s): %v`
+
+func (c *Client) logResponse(req *http.Request, resp *http.Response, respErr error, took time.Duration) {
+	level := c.logLevel()
+	if level < log.LevelRequests || req == nil {
+		return
+	}
+
+	if sl := c.structuredLogger(); sl != nil {
+		event := c.event(log.EventRequest, req, resp)
+		event.Duration = took
+		event.Err = respErr
+		if resp != nil && event.RequestID == "" && level >= log.LevelBodies {
+			event.RequestID = peekRequestID(resp)
+		}
+		sl.LogRequest(req.Context(), event)
+		return
+	}
+
+	switch {
+	case resp == nil:
+		c.logf(logRespErrMsg, req.Method, req.URL, took, respErr)
+	case level == log.LevelRequests:
+		c.logf(logRespLineMsg, req.Method, req.URL, resp.Status, took)
+	default:
+		out, err := c.dumpResponse(resp, level >= log.LevelBodies)
+		if err == nil {
+			c.logf(logRespMsg, req.Method, req.URL, took, string(out))
+		}
+	}
+}
+
+const logRetryMsg = `CONTROL MONKEY: Request "%s %s" failed (attempt %d/%d): %s. Retrying in %s`
+
+func (c *Client) logRetry(req *http.Request, resp *http.Response, err error, attempt, maxAttempts int, delay time.Duration) {
+	if c.logLevel() < log.LevelRequests {
+		return
+	}
+
+	if sl := c.structuredLogger(); sl != nil {
+		event := c.event(log.EventRetry, req, resp)
+		event.Attempt = attempt
+		event.MaxAttempts = maxAttempts
+		event.Delay = delay
+		event.Err = err
+		sl.LogRequest(req.Context(), event)
+		return
+	}
+
+	reason := "unknown error"
+	if err != nil {
+		reason = err.Error()
+	} else if resp != nil {
+		reason = resp.Status
+	}
+	c.logf(logRetryMsg, req.Method, req.URL, attempt, maxAttempts, reason, delay)
+}
+
+const logPauseMsg = `CONTROL MONKEY: Request "%s %s" was rate limited. Pausing requests for %s`
+
+func (c *Client) logPause(req *http.Request, resp *http.Response, d time.Duration) {
+	if c.logLevel() < log.LevelRequests {
+		return
+	}
+
+	if sl := c.structuredLogger(); sl != nil {
+		event := c.event(log.EventRateLimited, req, resp)
+		event.Delay = d
+		sl.LogRequest(req.Context(), event)
+		return
+	}
+
+	c.logf(logPauseMsg, req.Method, req.URL, d)
+}
+
+// event returns a structured event of the given kind about req and its
+// response, if any.
+func (c *Client) event(kind log.EventKind, req *http.Request, resp *http.Response) log.RequestEvent {
+	event := log.RequestEvent{
+		Kind:      kind,
+		Operation: controlmonkey.OperationName(req.Context()),
+		Method:    req.Method,
+		Path:      req.URL.Path,
+		Attempt:   controlmonkey.Attempt(req.Context()),
+	}
+	if resp != nil {
+		event.Status = resp.StatusCode
+		event.RequestID = requestIDFromHeader(resp.Header)
+	}
+	return event
+}
+
+// peekRequestID returns the ID assigned to the request by the API, as found
+// in the response body. The body of resp is restored so it can still be read
+// by the caller.
+//
+// It buffers the whole body, so it is only used when bodies are logged.
+func peekRequestID(resp *http.Response) string {
+	if resp.Body == nil || resp.Body == http.NoBody {
+		return ""
+	}
+
+	data, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	resp.Body = io.NopCloser(bytes.NewReader(data))
+	if err != nil {
+		return ""
+	}
+
+	var out Response
+	if err := json.Unmarshal(data, &out); err != nil {
+		return ""
+	}
+	return out.Request.ID
+}
+
+// dumpRequest returns the wire representation of req, with sensitive headers
+// and body fields redacted. The body of req is left untouched.
+func (c *Client) dumpRequest(req *http.Request, withBody bool) ([]byte, error) {
+	clone := req.Clone(req.Context())
+	clone.Header = redact.Header(req.Header, redact.DefaultHeaders()...)
+
+	if withBody && req.Body != nil && req.Body != http.NoBody {
+		if req.GetBody == nil {
+			withBody = false
+		} else {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			data, err := io.ReadAll(body)
+			body.Close()
+			if err != nil {
+				return nil, err
+			}
+			data = redact.JSON(data, c.sensitiveFields()...)
+			clone.Body = io.NopCloser(bytes.NewReader(data))
+			clone.ContentLength = int64(len(data))
+		}
+	}
+
+	return httputil.DumpRequestOut(clone, withBody)
+}
+
+// dumpResponse returns the wire representation of resp, with sensitive
+// headers and body fields redacted. The body of resp is restored so it can
+// still be read by the caller.
+func (c *Client) dumpResponse(resp *http.Response, withBody bool) ([]byte, error) {
+	clone := *resp
+	clone.Header = redact.Header(resp.Header, redact.DefaultHeaders()...)
+
+	if withBody && resp.Body != nil && resp.Body != http.NoBody {
+		data, err := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		resp.Body = io.NopCloser(bytes.NewReader(data))
+		if err != nil {
+			return nil, err
+		}
+		data = redact.JSON(data, c.sensitiveFields()...)
+		clone.Body = io.NopCloser(bytes.NewReader(data))
+		clone.ContentLength = int64(len(data))
+		clone.TransferEncoding = nil
+	}
+
+	return httputil.DumpResponse(&clone, withBody)
+}
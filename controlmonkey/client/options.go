@@ -0,0 +1,73 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+
+	"github.com/control-monkey/controlmonkey-sdk-go/controlmonkey"
+)
+
+type captureKey struct{}
+
+// withCapture returns a copy of ctx carrying the capture claimed by the
+// request sent with it.
+func withCapture(ctx context.Context, capture *controlmonkey.CapturedResponse) context.Context {
+	return context.WithValue(ctx, captureKey{}, capture)
+}
+
+// captureOf returns the capture claimed by the request of resp, if any.
+func captureOf(resp *http.Response) *controlmonkey.CapturedResponse {
+	if resp == nil || resp.Request == nil {
+		return nil
+	}
+	capture, _ := resp.Request.Context().Value(captureKey{}).(*controlmonkey.CapturedResponse)
+	return capture
+}
+
+// recordResponse records the response of a request into capture.
+func recordResponse(capture *controlmonkey.CapturedResponse, resp *http.Response, attempts int) {
+	capture.Response = resp
+	capture.Attempts = attempts
+	capture.RequestID = ""
+	if resp != nil {
+		capture.RequestID = requestIDFromHeader(resp.Header)
+	}
+}
+
+// captureRequestID records the request ID found in the body of resp into the
+// capture claimed by its request, if any, unless it was already found in the
+// headers.
+func captureRequestID(resp *http.Response, requestID string) {
+	if capture := captureOf(resp); capture != nil && capture.RequestID == "" {
+		capture.RequestID = requestID
+	}
+}
+
+// captureBodyRequestID is like captureRequestID, but reads the request ID
+// from the body of a successful response. The body is only read when the ID
+// is needed, and is restored so it can still be read by the caller.
+func captureBodyRequestID(resp *http.Response) {
+	capture := captureOf(resp)
+	if capture == nil || capture.RequestID != "" || resp.Body == nil {
+		return
+	}
+
+	data, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	resp.Body = io.NopCloser(bytes.NewReader(data))
+	if err != nil {
+		return
+	}
+
+	var out struct {
+		Request struct {
+			ID string `json:"id"`
+		} `json:"request"`
+	}
+	if json.Unmarshal(data, &out) == nil {
+		capture.RequestID = out.Request.ID
+	}
+}
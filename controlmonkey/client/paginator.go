@@ -0,0 +1,251 @@
+package client
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"iter"
+	"strconv"
+)
+
+// DefaultPageSize is the number of items fetched per page when no page size
+// is specified.
+const DefaultPageSize = 100
+
+// Default query parameters used to page through list operations, see
+// PageOptions.
+const (
+	DefaultLimitParam  = "limit"
+	DefaultOffsetParam = "offset"
+)
+
+// PageOptions holds the paging parameters of a list operation.
+//
+// Pages are requested PageSize items at a time through the LimitParam and
+// OffsetParam query parameters. Paging is not part of a documented contract
+// of the ControlMonkey API, so the parameter names can be changed, and the
+// Paginator does not rely on the API honouring them. Listing stops at the
+// first page holding fewer than PageSize items, or holding the same items as
+// any previous page: an endpoint ignoring the paging parameters returns every
+// item at once, or the same page over and over again, and is listed with at
+// most one extra request.
+type PageOptions struct {
+	// The number of items fetched per page. Defaults to DefaultPageSize.
+	PageSize int
+
+	// The number of items to skip before the first page.
+	Offset int
+
+	// The name of the query parameter holding the page size. Defaults to
+	// DefaultLimitParam.
+	LimitParam string
+
+	// The name of the query parameter holding the number of items to skip.
+	// Defaults to DefaultOffsetParam.
+	OffsetParam string
+}
+
+// A Pager iterates over the items of a list operation. It is implemented by
+// Paginator, and returned by the paginators of the services so that they can
+// be faked in tests, see NewSlicePager.
+type Pager[T any] interface {
+	// Pages returns an iterator over the pages of items. Iteration stops at
+	// the first error, which is yielded along with a nil page.
+	Pages(ctx context.Context) iter.Seq2[[]*T, error]
+
+	// Items returns an iterator over the items of every page. Iteration
+	// stops at the first error, which is yielded along with a nil item.
+	Items(ctx context.Context) iter.Seq2[*T, error]
+
+	// All fetches every page and returns all the items.
+	All(ctx context.Context) ([]*T, error)
+}
+
+// A Paginator iterates over the items of a list operation, transparently
+// fetching one page at a time.
+type Paginator[T any] struct {
+	client     *Client
+	newRequest func() *Request
+	decode     func(json.RawMessage) (*T, error)
+	opts       PageOptions
+}
+
+// NewPaginator returns a new Paginator sending the requests built by
+// newRequest through c, decoding each item of the response into a T.
+// newRequest is called once per page, and should set the operation filters;
+// the paging parameters are added by the Paginator.
+func NewPaginator[T any](c *Client, newRequest func() *Request, opts *PageOptions) *Paginator[T] {
+	p := &Paginator[T]{
+		client:     c,
+		newRequest: newRequest,
+	}
+	if opts != nil {
+		p.opts = *opts
+	}
+	if p.opts.PageSize <= 0 {
+		p.opts.PageSize = DefaultPageSize
+	}
+	if p.opts.Offset < 0 {
+		p.opts.Offset = 0
+	}
+	if p.opts.LimitParam == "" {
+		p.opts.LimitParam = DefaultLimitParam
+	}
+	if p.opts.OffsetParam == "" {
+		p.opts.OffsetParam = DefaultOffsetParam
+	}
+	return p
+}
+
+// WithDecoder defines the function used to decode each item of the response,
+// for list operations whose items are not a T. By default, items are decoded
+// using json.Unmarshal.
+func (p *Paginator[T]) WithDecoder(decode func(json.RawMessage) (*T, error)) *Paginator[T] {
+	p.decode = decode
+	return p
+}
+
+// Pages returns an iterator over the pages of items. Iteration stops at the
+// first error, which is yielded along with a nil page.
+func (p *Paginator[T]) Pages(ctx context.Context) iter.Seq2[[]*T, error] {
+	return func(yield func([]*T, error) bool) {
+		offset := p.opts.Offset
+		seen := make(map[[sha256.Size]byte]bool)
+
+		for {
+			items, err := p.fetch(ctx, offset)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			if len(items) == 0 {
+				return
+			}
+
+			// Stop if the API ignored the offset, as the same pages would
+			// be returned over and over again.
+			sum := pageSum(items)
+			if seen[sum] {
+				return
+			}
+			seen[sum] = true
+
+			page, err := p.decodeItems(items)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			if !yield(page, nil) {
+				return
+			}
+
+			if len(items) != p.opts.PageSize {
+				return
+			}
+			offset += len(items)
+		}
+	}
+}
+
+// Items returns an iterator over the items of every page. Iteration stops at
+// the first error, which is yielded along with a nil item.
+func (p *Paginator[T]) Items(ctx context.Context) iter.Seq2[*T, error] {
+	return items(p.Pages(ctx))
+}
+
+// All fetches every page and returns all the items.
+func (p *Paginator[T]) All(ctx context.Context) ([]*T, error) {
+	return all(p.Pages(ctx))
+}
+
+// items returns an iterator over the items of every page of pages.
+func items[T any](pages iter.Seq2[[]*T, error]) iter.Seq2[*T, error] {
+	return func(yield func(*T, error) bool) {
+		for page, err := range pages {
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			for _, item := range page {
+				if !yield(item, nil) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// all returns the items of every page of pages.
+func all[T any](pages iter.Seq2[[]*T, error]) ([]*T, error) {
+	out := make([]*T, 0)
+	for page, err := range pages {
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, page...)
+	}
+	return out, nil
+}
+
+// fetch fetches the raw items of the page starting at offset.
+func (p *Paginator[T]) fetch(ctx context.Context, offset int) ([]json.RawMessage, error) {
+	r := p.newRequest()
+	r.Params.Set(p.opts.LimitParam, strconv.Itoa(p.opts.PageSize))
+	r.Params.Set(p.opts.OffsetParam, strconv.Itoa(offset))
+
+	return doRaw(ctx, p.client, r)
+}
+
+// pageSum returns a checksum of the raw items of a page, so that pages
+// returned more than once are detected without keeping them.
+func pageSum(items []json.RawMessage) [sha256.Size]byte {
+	h := sha256.New()
+	for _, item := range items {
+		h.Write(strconv.AppendInt(nil, int64(len(item)), 10))
+		h.Write([]byte{':'})
+		h.Write(item)
+	}
+	var sum [sha256.Size]byte
+	h.Sum(sum[:0])
+	return sum
+}
+
+// decodeItems decodes each raw item into a T, using the decoder of the
+// Paginator if any.
+func (p *Paginator[T]) decodeItems(items []json.RawMessage) ([]*T, error) {
+	if p.decode == nil {
+		return decodeItems[T](items)
+	}
+	return decodeItemsWith(items, p.decode)
+}
+
+// NewSlicePager returns a Pager yielding items as a single page, or err
+// alone if not nil, e.g. to stub the paginators of the services in tests.
+func NewSlicePager[T any](items []*T, err error) Pager[T] {
+	return &slicePager[T]{items: items, err: err}
+}
+
+// A slicePager is a Pager over in-memory items.
+type slicePager[T any] struct {
+	items []*T
+	err   error
+}
+
+func (p *slicePager[T]) Pages(context.Context) iter.Seq2[[]*T, error] {
+	return func(yield func([]*T, error) bool) {
+		switch {
+		case p.err != nil:
+			yield(nil, p.err)
+		case len(p.items) > 0:
+			yield(p.items, nil)
+		}
+	}
+}
+
+func (p *slicePager[T]) Items(ctx context.Context) iter.Seq2[*T, error] {
+	return items(p.Pages(ctx))
+}
+
+func (p *slicePager[T]) All(ctx context.Context) ([]*T, error) {
+	return all(p.Pages(ctx))
+}
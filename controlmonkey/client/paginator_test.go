@@ -0,0 +1,351 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+)
+
+type testItem struct {
+	ID int `json:"id"`
+}
+
+// newPagingServer returns a server listing total items, honoring the limit
+// and offset query parameters, except the ones listed in ignore. An offset
+// listed as "offset_wrap" wraps around the items.
+func newPagingServer(t *testing.T, total int, ignore []string, requests *[]string) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		*requests = append(*requests, r.URL.RawQuery)
+
+		limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+		offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+		for _, param := range ignore {
+			switch param {
+			case "limit":
+				limit = total
+			case "offset":
+				offset = 0
+			case "offset_wrap":
+				offset %= total
+			}
+		}
+
+		items := make([]json.RawMessage, 0)
+		for i := offset; i < offset+limit && i < total; i++ {
+			items = append(items, json.RawMessage(fmt.Sprintf(`{"id":%d}`, i)))
+		}
+
+		var out Response
+		out.Response.Items = items
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(out); err != nil {
+			t.Fatal(err)
+		}
+	}))
+}
+
+func TestPaginatorAll(t *testing.T) {
+	tests := map[string]struct {
+		total        int
+		opts         *PageOptions
+		ignore       []string
+		wantItems    int
+		wantRequests []string
+	}{
+		"empty": {
+			total:        0,
+			opts:         &PageOptions{PageSize: 2},
+			wantItems:    0,
+			wantRequests: []string{"limit=2&name=x&offset=0"},
+		},
+		"partial_last_page": {
+			total:     5,
+			opts:      &PageOptions{PageSize: 2},
+			wantItems: 5,
+			wantRequests: []string{
+				"limit=2&name=x&offset=0",
+				"limit=2&name=x&offset=2",
+				"limit=2&name=x&offset=4",
+			},
+		},
+		"full_last_page": {
+			total:     4,
+			opts:      &PageOptions{PageSize: 2},
+			wantItems: 4,
+			wantRequests: []string{
+				"limit=2&name=x&offset=0",
+				"limit=2&name=x&offset=2",
+				"limit=2&name=x&offset=4",
+			},
+		},
+		"offset": {
+			total:        5,
+			opts:         &PageOptions{PageSize: 10, Offset: 3},
+			wantItems:    2,
+			wantRequests: []string{"limit=10&name=x&offset=3"},
+		},
+		"default_page_size": {
+			total:        3,
+			wantItems:    3,
+			wantRequests: []string{"limit=100&name=x&offset=0"},
+		},
+		"paging_ignored": {
+			total:     5,
+			opts:      &PageOptions{PageSize: 2},
+			ignore:    []string{"limit", "offset"},
+			wantItems: 5,
+			wantRequests: []string{
+				"limit=2&name=x&offset=0",
+			},
+		},
+		"paging_ignored_full_page": {
+			total:     2,
+			opts:      &PageOptions{PageSize: 2},
+			ignore:    []string{"limit", "offset"},
+			wantItems: 2,
+			wantRequests: []string{
+				"limit=2&name=x&offset=0",
+				"limit=2&name=x&offset=2",
+			},
+		},
+		"limit_ignored": {
+			total:     5,
+			opts:      &PageOptions{PageSize: 2},
+			ignore:    []string{"limit"},
+			wantItems: 5,
+			wantRequests: []string{
+				"limit=2&name=x&offset=0",
+			},
+		},
+		"offset_wrapped": {
+			total:     4,
+			opts:      &PageOptions{PageSize: 2},
+			ignore:    []string{"offset_wrap"},
+			wantItems: 4,
+			wantRequests: []string{
+				"limit=2&name=x&offset=0",
+				"limit=2&name=x&offset=2",
+				"limit=2&name=x&offset=4",
+			},
+		},
+		"offset_ignored": {
+			total:     5,
+			opts:      &PageOptions{PageSize: 2},
+			ignore:    []string{"offset"},
+			wantItems: 2,
+			wantRequests: []string{
+				"limit=2&name=x&offset=0",
+				"limit=2&name=x&offset=2",
+			},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			var requests []string
+			srv := newPagingServer(t, tc.total, tc.ignore, &requests)
+			defer srv.Close()
+
+			c := New(newTestConfig(srv.URL))
+			p := NewPaginator[testItem](c, func() *Request {
+				r := NewRequest(http.MethodGet, "/item")
+				r.Params.Set("name", "x")
+				return r
+			}, tc.opts)
+
+			items, err := p.All(context.Background())
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if len(items) != tc.wantItems {
+				t.Errorf("want: %d items, got: %d", tc.wantItems, len(items))
+			}
+			for i, item := range items {
+				if want := i + startOffset(tc.opts); item.ID != want {
+					t.Errorf("want: item %d, got: %d", want, item.ID)
+				}
+			}
+			if fmt.Sprint(requests) != fmt.Sprint(tc.wantRequests) {
+				t.Errorf("want: %v, got: %v", tc.wantRequests, requests)
+			}
+		})
+	}
+}
+
+func startOffset(opts *PageOptions) int {
+	if opts == nil {
+		return 0
+	}
+	return opts.Offset
+}
+
+func TestPaginatorParams(t *testing.T) {
+	var requests []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.URL.RawQuery)
+		fmt.Fprint(w, `{"response":{"items":[{"id":1}]}}`)
+	}))
+	defer srv.Close()
+
+	c := New(newTestConfig(srv.URL))
+	p := NewPaginator[testItem](c, func() *Request {
+		return NewRequest(http.MethodGet, "/item")
+	}, &PageOptions{PageSize: 2, Offset: 1, LimitParam: "page_size", OffsetParam: "skip"})
+
+	if _, err := p.All(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if want := []string{"page_size=2&skip=1"}; fmt.Sprint(requests) != fmt.Sprint(want) {
+		t.Errorf("want: %v, got: %v", want, requests)
+	}
+}
+
+func TestPaginatorItemsBreak(t *testing.T) {
+	var requests []string
+	srv := newPagingServer(t, 10, nil, &requests)
+	defer srv.Close()
+
+	c := New(newTestConfig(srv.URL))
+	p := NewPaginator[testItem](c, func() *Request {
+		return NewRequest(http.MethodGet, "/item")
+	}, &PageOptions{PageSize: 3})
+
+	var got []int
+	for item, err := range p.Items(context.Background()) {
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		got = append(got, item.ID)
+		if len(got) == 4 {
+			break
+		}
+	}
+
+	if want := []int{0, 1, 2, 3}; fmt.Sprint(got) != fmt.Sprint(want) {
+		t.Errorf("want: %v, got: %v", want, got)
+	}
+	if len(requests) != 2 {
+		t.Errorf("want: 2 requests, got: %d", len(requests))
+	}
+}
+
+func TestPaginatorError(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, `{"response":{"errors":[{"code":"validation_error","message":"bad"}]}}`)
+	}))
+	defer srv.Close()
+
+	c := New(newTestConfig(srv.URL))
+	p := NewPaginator[testItem](c, func() *Request {
+		return NewRequest(http.MethodGet, "/item")
+	}, nil)
+
+	var n int
+	for item, err := range p.Items(context.Background()) {
+		n++
+		if item != nil {
+			t.Errorf("want: nil item, got: %v", item)
+		}
+		if !IsValidationError(err) {
+			t.Errorf("want: validation error, got: %v", err)
+		}
+	}
+	if n != 1 {
+		t.Errorf("want: 1 iteration, got: %d", n)
+	}
+
+	if _, err := p.All(context.Background()); !IsValidationError(err) {
+		t.Errorf("want: validation error, got: %v", err)
+	}
+}
+
+func TestPaginatorWithDecoder(t *testing.T) {
+	var requests []string
+	srv := newPagingServer(t, 3, nil, &requests)
+	defer srv.Close()
+
+	c := New(newTestConfig(srv.URL))
+	p := NewPaginator[string](c, func() *Request {
+		return NewRequest(http.MethodGet, "/item")
+	}, nil).WithDecoder(func(in json.RawMessage) (*string, error) {
+		var item testItem
+		if err := json.Unmarshal(in, &item); err != nil {
+			return nil, err
+		}
+		s := fmt.Sprintf("item-%d", item.ID)
+		return &s, nil
+	})
+
+	items, err := p.All(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var got []string
+	for _, item := range items {
+		got = append(got, *item)
+	}
+	if want := []string{"item-0", "item-1", "item-2"}; fmt.Sprint(got) != fmt.Sprint(want) {
+		t.Errorf("want: %v, got: %v", want, got)
+	}
+}
+
+func TestSlicePager(t *testing.T) {
+	errList := errors.New("list failed")
+
+	tests := map[string]struct {
+		items     []*testItem
+		err       error
+		wantPages int
+		wantItems int
+		wantErr   error
+	}{
+		"items": {
+			items:     []*testItem{{ID: 0}, {ID: 1}},
+			wantPages: 1,
+			wantItems: 2,
+		},
+		"empty": {
+			wantPages: 0,
+			wantItems: 0,
+		},
+		"error": {
+			items:     []*testItem{{ID: 0}},
+			err:       errList,
+			wantPages: 1,
+			wantErr:   errList,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			var p Pager[testItem] = NewSlicePager(tc.items, tc.err)
+
+			var pages int
+			for _, err := range p.Pages(context.Background()) {
+				pages++
+				if !errors.Is(err, tc.wantErr) {
+					t.Errorf("want: %v, got: %v", tc.wantErr, err)
+				}
+			}
+			if pages != tc.wantPages {
+				t.Errorf("want: %d pages, got: %d", tc.wantPages, pages)
+			}
+
+			items, err := p.All(context.Background())
+			if !errors.Is(err, tc.wantErr) {
+				t.Errorf("want: %v, got: %v", tc.wantErr, err)
+			}
+			if len(items) != tc.wantItems {
+				t.Errorf("want: %d items, got: %d", tc.wantItems, len(items))
+			}
+		})
+	}
+}
@@ -15,6 +15,11 @@ type Request struct {
 	Obj    interface{}
 	Entity interface{}
 	Params url.Values
+
+	// Operation is the logical name of the operation performed by the
+	// request, e.g. "stack.CreateStack".
+	Operation string
+
 	url    *url.URL
 	method string
 	body   io.Reader
@@ -73,6 +78,10 @@ func (r *Request) toHTTP(ctx context.Context, cfg *controlmonkey.Config, shouldW
 	req.Header.Add("Accept", cfg.ContentType)
 	req.Header.Add("User-Agent", cfg.UserAgent)
 
+	if r.Operation != "" {
+		ctx = controlmonkey.WithOperationName(ctx, r.Operation)
+	}
+
 	return req.WithContext(ctx), nil
 }
 
@@ -5,9 +5,9 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
-	"io/ioutil"
 	"net/http"
 	"strconv"
+	"strings"
 )
 
 type Response struct {
@@ -26,21 +26,66 @@ type responseError struct {
 	Field   string `json:"field"`
 }
 
+// ErrorKind classifies where an Error originated from.
+type ErrorKind int
+
+const (
+	// ErrorKindAPI is an error reported by the ControlMonkey API itself, in
+	// its usual JSON envelope.
+	ErrorKindAPI ErrorKind = iota
+
+	// ErrorKindTransport is an error reported by an intermediary, e.g. a
+	// load balancer or proxy answering with an HTML error page, or an error
+	// response whose body could not be read or understood.
+	ErrorKindTransport
+)
+
+// String returns the string representation of the error kind.
+func (k ErrorKind) String() string {
+	switch k {
+	case ErrorKindAPI:
+		return "api"
+	case ErrorKindTransport:
+		return "transport"
+	default:
+		return "unknown"
+	}
+}
+
 type Error struct {
 	Response  *http.Response `json:"-"`
 	Code      string         `json:"code"`
 	Message   string         `json:"message"`
 	Field     string         `json:"field"`
 	RequestID string         `json:"requestId"`
+
+	// Kind states whether the error was reported by the API or by an
+	// intermediary.
+	Kind ErrorKind `json:"-"`
+
+	// Header holds the headers of the response.
+	Header http.Header `json:"-"`
+
+	// Body holds the raw response body, truncated to maxErrorBodySnippet
+	// bytes. It is only set for transport errors.
+	Body string `json:"-"`
 }
 
 func (e Error) Error() string {
+	var method, url string
+	if e.Response != nil && e.Response.Request != nil {
+		method, url = e.Response.Request.Method, e.Response.Request.URL.String()
+	}
+
 	msg := fmt.Sprintf("%v %v: %d (request: %q) %v: %v",
-		e.Response.Request.Method, e.Response.Request.URL, e.Response.StatusCode, e.RequestID, e.Code, e.Message)
+		method, url, e.StatusCode(), e.RequestID, e.Code, e.Message)
 
 	if e.Field != "" {
 		msg = fmt.Sprintf("%s (field: %v)", msg, e.Field)
 	}
+	if e.Kind == ErrorKindTransport && e.Body != "" {
+		msg = fmt.Sprintf("%s (body: %q)", msg, e.Body)
+	}
 
 	return msg
 }
@@ -61,6 +106,10 @@ func DecodeBody(resp *http.Response, out interface{}) error {
 }
 
 // RequireOK is used to verify response status code is a successful one (200 OK)
+//
+// The request ID found in the body of the response is recorded into the
+// response capture of the request, if any; see
+// controlmonkey.WithResponseCapture.
 func RequireOK(resp *http.Response, err error) (*http.Response, error) {
 	if err != nil {
 		return nil, err
@@ -68,21 +117,61 @@ func RequireOK(resp *http.Response, err error) (*http.Response, error) {
 	if resp.StatusCode != http.StatusOK {
 		return nil, extractError(resp)
 	}
+	captureBodyRequestID(resp)
 	return resp, nil
 }
 
-// extractError is used to extract inner/logical errors from the response
-func extractError(resp *http.Response) error {
-	buf := bytes.NewBuffer(nil)
+// maxErrorBodySnippet is the maximum number of bytes of the raw response
+// body kept in a transport Error.
+const maxErrorBodySnippet = 512
 
-	// TeeReader returns a Reader that writes to b what it reads from r.Body.
-	reader := io.TeeReader(resp.Body, buf)
-	defer resp.Body.Close()
-	resp.Body = ioutil.NopCloser(buf)
+// requestIDHeaders are the headers that may carry the request ID when the
+// response body does not.
+var requestIDHeaders = []string{"X-Request-Id", "X-Amzn-Requestid"}
+
+// extractError is used to extract inner/logical errors from the response.
+//
+// It always returns a non-empty Errors, even when the body is not a valid API
+// response (e.g. an HTML error page served by a load balancer), in which case
+// a single Error of kind ErrorKindTransport is returned. The response body is
+// restored so it can still be read by the caller.
+func extractError(resp *http.Response) error {
+	var data []byte
+	var readErr error
+	if resp.Body != nil {
+		data, readErr = io.ReadAll(resp.Body)
+		resp.Body.Close()
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(data))
 
 	var out Response
-	if err := json.NewDecoder(reader).Decode(&out); err != nil {
-		return err
+	if readErr == nil && json.Unmarshal(data, &out) == nil &&
+		(out.Request.ID != "" || len(out.Response.Errors) > 0) {
+		captureRequestID(resp, out.Request.ID)
+		return apiErrors(resp, out)
+	}
+
+	e := Error{
+		Response:  resp,
+		Kind:      ErrorKindTransport,
+		Header:    resp.Header,
+		RequestID: requestIDFromHeader(resp.Header),
+		Code:      strconv.Itoa(resp.StatusCode),
+		Message:   http.StatusText(resp.StatusCode),
+		Body:      truncate(data, maxErrorBodySnippet),
+	}
+	if readErr != nil {
+		e.Message = fmt.Sprintf("%s: failed to read response body: %v", e.Message, readErr)
+	}
+
+	return Errors{e}
+}
+
+// apiErrors converts the errors of an API response to Errors.
+func apiErrors(resp *http.Response, out Response) Errors {
+	requestID := out.Request.ID
+	if requestID == "" {
+		requestID = requestIDFromHeader(resp.Header)
 	}
 
 	var errors Errors
@@ -90,7 +179,9 @@ func extractError(resp *http.Response) error {
 		for _, err := range errs {
 			errors = append(errors, Error{
 				Response:  resp,
-				RequestID: out.Request.ID,
+				Kind:      ErrorKindAPI,
+				Header:    resp.Header,
+				RequestID: requestID,
 				Code:      err.Code,
 				Message:   err.Message,
 				Field:     err.Field,
@@ -99,7 +190,9 @@ func extractError(resp *http.Response) error {
 	} else {
 		errors = append(errors, Error{
 			Response:  resp,
-			RequestID: out.Request.ID,
+			Kind:      ErrorKindAPI,
+			Header:    resp.Header,
+			RequestID: requestID,
 			Code:      strconv.Itoa(resp.StatusCode),
 			Message:   http.StatusText(resp.StatusCode),
 		})
@@ -107,3 +200,19 @@ func extractError(resp *http.Response) error {
 
 	return errors
 }
+
+func requestIDFromHeader(h http.Header) string {
+	for _, name := range requestIDHeaders {
+		if id := h.Get(name); id != "" {
+			return id
+		}
+	}
+	return ""
+}
+
+func truncate(data []byte, n int) string {
+	if len(data) <= n {
+		return string(data)
+	}
+	return strings.ToValidUTF8(string(data[:n]), "") + "..."
+}
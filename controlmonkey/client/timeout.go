@@ -0,0 +1,100 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+)
+
+// A TimeoutError is returned when an operation did not complete within the
+// timeout applied by the client, i.e. the RequestTimeout or operation
+// timeout of the config, applied when the context passed by the caller has
+// no deadline.
+//
+// It matches context.DeadlineExceeded using errors.Is.
+type TimeoutError struct {
+	// Operation is the name of the operation that timed out, if known.
+	Operation string
+
+	// Duration is the timeout that was applied.
+	Duration time.Duration
+
+	// Err is the error the operation failed with, if any.
+	Err error
+}
+
+// Error returns the string representation of the error.
+func (e *TimeoutError) Error() string {
+	op := e.Operation
+	if op == "" {
+		op = "request"
+	}
+	return fmt.Sprintf("controlmonkey: %s timed out after %s", op, e.Duration)
+}
+
+// Timeout reports that the error is a timeout, as net.Error does.
+func (e *TimeoutError) Timeout() bool { return true }
+
+// Unwrap returns context.DeadlineExceeded along with the underlying error.
+func (e *TimeoutError) Unwrap() []error {
+	if e.Err == nil {
+		return []error{context.DeadlineExceeded}
+	}
+	return []error{context.DeadlineExceeded, e.Err}
+}
+
+// IsTimeout reports whether err is, or wraps, a TimeoutError.
+func IsTimeout(err error) bool {
+	var e *TimeoutError
+	return errors.As(err, &e)
+}
+
+// withTimeout returns a copy of ctx that times out after the timeout of the
+// operation, unless ctx already has a deadline.
+func (c *Client) withTimeout(ctx context.Context, operation string) (context.Context, context.CancelFunc) {
+	if _, ok := ctx.Deadline(); ok {
+		return ctx, func() {}
+	}
+	timeout := c.config.Timeout(operation)
+	if timeout <= 0 {
+		return ctx, func() {}
+	}
+	cause := &TimeoutError{Operation: operation, Duration: timeout}
+	return context.WithTimeoutCause(ctx, timeout, cause)
+}
+
+// timeoutError returns a TimeoutError wrapping err if ctx timed out because
+// of the timeout applied by withTimeout, err otherwise.
+func timeoutError(ctx context.Context, err error) error {
+	if err == nil || ctx.Err() == nil {
+		return err
+	}
+	var cause *TimeoutError
+	if !errors.As(context.Cause(ctx), &cause) || errors.As(err, new(*TimeoutError)) {
+		return err
+	}
+	return &TimeoutError{Operation: cause.Operation, Duration: cause.Duration, Err: err}
+}
+
+// timeoutBody is a response body that releases the timeout of the operation
+// once closed, and reports reads interrupted by it as TimeoutError.
+type timeoutBody struct {
+	io.ReadCloser
+	ctx    context.Context
+	cancel context.CancelFunc
+}
+
+func (b *timeoutBody) Read(p []byte) (int, error) {
+	n, err := b.ReadCloser.Read(p)
+	if err != nil && err != io.EOF {
+		err = timeoutError(b.ctx, err)
+	}
+	return n, err
+}
+
+func (b *timeoutBody) Close() error {
+	defer b.cancel()
+	return b.ReadCloser.Close()
+}
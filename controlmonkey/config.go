@@ -1,6 +1,8 @@
 package controlmonkey
 
 import (
+	"errors"
+	"fmt"
 	"net"
 	"net/http"
 	"net/url"
@@ -20,6 +22,14 @@ const (
 
 	// defaultContentType is the default content type to use when making HTTP calls.
 	defaultContentType = "application/json"
+
+	// DefaultRequestTimeout is a suggested timeout of an operation, e.g. to
+	// pass to Config.WithRequestTimeout. Operations have no timeout by
+	// default.
+	DefaultRequestTimeout = 5 * time.Minute
+
+	// NoTimeout disables the timeout of operations.
+	NoTimeout time.Duration = -1
 )
 
 // A Config provides Configuration to a service client instance.
@@ -44,9 +54,58 @@ type Config struct {
 	// Defaults to standard out.
 	Logger log.Logger
 
+	// The verbosity of request and response logging.
+	//
+	// Defaults to log.LevelBodies when a Logger is set.
+	LogLevel log.Level
+
+	// The JSON fields to redact from logged request and response bodies, on
+	// top of redact.DefaultFields. Authorization headers are always redacted.
+	SensitiveFields []string
+
 	// The User-Agent and Content-Type HTTP headers to set when invoking HTTP
 	// requests.
 	UserAgent, ContentType string
+
+	// The timeout of an operation, including its retries, applied when the
+	// context passed by the caller has no deadline. A negative value disables
+	// the timeout.
+	//
+	// Defaults to zero, meaning operations only end with the context passed
+	// by the caller. DefaultRequestTimeout is a suggested value.
+	RequestTimeout time.Duration
+
+	// The timeouts of specific operations, overriding RequestTimeout, by
+	// operation name, e.g. "stack.CreatePlan".
+	OperationTimeouts map[string]time.Duration
+
+	// The policy used to retry failed requests.
+	//
+	// Defaults to nil, meaning requests are not retried. DefaultRetryPolicy
+	// retries idempotent requests on transient network errors and
+	// 429/502/503/504 responses.
+	RetryPolicy *RetryPolicy
+
+	// The client-side rate limiter used to throttle outgoing requests.
+	//
+	// Defaults to nil, meaning requests are not throttled. Share a single
+	// RateLimiter between clients so they pause together when the API
+	// answers with 429 Too Many Requests.
+	RateLimiter *RateLimiter
+
+	// The middlewares wrapping every HTTP request sent by the client, in the
+	// order they were added.
+	Middlewares []Middleware
+
+	// The instrumentation observing every operation, e.g. to emit traces and
+	// metrics.
+	//
+	// Defaults to nil, meaning operations are not instrumented.
+	Instrumentation Instrumentation
+
+	// err records the errors met by the builder methods, e.g. a base URL
+	// that failed to parse. It is reported by Validate.
+	err error
 }
 
 // DefaultBaseURL returns the default base URL.
@@ -71,9 +130,10 @@ func DefaultContentType() string {
 }
 
 // DefaultTransport returns a new http.Transport with similar default values to
-// http.DefaultTransport. Do not use this for transient transports as it can
-// leak file descriptors over time. Only use this for transports that will be
-// re-used for the same host(s).
+// http.DefaultTransport, but keeping more idle connections to the API so that
+// concurrent requests reuse them. Do not use this for transient transports as
+// it can leak file descriptors over time. Only use this for transports that
+// will be re-used for the same host(s). See NewTransport to customize it.
 func DefaultTransport() *http.Transport {
 	return &http.Transport{
 		Proxy: http.ProxyFromEnvironment,
@@ -81,15 +141,18 @@ func DefaultTransport() *http.Transport {
 			Timeout:   30 * time.Second,
 			KeepAlive: 30 * time.Second,
 		}).DialContext,
-		TLSHandshakeTimeout: 10 * time.Second,
-		DisableKeepAlives:   false,
-		MaxIdleConnsPerHost: 1,
+		ForceAttemptHTTP2:     true,
+		TLSHandshakeTimeout:   10 * time.Second,
+		ExpectContinueTimeout: time.Second,
+		DisableKeepAlives:     false,
+		MaxIdleConns:          defaultMaxIdleConns,
+		MaxIdleConnsPerHost:   defaultMaxIdleConnsPerHost,
+		IdleConnTimeout:       defaultIdleConnTimeout,
 	}
 }
 
 // DefaultHTTPClient returns a new http.Client with similar default values to
-// http.Client, but with a non-shared Transport, idle connections disabled, and
-// KeepAlives disabled.
+// http.Client, but with a non-shared Transport.
 func DefaultHTTPClient() *http.Client {
 	return &http.Client{
 		Transport: DefaultTransport(),
@@ -100,22 +163,48 @@ func DefaultHTTPClient() *http.Client {
 // will pool and reuse idle connections to API. If you have a long-lived client
 // object, this is the desired behavior and should make the most efficient use
 // of the connections to API.
+//
+// Only the credentials are read from the environment and the shared
+// credentials file. The other settings, e.g. EnvVarBaseURL, are only read by
+// LoadConfig.
 func DefaultConfig() *Config {
-	return &Config{
+	cfg := &Config{
 		BaseURL:     DefaultBaseURL(),
 		HTTPClient:  DefaultHTTPClient(),
 		UserAgent:   DefaultUserAgent(),
 		ContentType: DefaultContentType(),
-		Credentials: credentials.NewChainCredentials(
-			new(credentials.EnvProvider),
-			new(credentials.FileProvider),
-		),
+	}
+	cfg.Credentials = credentials.NewChainCredentials(
+		new(credentials.EnvProvider),
+		new(credentials.FileProvider),
+		cfg.webIdentityProvider(),
+	)
+	return cfg
+}
+
+// webIdentityProvider returns a WebIdentityProvider exchanging tokens
+// through the transport of the HTTP client of c, as set at the time of the
+// exchange, so that the CA bundle, client certificate and proxy of c also
+// apply to the token exchange endpoint.
+func (c *Config) webIdentityProvider() *credentials.WebIdentityProvider {
+	return &credentials.WebIdentityProvider{
+		HTTPClient: &http.Client{
+			Transport: configTransport{cfg: c},
+			Timeout:   credentials.DefaultWebIdentityTimeout,
+		},
 	}
 }
 
 // WithBaseURL defines the base URL of the ControlMonkey API.
+//
+// A malformed URL is reported by Validate, and by every request sent with
+// the config.
 func (c *Config) WithBaseURL(rawurl string) *Config {
-	baseURL, _ := url.Parse(rawurl)
+	baseURL, err := url.Parse(rawurl)
+	if err != nil {
+		c.err = errors.Join(c.err, fmt.Errorf("base URL: %w", err))
+		return c
+	}
 	c.BaseURL = baseURL
 	return c
 }
@@ -126,6 +215,27 @@ func (c *Config) WithHTTPClient(client *http.Client) *Config {
 	return c
 }
 
+// WithTransportOptions defines the options of the HTTP transport, e.g. root
+// CAs, a client certificate or a proxy. It replaces the HTTP client by a new
+// one using NewTransport, keeping the timeout of the current one.
+//
+// Errors, e.g. an unreadable CA bundle, are reported by Validate, and by
+// every request sent with the config.
+func (c *Config) WithTransportOptions(opts *TransportOptions) *Config {
+	transport, err := NewTransport(opts)
+	if err != nil {
+		c.err = errors.Join(c.err, err)
+		return c
+	}
+	client := new(http.Client)
+	if c.HTTPClient != nil {
+		*client = *c.HTTPClient
+	}
+	client.Transport = transport
+	c.HTTPClient = client
+	return c
+}
+
 // WithCredentials defines the credentials.
 func (c *Config) WithCredentials(creds *credentials.Credentials) *Config {
 	c.Credentials = creds
@@ -151,6 +261,75 @@ func (c *Config) WithLogger(logger log.Logger) *Config {
 	return c
 }
 
+// WithLogLevel defines the verbosity of request and response logging.
+func (c *Config) WithLogLevel(level log.Level) *Config {
+	c.LogLevel = level
+	return c
+}
+
+// WithSensitiveFields appends JSON fields to redact from logged request and
+// response bodies, on top of redact.DefaultFields.
+func (c *Config) WithSensitiveFields(fields ...string) *Config {
+	c.SensitiveFields = append(c.SensitiveFields, fields...)
+	return c
+}
+
+// WithRequestTimeout defines the timeout of operations, applied when the
+// context passed by the caller has no deadline. NoTimeout disables it.
+func (c *Config) WithRequestTimeout(timeout time.Duration) *Config {
+	c.RequestTimeout = timeout
+	return c
+}
+
+// WithOperationTimeout defines the timeout of a specific operation, e.g.
+// "stack.CreatePlan", overriding the request timeout.
+func (c *Config) WithOperationTimeout(operation string, timeout time.Duration) *Config {
+	timeouts := make(map[string]time.Duration, len(c.OperationTimeouts)+1)
+	for op, t := range c.OperationTimeouts {
+		timeouts[op] = t
+	}
+	timeouts[operation] = timeout
+	c.OperationTimeouts = timeouts
+	return c
+}
+
+// Timeout returns the timeout of an operation: its own timeout if set,
+// RequestTimeout otherwise. A value lower than or equal to zero means no
+// timeout.
+func (c *Config) Timeout(operation string) time.Duration {
+	if t, ok := c.OperationTimeouts[operation]; ok {
+		return t
+	}
+	return c.RequestTimeout
+}
+
+// WithRetryPolicy defines the policy used to retry failed requests, e.g.
+// DefaultRetryPolicy. Use NoRetryPolicy to disable retries.
+func (c *Config) WithRetryPolicy(policy *RetryPolicy) *Config {
+	c.RetryPolicy = policy
+	return c
+}
+
+// WithRateLimiter defines the client-side rate limiter used to throttle
+// outgoing requests.
+func (c *Config) WithRateLimiter(limiter *RateLimiter) *Config {
+	c.RateLimiter = limiter
+	return c
+}
+
+// WithMiddleware appends middlewares wrapping every HTTP request sent by the
+// client. The first middleware is the outermost one.
+func (c *Config) WithMiddleware(middlewares ...Middleware) *Config {
+	c.Middlewares = append(c.Middlewares, middlewares...)
+	return c
+}
+
+// WithInstrumentation defines the instrumentation observing every operation.
+func (c *Config) WithInstrumentation(inst Instrumentation) *Config {
+	c.Instrumentation = inst
+	return c
+}
+
 // Merge merges the passed in configs into the existing config object.
 func (c *Config) Merge(cfgs ...*Config) {
 	for _, cfg := range cfgs {
@@ -162,6 +341,9 @@ func mergeConfigs(c1, c2 *Config) {
 	if c2 == nil {
 		return
 	}
+	if c2.err != nil {
+		c1.err = errors.Join(c1.err, c2.err)
+	}
 	if c2.BaseURL != nil {
 		c1.BaseURL = c2.BaseURL
 	}
@@ -180,4 +362,83 @@ func mergeConfigs(c1, c2 *Config) {
 	if c2.Logger != nil {
 		c1.Logger = c2.Logger
 	}
+	if c2.LogLevel != 0 {
+		c1.LogLevel = c2.LogLevel
+	}
+	if len(c2.SensitiveFields) > 0 {
+		fields := make([]string, 0, len(c1.SensitiveFields)+len(c2.SensitiveFields))
+		fields = append(fields, c1.SensitiveFields...)
+		c1.SensitiveFields = append(fields, c2.SensitiveFields...)
+	}
+	if c2.RequestTimeout != 0 {
+		c1.RequestTimeout = c2.RequestTimeout
+	}
+	if len(c2.OperationTimeouts) > 0 {
+		timeouts := make(map[string]time.Duration, len(c1.OperationTimeouts)+len(c2.OperationTimeouts))
+		for op, t := range c1.OperationTimeouts {
+			timeouts[op] = t
+		}
+		for op, t := range c2.OperationTimeouts {
+			timeouts[op] = t
+		}
+		c1.OperationTimeouts = timeouts
+	}
+	if c2.RetryPolicy != nil {
+		c1.RetryPolicy = c2.RetryPolicy
+	}
+	if c2.RateLimiter != nil {
+		c1.RateLimiter = c2.RateLimiter
+	}
+	if c2.Instrumentation != nil {
+		c1.Instrumentation = c2.Instrumentation
+	}
+	if len(c2.Middlewares) > 0 {
+		mws := make([]Middleware, 0, len(c1.Middlewares)+len(c2.Middlewares))
+		mws = append(mws, c1.Middlewares...)
+		c1.Middlewares = append(mws, c2.Middlewares...)
+	}
+}
+
+// ErrInvalidConfig is returned by Validate when the config is invalid.
+var ErrInvalidConfig = errors.New("controlmonkey: invalid config")
+
+// Validate reports whether the config can be used to send requests. The
+// returned error wraps ErrInvalidConfig along with every problem found, e.g.
+// a malformed base URL.
+func (c *Config) Validate() error {
+	var errs []error
+
+	if c.err != nil {
+		errs = append(errs, c.err)
+	}
+
+	switch u := c.BaseURL; {
+	case u == nil:
+		errs = append(errs, errors.New("base URL is not set"))
+	case u.Scheme != "http" && u.Scheme != "https":
+		errs = append(errs, fmt.Errorf("base URL %q: scheme must be http or https", u))
+	case u.Host == "":
+		errs = append(errs, fmt.Errorf("base URL %q: missing host", u))
+	}
+
+	if p := c.RetryPolicy; p != nil {
+		if p.MaxAttempts < 0 {
+			errs = append(errs, fmt.Errorf("retry policy: negative max attempts %d", p.MaxAttempts))
+		}
+		if p.BaseDelay < 0 || p.MaxDelay < 0 {
+			errs = append(errs, errors.New("retry policy: negative delay"))
+		}
+		if p.Jitter < 0 || p.Jitter > 1 {
+			errs = append(errs, fmt.Errorf("retry policy: jitter %v out of range [0, 1]", p.Jitter))
+		}
+	}
+
+	if c.LogLevel < 0 || c.LogLevel > log.LevelBodies {
+		errs = append(errs, fmt.Errorf("unknown log level %d", c.LogLevel))
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("%w: %w", ErrInvalidConfig, errors.Join(errs...))
+	}
+	return nil
 }
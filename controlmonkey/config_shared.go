@@ -0,0 +1,486 @@
+package controlmonkey
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"time"
+
+	"gopkg.in/ini.v1"
+
+	"github.com/control-monkey/controlmonkey-sdk-go/controlmonkey/credentials"
+	"github.com/control-monkey/controlmonkey-sdk-go/controlmonkey/log"
+)
+
+const (
+	// EnvVarBaseURL specifies the name of the environment variable points to
+	// the base URL of the ControlMonkey API.
+	EnvVarBaseURL = "CONTROL_MONKEY_BASE_URL"
+
+	// EnvVarTimeout specifies the name of the environment variable points to
+	// the timeout of an operation, including its retries, e.g. "30s". Zero
+	// disables it. See Config.RequestTimeout.
+	EnvVarTimeout = "CONTROL_MONKEY_TIMEOUT"
+
+	// EnvVarMaxAttempts specifies the name of the environment variable points
+	// to the maximum number of attempts of a request, including the initial
+	// one.
+	EnvVarMaxAttempts = "CONTROL_MONKEY_MAX_ATTEMPTS"
+
+	// EnvVarRetryBaseDelay specifies the name of the environment variable
+	// points to the delay before the first retry, e.g. "500ms".
+	EnvVarRetryBaseDelay = "CONTROL_MONKEY_RETRY_BASE_DELAY"
+
+	// EnvVarRetryMaxDelay specifies the name of the environment variable
+	// points to the upper bound of a single retry delay, e.g. "20s".
+	EnvVarRetryMaxDelay = "CONTROL_MONKEY_RETRY_MAX_DELAY"
+
+	// EnvVarProxy specifies the name of the environment variable points to
+	// the URL of the proxy to send requests through. It takes precedence over
+	// the standard HTTPS_PROXY and HTTP_PROXY variables.
+	EnvVarProxy = "CONTROL_MONKEY_PROXY"
+
+	// EnvVarProxyUsername and EnvVarProxyPassword specify the names of the
+	// environment variables pointing to the credentials used to authenticate
+	// to the proxy.
+	EnvVarProxyUsername = "CONTROL_MONKEY_PROXY_USERNAME"
+	EnvVarProxyPassword = "CONTROL_MONKEY_PROXY_PASSWORD"
+
+	// EnvVarCABundle specifies the name of the environment variable points to
+	// a PEM file of root CAs to trust on top of the system ones.
+	EnvVarCABundle = "CONTROL_MONKEY_CA_BUNDLE"
+
+	// EnvVarClientCert and EnvVarClientKey specify the names of the
+	// environment variables pointing to the PEM files of the client
+	// certificate and private key used for mutual TLS.
+	EnvVarClientCert = "CONTROL_MONKEY_CLIENT_CERT"
+	EnvVarClientKey  = "CONTROL_MONKEY_CLIENT_KEY"
+
+	// EnvVarMaxConnsPerHost specifies the name of the environment variable
+	// points to the maximum number of connections to the API.
+	EnvVarMaxConnsPerHost = "CONTROL_MONKEY_MAX_CONNS_PER_HOST"
+
+	// EnvVarMaxIdleConnsPerHost specifies the name of the environment variable
+	// points to the maximum number of idle connections kept to the API.
+	EnvVarMaxIdleConnsPerHost = "CONTROL_MONKEY_MAX_IDLE_CONNS_PER_HOST"
+
+	// EnvVarLogLevel specifies the name of the environment variable points to
+	// the log level, one of "off", "requests", "headers" or "bodies".
+	EnvVarLogLevel = "CONTROL_MONKEY_LOG_LEVEL"
+
+	// EnvVarConfigFile specifies the name of the environment variable points
+	// to the location of the shared config file.
+	EnvVarConfigFile = "CONTROL_MONKEY_CONFIG_FILE"
+
+	// EnvVarProfile specifies the name of the environment variable points to
+	// the profile to use when loading the shared config file. It takes
+	// precedence over credentials.FileCredentialsEnvVarProfile.
+	EnvVarProfile = "CONTROL_MONKEY_PROFILE"
+)
+
+// sharedSettings lists the settings loadable from the shared config file,
+// by key, along with the environment variables overriding them.
+var sharedSettings = []struct {
+	key, env string
+	apply    func(sc *sharedConfig, value string) error
+}{
+	{"base_url", EnvVarBaseURL, applyBaseURL},
+	{"timeout", EnvVarTimeout, applyTimeout},
+	{"max_attempts", EnvVarMaxAttempts, applyMaxAttempts},
+	{"retry_base_delay", EnvVarRetryBaseDelay, applyRetryBaseDelay},
+	{"retry_max_delay", EnvVarRetryMaxDelay, applyRetryMaxDelay},
+	{"proxy", EnvVarProxy, applyProxy},
+	{"proxy_username", EnvVarProxyUsername, applyProxyUsername},
+	{"proxy_password", EnvVarProxyPassword, applyProxyPassword},
+	{"ca_bundle", EnvVarCABundle, applyCABundle},
+	{"client_cert", EnvVarClientCert, applyClientCert},
+	{"client_key", EnvVarClientKey, applyClientKey},
+	{"max_conns_per_host", EnvVarMaxConnsPerHost, applyMaxConnsPerHost},
+	{"max_idle_conns_per_host", EnvVarMaxIdleConnsPerHost, applyMaxIdleConnsPerHost},
+	{"log_level", EnvVarLogLevel, applyLogLevel},
+}
+
+// DefaultConfigFilename returns the SDK's default file path for the shared
+// config file, which lives next to the shared credentials file.
+//   - Linux/Unix : $HOME/.controlmonkey/config
+//   - Windows    : %USERPROFILE%\.controlmonkey\config
+func DefaultConfigFilename() string {
+	return filepath.Join(filepath.Dir(credentials.DefaultFilename()), "config")
+}
+
+// LoadConfig returns the default configuration, overridden by the settings
+// of a profile of the shared credentials file, then of the shared config
+// file, then by the environment variables. Configuration passed in code,
+// e.g. merged into the returned config, takes precedence over all of them.
+//
+// An empty profile defaults to the EnvVarProfile environment variable, then
+// to the credentials.FileCredentialsEnvVarProfile environment variable, then
+// to the default profile; EnvVarProfile wins when both are set. The settings
+// and the token are read from that same profile, and a profile without a
+// token of its own is not completed with the token of the default profile.
+// When the profile is passed explicitly, the token is only read from that
+// profile of the shared credentials file, and the settings of the profile
+// take precedence over the environment variables, so configs of several
+// profiles can be used side by side; otherwise the token is read from the
+// environment first. An empty filename defaults to the EnvVarConfigFile
+// environment variable, then to DefaultConfigFilename.
+//
+// Missing shared files are ignored, unless the location of the shared config
+// file was set explicitly. A missing profile is ignored, unless it was set
+// explicitly and neither file holds it.
+//
+// The shared config file is an INI file, e.g.:
+//
+//	[default]
+//	base_url = https://api.controlmonkey.io
+//	timeout = 30s
+//	max_attempts = 5
+//	retry_base_delay = 1s
+//	retry_max_delay = 30s
+//	proxy = http://proxy.internal:3128
+//	proxy_username = user
+//	proxy_password = password
+//	ca_bundle = /etc/ssl/corporate-ca.pem
+//	client_cert = /etc/controlmonkey/client.crt
+//	client_key = /etc/controlmonkey/client.key
+//	max_conns_per_host = 32
+//	max_idle_conns_per_host = 16
+//	log_level = requests
+//
+// Transport settings are applied with Config.WithTransportOptions.
+//
+// The same settings may be set in the profiles of the shared credentials
+// file; see credentials.Profile.
+//
+// The returned config is validated; see Config.Validate.
+func LoadConfig(profile, filename string) (*Config, error) {
+	cfg := DefaultConfig()
+
+	// Settings of a profile passed explicitly win over the environment, as
+	// its token does.
+	profileWins := profile != ""
+
+	// The settings and the token are read from the same profile.
+	if profile == "" {
+		profile = os.Getenv(EnvVarProfile)
+	}
+	if profile == "" {
+		profile = os.Getenv(credentials.FileCredentialsEnvVarProfile)
+	}
+	explicitProfile := profile != ""
+	if profile == "" {
+		profile = credentials.DefaultProfile()
+	}
+
+	// A profile without a token must not authenticate with the token of the
+	// default profile, which may belong to another organization.
+	file := &credentials.FileProvider{Profile: profile, DisableDefaultFallback: true}
+	if profileWins {
+		cfg.Credentials = credentials.NewCredentials(file)
+	} else {
+		cfg.Credentials = credentials.NewChainCredentials(
+			new(credentials.EnvProvider),
+			file,
+			cfg.webIdentityProvider(),
+		)
+	}
+
+	explicitFile := filename != ""
+	if filename == "" {
+		filename = os.Getenv(EnvVarConfigFile)
+		explicitFile = filename != ""
+	}
+	if filename == "" {
+		filename = DefaultConfigFilename()
+	}
+
+	values := make(map[string]sharedValue)
+	credsFound, err := loadSharedCredentials(values, profile)
+	if err != nil {
+		return nil, err
+	}
+	configFound, err := loadSharedConfig(values, profile, filename, explicitFile)
+	if err != nil {
+		return nil, err
+	}
+	if explicitProfile && !credsFound && !configFound {
+		return nil, fmt.Errorf("controlmonkey: profile %q not found", profile)
+	}
+
+	sc := &sharedConfig{cfg: cfg}
+	for _, s := range sharedSettings {
+		v, ok := values[s.key]
+		if env := os.Getenv(s.env); env != "" && !(ok && profileWins) {
+			v, ok = sharedValue{value: env, source: s.env}, true
+		}
+		if !ok {
+			continue
+		}
+		if err := s.apply(sc, v.value); err != nil {
+			return nil, fmt.Errorf("%w: %s: %w", ErrInvalidConfig, v.source, err)
+		}
+	}
+	if sc.transportOpts != nil {
+		cfg.WithTransportOptions(sc.transportOpts)
+	}
+
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+	return cfg, nil
+}
+
+// ListProfiles returns the sorted names of the profiles of the shared
+// credentials file and of the shared config file, at their default
+// locations. Missing files are ignored.
+func ListProfiles() ([]string, error) {
+	names := make(map[string]bool)
+
+	credsProfiles, err := credentials.ListProfiles("")
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return nil, err
+	}
+	for _, name := range credsProfiles {
+		names[name] = true
+	}
+
+	filename := os.Getenv(EnvVarConfigFile)
+	if filename == "" {
+		filename = DefaultConfigFilename()
+	}
+	if _, err := os.Stat(filename); err == nil {
+		file, err := ini.Load(filename)
+		if err != nil {
+			return nil, fmt.Errorf("controlmonkey: failed to load config file: %w", err)
+		}
+		for _, section := range file.Sections() {
+			if section.Name() == ini.DefaultSection && len(section.Keys()) == 0 {
+				continue
+			}
+			names[section.Name()] = true
+		}
+	}
+
+	out := make([]string, 0, len(names))
+	for name := range names {
+		out = append(out, name)
+	}
+	sort.Strings(out)
+	return out, nil
+}
+
+// A sharedConfig is the config being loaded from the shared settings.
+type sharedConfig struct {
+	cfg           *Config
+	transportOpts *TransportOptions
+}
+
+// retryPolicy returns the retry policy being loaded. Retry settings enable
+// retries, starting from DefaultRetryPolicy.
+func (sc *sharedConfig) retryPolicy() *RetryPolicy {
+	if sc.cfg.RetryPolicy == nil {
+		sc.cfg.RetryPolicy = DefaultRetryPolicy()
+	}
+	return sc.cfg.RetryPolicy
+}
+
+// transport returns the transport options being loaded.
+func (sc *sharedConfig) transport() *TransportOptions {
+	if sc.transportOpts == nil {
+		sc.transportOpts = new(TransportOptions)
+	}
+	return sc.transportOpts
+}
+
+// A sharedValue is the value of a setting, along with where it was read
+// from.
+type sharedValue struct {
+	value, source string
+}
+
+// loadSharedCredentials reads the settings of a profile of the shared
+// credentials file into values, and reports whether the profile exists.
+func loadSharedCredentials(values map[string]sharedValue, profile string) (bool, error) {
+	prof, err := credentials.LoadProfile(profile, "")
+	if err != nil {
+		var notFound *credentials.ProfileNotFoundError
+		if errors.Is(err, os.ErrNotExist) || errors.As(err, &notFound) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	for _, s := range sharedSettings {
+		if v, ok := prof.Settings[s.key]; ok {
+			values[s.key] = sharedValue{
+				value:  v,
+				source: fmt.Sprintf("%s in profile %q of the credentials file", s.key, profile),
+			}
+		}
+	}
+	return true, nil
+}
+
+// loadSharedConfig reads the settings of a profile of the shared config file
+// into values, and reports whether the profile exists.
+func loadSharedConfig(values map[string]sharedValue, profile, filename string, explicitFile bool) (bool, error) {
+	if _, err := os.Stat(filename); errors.Is(err, os.ErrNotExist) && !explicitFile {
+		return false, nil
+	}
+
+	file, err := ini.Load(filename)
+	if err != nil {
+		return false, fmt.Errorf("controlmonkey: failed to load config file: %w", err)
+	}
+
+	section, err := file.GetSection(profile)
+	if err != nil {
+		return false, nil
+	}
+
+	for _, s := range sharedSettings {
+		if section.HasKey(s.key) {
+			values[s.key] = sharedValue{
+				value:  section.Key(s.key).String(),
+				source: fmt.Sprintf("%s in profile %q of %s", s.key, profile, filename),
+			}
+		}
+	}
+	return true, nil
+}
+
+func applyBaseURL(sc *sharedConfig, value string) error {
+	u, err := url.Parse(value)
+	if err != nil {
+		return err
+	}
+	sc.cfg.BaseURL = u
+	return nil
+}
+
+func applyTimeout(sc *sharedConfig, value string) error {
+	d, err := parseDuration(value)
+	if err != nil {
+		return err
+	}
+	if d == 0 {
+		d = NoTimeout
+	}
+	sc.cfg.RequestTimeout = d
+	return nil
+}
+
+func applyMaxAttempts(sc *sharedConfig, value string) error {
+	n, err := strconv.Atoi(value)
+	if err != nil {
+		return err
+	}
+	sc.retryPolicy().MaxAttempts = n
+	return nil
+}
+
+func applyRetryBaseDelay(sc *sharedConfig, value string) error {
+	d, err := parseDuration(value)
+	if err != nil {
+		return err
+	}
+	sc.retryPolicy().BaseDelay = d
+	return nil
+}
+
+func applyRetryMaxDelay(sc *sharedConfig, value string) error {
+	d, err := parseDuration(value)
+	if err != nil {
+		return err
+	}
+	sc.retryPolicy().MaxDelay = d
+	return nil
+}
+
+func applyProxy(sc *sharedConfig, value string) error {
+	sc.transport().ProxyURL = value
+	return nil
+}
+
+func applyProxyUsername(sc *sharedConfig, value string) error {
+	sc.transport().ProxyUsername = value
+	return nil
+}
+
+func applyProxyPassword(sc *sharedConfig, value string) error {
+	sc.transport().ProxyPassword = value
+	return nil
+}
+
+func applyCABundle(sc *sharedConfig, value string) error {
+	sc.transport().CABundleFile = value
+	return nil
+}
+
+func applyClientCert(sc *sharedConfig, value string) error {
+	sc.transport().ClientCertFile = value
+	return nil
+}
+
+func applyClientKey(sc *sharedConfig, value string) error {
+	sc.transport().ClientKeyFile = value
+	return nil
+}
+
+func applyMaxConnsPerHost(sc *sharedConfig, value string) error {
+	n, err := parseCount(value)
+	if err != nil {
+		return err
+	}
+	sc.transport().MaxConnsPerHost = n
+	return nil
+}
+
+func applyMaxIdleConnsPerHost(sc *sharedConfig, value string) error {
+	n, err := parseCount(value)
+	if err != nil {
+		return err
+	}
+	sc.transport().MaxIdleConnsPerHost = n
+	return nil
+}
+
+func applyLogLevel(sc *sharedConfig, value string) error {
+	level, err := log.ParseLevel(value)
+	if err != nil {
+		return err
+	}
+	sc.cfg.LogLevel = level
+	if level != log.LevelOff && sc.cfg.Logger == nil {
+		sc.cfg.Logger = log.DefaultStdLogger
+	}
+	return nil
+}
+
+// parseDuration parses a non-negative duration, e.g. "30s".
+func parseDuration(value string) (time.Duration, error) {
+	d, err := time.ParseDuration(value)
+	if err != nil {
+		return 0, err
+	}
+	if d < 0 {
+		return 0, fmt.Errorf("negative duration %s", value)
+	}
+	return d, nil
+}
+
+// parseCount parses a non-negative integer.
+func parseCount(value string) (int, error) {
+	n, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, err
+	}
+	if n < 0 {
+		return 0, fmt.Errorf("negative count %d", n)
+	}
+	return n, nil
+}
@@ -0,0 +1,391 @@
+package controlmonkey
+
+import (
+	"errors"
+	"net/http"
+	"net/url"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/control-monkey/controlmonkey-sdk-go/controlmonkey/credentials"
+	"github.com/control-monkey/controlmonkey-sdk-go/controlmonkey/log"
+)
+
+func TestConfigValidate(t *testing.T) {
+	tests := map[string]struct {
+		cfg     *Config
+		wantErr bool
+	}{
+		"default": {
+			cfg: DefaultConfig(),
+		},
+		"malformed_base_url": {
+			cfg:     DefaultConfig().WithBaseURL("http://[::1"),
+			wantErr: true,
+		},
+		"relative_base_url": {
+			cfg:     DefaultConfig().WithBaseURL("api.controlmonkey.io"),
+			wantErr: true,
+		},
+		"missing_base_url": {
+			cfg:     &Config{},
+			wantErr: true,
+		},
+		"negative_attempts": {
+			cfg:     DefaultConfig().WithRetryPolicy(&RetryPolicy{MaxAttempts: -1}),
+			wantErr: true,
+		},
+		"jitter_out_of_range": {
+			cfg:     DefaultConfig().WithRetryPolicy(&RetryPolicy{Jitter: 2}),
+			wantErr: true,
+		},
+		"unknown_log_level": {
+			cfg:     DefaultConfig().WithLogLevel(log.Level(42)),
+			wantErr: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := test.cfg.Validate()
+			if e, a := test.wantErr, err != nil; e != a {
+				t.Fatalf("want: error %v, got: %v", e, err)
+			}
+			if err != nil && !errors.Is(err, ErrInvalidConfig) {
+				t.Errorf("want: %v, got: %v", ErrInvalidConfig, err)
+			}
+		})
+	}
+}
+
+func TestConfigMergeKeepsBaseURLError(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.Merge(new(Config).WithBaseURL("http://[::1"))
+	if err := cfg.Validate(); !errors.Is(err, ErrInvalidConfig) {
+		t.Errorf("want: %v, got: %v", ErrInvalidConfig, err)
+	}
+}
+
+// clearConfigEnv isolates a test from the environment and the shared files
+// of the current user.
+func clearConfigEnv(t *testing.T) {
+	t.Helper()
+	t.Setenv("HOME", t.TempDir())
+	t.Setenv("USERPROFILE", t.TempDir())
+	for _, s := range sharedSettings {
+		t.Setenv(s.env, "")
+	}
+	t.Setenv(EnvVarConfigFile, "")
+	t.Setenv(EnvVarProfile, "")
+	t.Setenv(credentials.FileCredentialsEnvVarFile, "")
+	t.Setenv(credentials.FileCredentialsEnvVarProfile, "")
+}
+
+// credentialsFile is a shared credentials file holding settings.
+var credentialsFile = filepath.Join("credentials", "testdata", "credentials_profiles_ini")
+
+func TestLoadConfig(t *testing.T) {
+	tests := map[string]struct {
+		profile, filename string
+		env               map[string]string
+
+		wantBaseURL     string
+		wantTimeout     time.Duration
+		wantMaxAttempts int
+		wantBaseDelay   time.Duration
+		wantLogLevel    log.Level
+		wantProxy       string
+		wantErr         bool
+	}{
+		"defaults": {
+			wantBaseURL: defaultBaseURL,
+		},
+		"missing_explicit_file": {
+			filename: "testdata/missing",
+			wantErr:  true,
+		},
+		"file_default_profile": {
+			filename:        "testdata/config_ini",
+			wantBaseURL:     "https://default.example.com",
+			wantMaxAttempts: 5,
+			wantBaseDelay:   defaultRetryBaseDelay,
+		},
+		"file_profile": {
+			profile:         "staging",
+			filename:        "testdata/config_ini",
+			wantBaseURL:     "https://staging.example.com",
+			wantTimeout:     10 * time.Second,
+			wantMaxAttempts: defaultRetryMaxAttempts,
+			wantBaseDelay:   time.Second,
+			wantLogLevel:    log.LevelHeaders,
+			wantProxy:       "http://proxy.example.com:3128",
+		},
+		"env_file_and_profile": {
+			env: map[string]string{
+				EnvVarConfigFile: "testdata/config_ini",
+				EnvVarProfile:    "staging",
+			},
+			wantBaseURL:     "https://staging.example.com",
+			wantTimeout:     10 * time.Second,
+			wantMaxAttempts: defaultRetryMaxAttempts,
+			wantBaseDelay:   time.Second,
+			wantLogLevel:    log.LevelHeaders,
+			wantProxy:       "http://proxy.example.com:3128",
+		},
+		"env_overrides_file": {
+			env: map[string]string{
+				EnvVarConfigFile:  "testdata/config_ini",
+				EnvVarProfile:     "staging",
+				EnvVarBaseURL:     "http://localhost:8080",
+				EnvVarMaxAttempts: "1",
+				EnvVarLogLevel:    "Off",
+			},
+			wantBaseURL:     "http://localhost:8080",
+			wantTimeout:     10 * time.Second,
+			wantMaxAttempts: 1,
+			wantBaseDelay:   time.Second,
+			wantLogLevel:    log.LevelOff,
+			wantProxy:       "http://proxy.example.com:3128",
+		},
+		"explicit_profile_overrides_env": {
+			profile:  "staging",
+			filename: "testdata/config_ini",
+			env: map[string]string{
+				EnvVarBaseURL:     "http://localhost:8080",
+				EnvVarMaxAttempts: "1",
+				EnvVarLogLevel:    "Off",
+			},
+			wantBaseURL:     "https://staging.example.com",
+			wantTimeout:     10 * time.Second,
+			wantMaxAttempts: 1, // not set by the profile
+			wantBaseDelay:   time.Second,
+			wantLogLevel:    log.LevelHeaders,
+			wantProxy:       "http://proxy.example.com:3128",
+		},
+		"credentials_profile": {
+			profile: "prod",
+			env:     map[string]string{credentials.FileCredentialsEnvVarFile: credentialsFile},
+
+			wantBaseURL: "https://prod.example.com",
+		},
+		"credentials_profile_overrides_env": {
+			profile: "prod",
+			env: map[string]string{
+				credentials.FileCredentialsEnvVarFile: credentialsFile,
+				EnvVarBaseURL:                         "http://localhost:8080",
+			},
+
+			wantBaseURL: "https://prod.example.com",
+		},
+		"credentials_profile_with_config_file": {
+			profile:  "prod",
+			filename: "testdata/config_ini",
+			env:      map[string]string{credentials.FileCredentialsEnvVarFile: credentialsFile},
+
+			wantBaseURL: "https://prod.example.com",
+		},
+		"config_file_overrides_credentials": {
+			profile:  "staging",
+			filename: "testdata/config_ini",
+			env:      map[string]string{credentials.FileCredentialsEnvVarFile: credentialsFile},
+
+			wantBaseURL:     "https://staging.example.com",
+			wantTimeout:     10 * time.Second,
+			wantMaxAttempts: defaultRetryMaxAttempts,
+			wantBaseDelay:   time.Second,
+			wantLogLevel:    log.LevelHeaders,
+			wantProxy:       "http://proxy.example.com:3128",
+		},
+		"missing_profile": {
+			profile:  "production",
+			filename: "testdata/config_ini",
+			wantErr:  true,
+		},
+		"invalid_file_value": {
+			profile:  "broken",
+			filename: "testdata/config_ini",
+			wantErr:  true,
+		},
+		"invalid_env_value": {
+			env:     map[string]string{EnvVarTimeout: "-1s"},
+			wantErr: true,
+		},
+		"malformed_env_base_url": {
+			env:     map[string]string{EnvVarBaseURL: "localhost:8080"},
+			wantErr: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			clearConfigEnv(t)
+			for k, v := range test.env {
+				t.Setenv(k, v)
+			}
+
+			cfg, err := LoadConfig(test.profile, test.filename)
+			if test.wantErr {
+				if err == nil {
+					t.Errorf("want: error, got: %v", cfg)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if e, a := test.wantBaseURL, cfg.BaseURL.String(); e != a {
+				t.Errorf("want: %v, got: %v", e, a)
+			}
+			if e, a := test.wantTimeout, cfg.RequestTimeout; e != a {
+				t.Errorf("want: %v, got: %v", e, a)
+			}
+			var policy RetryPolicy
+			if cfg.RetryPolicy != nil {
+				policy = *cfg.RetryPolicy
+			}
+			if e, a := test.wantMaxAttempts, policy.MaxAttempts; e != a {
+				t.Errorf("want: %v, got: %v", e, a)
+			}
+			if e, a := test.wantBaseDelay, policy.BaseDelay; e != a {
+				t.Errorf("want: %v, got: %v", e, a)
+			}
+			if e, a := test.wantLogLevel, cfg.LogLevel; e != a {
+				t.Errorf("want: %v, got: %v", e, a)
+			}
+
+			req := &http.Request{URL: &url.URL{Scheme: "https", Host: "api.example.com"}}
+			proxy, err := cfg.HTTPClient.Transport.(*http.Transport).Proxy(req)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			var got string
+			if proxy != nil {
+				got = proxy.String()
+			}
+			if e, a := test.wantProxy, got; e != a {
+				t.Errorf("want: %v, got: %v", e, a)
+			}
+		})
+	}
+}
+
+func TestLoadConfigProfileEnv(t *testing.T) {
+	tests := map[string]struct {
+		env map[string]string
+
+		wantBaseURL string
+		wantToken   string
+		wantErr     string
+	}{
+		"none": {
+			wantBaseURL: DefaultBaseURL().String(),
+			wantToken:   "default_token",
+		},
+		"profile": {
+			env:         map[string]string{EnvVarProfile: "prod"},
+			wantBaseURL: "https://prod.example.com",
+			wantToken:   "prod_token",
+		},
+		"credentials_profile": {
+			env:         map[string]string{credentials.FileCredentialsEnvVarProfile: "prod"},
+			wantBaseURL: "https://prod.example.com",
+			wantToken:   "prod_token",
+		},
+		"profile_wins": {
+			env: map[string]string{
+				EnvVarProfile:                            "prod",
+				credentials.FileCredentialsEnvVarProfile: "staging",
+			},
+			wantBaseURL: "https://prod.example.com",
+			wantToken:   "prod_token",
+		},
+		"profile_without_token": {
+			env:         map[string]string{credentials.FileCredentialsEnvVarProfile: "staging"},
+			wantBaseURL: "https://staging-creds.example.com",
+			wantErr:     `profile "staging" has no token`,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			clearConfigEnv(t)
+			t.Setenv(credentials.EnvCredentialsVarToken, "")
+			t.Setenv(credentials.FileCredentialsEnvVarFile, credentialsFile)
+			for k, v := range test.env {
+				t.Setenv(k, v)
+			}
+
+			cfg, err := LoadConfig("", "")
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if e, a := test.wantBaseURL, cfg.BaseURL.String(); e != a {
+				t.Errorf("want: %v, got: %v", e, a)
+			}
+
+			value, err := cfg.Credentials.Get()
+			if test.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), test.wantErr) {
+					t.Errorf("want: %v, got: %v", test.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if e, a := test.wantToken, value.Token; e != a {
+				t.Errorf("want: %v, got: %v", e, a)
+			}
+		})
+	}
+}
+
+func TestLoadConfigProfileWithoutToken(t *testing.T) {
+	clearConfigEnv(t)
+	t.Setenv(credentials.FileCredentialsEnvVarFile, credentialsFile)
+
+	cfg, err := LoadConfig("staging", "")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := cfg.Credentials.Get(); !errors.Is(err, credentials.ErrFileCredentialsNotFound) {
+		t.Errorf("want: %v, got: %v", credentials.ErrFileCredentialsNotFound, err)
+	}
+}
+
+func TestListProfiles(t *testing.T) {
+	clearConfigEnv(t)
+	t.Setenv(credentials.FileCredentialsEnvVarFile, credentialsFile)
+	t.Setenv(EnvVarConfigFile, filepath.Join("testdata", "config_ini"))
+
+	got, err := ListProfiles()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if e, a := []string{"broken", "default", "prod", "staging"}, got; !reflect.DeepEqual(e, a) {
+		t.Errorf("want: %v, got: %v", e, a)
+	}
+}
+
+func TestLoadConfigTransport(t *testing.T) {
+	clearConfigEnv(t)
+	t.Setenv(EnvVarMaxConnsPerHost, "4")
+	t.Setenv(EnvVarProxyUsername, "user")
+
+	cfg, err := LoadConfig("", "")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if e, a := 4, cfg.HTTPClient.Transport.(*http.Transport).MaxConnsPerHost; e != a {
+		t.Errorf("want: %v, got: %v", e, a)
+	}
+
+	t.Setenv(EnvVarCABundle, "file_not_exist")
+	if _, err := LoadConfig("", ""); !errors.Is(err, ErrInvalidConfig) {
+		t.Errorf("want: %v, got: %v", ErrInvalidConfig, err)
+	}
+}
@@ -3,8 +3,13 @@ package credentials
 import (
 	"errors"
 	"sync"
+	"time"
 )
 
+// DefaultExpiryWindow is the default time before their expiry credentials
+// are refreshed. See Credentials.ExpiryWindow.
+const DefaultExpiryWindow = time.Minute
+
 // ErrNoValidTokenFound is returned when there is no valid token.
 var ErrNoValidTokenFound = errors.New("controlmonkey: no valid token found")
 
@@ -17,12 +22,29 @@ var ErrNoValidTokenFound = errors.New("controlmonkey: no valid token found")
 //
 // The first Credentials.Get() will always call Provider.Retrieve() to get the
 // first instance of the credentials Value. All calls to Get() after that will
-// return the cached credentials Value.
+// return the cached credentials Value, until it is about to expire (see
+// Value.Expires), or the Provider reports it expired (see Expirer).
 type Credentials struct {
+	// ExpiryWindow is the time before their expiry credentials are
+	// refreshed, so they do not expire while a request is in flight. Zero
+	// defaults to DefaultExpiryWindow.
+	ExpiryWindow time.Duration
+
 	provider     Provider
 	mu           sync.Mutex
 	forceRefresh bool
 	creds        Value
+
+	// refreshing is the retrieval in flight, if any, shared by the
+	// concurrent callers of Get.
+	refreshing *retrieval
+}
+
+// A retrieval is a call to Provider.Retrieve.
+type retrieval struct {
+	done  chan struct{}
+	creds Value
+	err   error
 }
 
 // NewCredentials returns a pointer to a new Credentials with the provider set.
@@ -37,24 +59,101 @@ func NewCredentials(provider Provider) *Credentials {
 // to be retrieved.
 //
 // Will return the cached credentials Value. If the credentials Value is empty
-// the Provider's Retrieve() will be called to refresh the credentials.
+// or expired the Provider's Retrieve() will be called to refresh the
+// credentials. Concurrent callers wait for a single call to Retrieve().
+//
+// Credentials about to expire are refreshed too, but remain in use if the
+// refresh fails.
 func (c *Credentials) Get() (Value, error) {
 	c.mu.Lock()
-	defer c.mu.Unlock()
-
-	if c.creds.Token == "" || c.forceRefresh {
-		creds, err := c.provider.Retrieve()
-		if err != nil {
-			return Value{}, err
+	r := c.refreshing
+	if r == nil {
+		if !c.needsRefresh(time.Now()) {
+			creds := c.creds
+			c.mu.Unlock()
+			return creds, nil
 		}
-		if creds.Token == "" {
-			return Value{ProviderName: creds.ProviderName}, ErrNoValidTokenFound
+		r = &retrieval{done: make(chan struct{})}
+		c.refreshing = r
+		c.mu.Unlock()
+		c.retrieve(r)
+	} else {
+		c.mu.Unlock()
+		<-r.done
+	}
+
+	if r.err != nil {
+		c.mu.Lock()
+		defer c.mu.Unlock()
+		if c.usable(time.Now()) {
+			return c.creds, nil
 		}
+	}
+	return r.creds, r.err
+}
+
+// retrieve retrieves the credentials from the provider, caches them on
+// success and completes r.
+func (c *Credentials) retrieve(r *retrieval) {
+	c.retrieveWith(r, c.provider.Retrieve)
+}
+
+// retrieveWith is like retrieve, but retrieves the credentials with fn.
+func (c *Credentials) retrieveWith(r *retrieval, fn func() (Value, error)) {
+	creds, err := fn()
+	switch {
+	case err != nil:
+		creds = Value{}
+	case creds.Token == "":
+		creds, err = Value{ProviderName: creds.ProviderName}, ErrNoValidTokenFound
+	}
+
+	c.mu.Lock()
+	if err == nil {
 		c.creds = creds
 		c.forceRefresh = false
 	}
+	r.creds, r.err = creds, err
+	c.refreshing = nil
+	c.mu.Unlock()
+
+	close(r.done)
+}
 
-	return c.creds, nil
+// needsRefresh reports whether the credentials must be retrieved again. It
+// must be called with mu held and no retrieval in flight, since it may query
+// the provider.
+func (c *Credentials) needsRefresh(now time.Time) bool {
+	if c.creds.Token == "" || c.forceRefresh {
+		return true
+	}
+	if e, ok := c.provider.(Expirer); ok && e.IsExpired() {
+		return true
+	}
+	return c.creds.expiresWithin(now, c.expiryWindow())
+}
+
+// usable reports whether the cached credentials may still be used after a
+// failed refresh, i.e. they were not invalidated and have not expired yet.
+// It must be called with mu held.
+func (c *Credentials) usable(now time.Time) bool {
+	if c.creds.Token == "" || c.forceRefresh || c.creds.expiresWithin(now, 0) {
+		return false
+	}
+	if e, ok := c.provider.(Expirer); ok {
+		// The provider may only be queried when no retrieval is in flight.
+		return c.refreshing == nil && !e.IsExpired()
+	}
+	return true
+}
+
+// expiryWindow returns the time before their expiry credentials are
+// refreshed.
+func (c *Credentials) expiryWindow() time.Duration {
+	if c.ExpiryWindow > 0 {
+		return c.ExpiryWindow
+	}
+	return DefaultExpiryWindow
 }
 
 // Refresh refreshes the credentials and forces them to be retrieved on the next
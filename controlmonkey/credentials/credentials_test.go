@@ -2,11 +2,18 @@ package credentials
 
 import (
 	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"path/filepath"
 	"reflect"
+	"runtime"
+	"slices"
 	"strings"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/control-monkey/controlmonkey-sdk-go/controlmonkey/featureflag"
 )
@@ -194,6 +201,235 @@ func TestChainCredentials(t *testing.T) {
 	}
 }
 
+// countingProvider returns tokens expiring after ttl, numbered by the calls
+// to Retrieve, which fail once err is set.
+type countingProvider struct {
+	mu    sync.Mutex
+	calls int
+	ttl   time.Duration
+	delay time.Duration
+	err   error
+}
+
+func (p *countingProvider) Retrieve() (Value, error) {
+	time.Sleep(p.delay)
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.calls++
+	if p.err != nil {
+		return Value{}, p.err
+	}
+	v := Value{Token: fmt.Sprintf("token%d", p.calls)}
+	if p.ttl != 0 {
+		v.Expires = time.Now().Add(p.ttl)
+	}
+	return v, nil
+}
+
+func (p *countingProvider) String() string { return "counting" }
+
+func TestCredentialsExpiry(t *testing.T) {
+	errRetrieve := errors.New("controlmonkey: retrieve failed")
+
+	tests := map[string]struct {
+		ttl       time.Duration
+		failAfter bool
+		refresh   bool
+		want      string
+		wantErr   error
+		wantCalls int
+	}{
+		"no_expiry": {
+			want:      "token1",
+			wantCalls: 1,
+		},
+		"not_expiring": {
+			ttl:       time.Hour,
+			want:      "token1",
+			wantCalls: 1,
+		},
+		"within_expiry_window": {
+			ttl:       30 * time.Second,
+			want:      "token2",
+			wantCalls: 2,
+		},
+		"within_expiry_window_refresh_fails": {
+			ttl:       30 * time.Second,
+			failAfter: true,
+			want:      "token1",
+			wantCalls: 2,
+		},
+		"expired_refresh_fails": {
+			ttl:       -time.Second,
+			failAfter: true,
+			wantErr:   errRetrieve,
+			wantCalls: 2,
+		},
+		"forced_refresh_fails": {
+			ttl:       time.Hour,
+			failAfter: true,
+			refresh:   true,
+			wantErr:   errRetrieve,
+			wantCalls: 2,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			p := &countingProvider{ttl: test.ttl}
+			creds := NewCredentials(p)
+			if _, err := creds.Get(); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if test.failAfter {
+				p.err = errRetrieve
+			}
+			if test.refresh {
+				creds.Refresh()
+			}
+
+			v, err := creds.Get()
+			if !errors.Is(err, test.wantErr) {
+				t.Fatalf("want: %v, got: %v", test.wantErr, err)
+			}
+			if e, a := test.want, v.Token; e != a {
+				t.Errorf("want: %v, got: %v", e, a)
+			}
+			if e, a := test.wantCalls, p.calls; e != a {
+				t.Errorf("want: %v calls, got: %v", e, a)
+			}
+		})
+	}
+}
+
+func TestCredentialsConcurrentRefresh(t *testing.T) {
+	p := &countingProvider{ttl: 30 * time.Second, delay: 20 * time.Millisecond}
+	creds := NewCredentials(p)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := creds.Get(); err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if e, a := 1, p.calls; e != a {
+		t.Errorf("want: %v calls, got: %v", e, a)
+	}
+}
+
+func TestCredentialsDescribe(t *testing.T) {
+	errInvalid := errors.New("controlmonkey: invalid credentials")
+	filename := filepath.Join("testdata", "credentials_profiles_ini")
+
+	tests := map[string]struct {
+		creds    *Credentials
+		features string
+		want     *ChainResult
+	}{
+		"single_provider": {
+			creds: NewStaticCredentials("token"),
+			want: &ChainResult{
+				Providers: []ProviderResult{
+					{Name: StaticCredentialsProviderName, Found: true, Used: true},
+				},
+				ProviderName: StaticCredentialsProviderName,
+			},
+		},
+		"chain": {
+			creds: NewChainCredentials(
+				&mockProvider{},
+				&FileProvider{Profile: "prod", Filename: filename},
+				&mockProvider{creds: Value{Token: "token"}},
+			),
+			want: &ChainResult{
+				Providers: []ProviderResult{
+					{Name: "mock", Err: errInvalid},
+					{Name: FileCredentialsProviderName, Found: true, Used: true, Profile: "prod", Filename: filename},
+				},
+				ProviderName: FileCredentialsProviderName,
+			},
+		},
+		"chain_with_merge": {
+			creds: NewChainCredentials(
+				&mockProvider{},
+				&mockProvider{creds: Value{Token: "token"}},
+			),
+			features: "MergeCredentialsChain=true",
+			want: &ChainResult{
+				Providers: []ProviderResult{
+					{Name: "mock", Err: errInvalid},
+					{Name: "mock", Found: true, Used: true},
+				},
+				Merged:       true,
+				ProviderName: "mock",
+			},
+		},
+		"chain_failed": {
+			creds: NewChainCredentials(
+				&mockProvider{},
+				&FileProvider{Profile: "unknown", Filename: filename},
+			),
+			want: &ChainResult{
+				Providers: []ProviderResult{
+					{Name: "mock", Err: errInvalid},
+					{Name: FileCredentialsProviderName, Err: ErrFileCredentialsLoadFailed, Profile: "unknown", Filename: filename},
+				},
+				Err: errInvalid,
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			if test.features != "" {
+				origFlags := featureflag.All()
+				defer func() { featureflag.Set(origFlags.String()) }() // restore
+				featureflag.Set(test.features)
+			}
+
+			got := test.creds.Describe()
+
+			if e, a := test.want.Err != nil, got.Err != nil; e != a {
+				t.Fatalf("want: error %v, got: %v", e, got.Err)
+			}
+			if e, a := len(test.want.Providers), len(got.Providers); e != a {
+				t.Fatalf("want: %v providers, got: %v", e, a)
+			}
+			for i, want := range test.want.Providers {
+				p := got.Providers[i]
+				if !errors.Is(p.Err, want.Err) && (want.Err == nil || p.Err == nil || p.Err.Error() != want.Err.Error()) {
+					t.Errorf("provider %d: want: %v, got: %v", i, want.Err, p.Err)
+				}
+				p.Err, want.Err = nil, nil
+				if !reflect.DeepEqual(want, p) {
+					t.Errorf("provider %d: want: %+v, got: %+v", i, want, p)
+				}
+			}
+			if e, a := test.want.Merged, got.Merged; e != a {
+				t.Errorf("want: %v, got: %v", e, a)
+			}
+			if e, a := test.want.ProviderName, got.ProviderName; e != a {
+				t.Errorf("want: %v, got: %v", e, a)
+			}
+
+			// The described credentials are cached.
+			if got.Err == nil {
+				if _, err := test.creds.Get(); err != nil {
+					t.Errorf("unexpected error: %v", err)
+				}
+			}
+		})
+	}
+}
+
 func TestFileCredentials(t *testing.T) {
 	var (
 		filenameINI         = filepath.Join("testdata", "credentials_ini")
@@ -241,6 +477,22 @@ func TestFileCredentials(t *testing.T) {
 				Token:        "complete_credentials_token",
 			},
 		},
+		"valid_ini_profile_completed_with_default": {
+			filename: filepath.Join("testdata", "credentials_profiles_ini"),
+			profile:  "staging",
+			want: Value{
+				ProviderName: FileCredentialsProviderName,
+				Token:        "default_token",
+			},
+		},
+		"valid_json_profile": {
+			filename: filepath.Join("testdata", "credentials_profiles_json"),
+			profile:  "prod",
+			want: Value{
+				ProviderName: FileCredentialsProviderName,
+				Token:        "prod_token",
+			},
+		},
 		"valid_json": {
 			filename: filenameJSON,
 			want: Value{
@@ -378,3 +630,685 @@ func TestStaticCredentials(t *testing.T) {
 		})
 	}
 }
+
+func TestProcessCredentials(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("commands are written for sh")
+	}
+
+	tests := map[string]struct {
+		command string
+		want    Value
+		err     string
+	}{
+		"token": {
+			command: `echo '{"token": "process_token"}'`,
+			want:    Value{Token: "process_token", ProviderName: ProcessCredentialsProviderName},
+		},
+		"token_with_expiration": {
+			command: `echo '{"token": "process_token", "expiration": "2999-01-02T15:04:05Z"}'`,
+			want: Value{
+				Token:        "process_token",
+				ProviderName: ProcessCredentialsProviderName,
+				Expires:      time.Date(2999, 1, 2, 15, 4, 5, 0, time.UTC),
+			},
+		},
+		"empty_command": {
+			command: " ",
+			err:     "controlmonkey: credential process command is empty",
+		},
+		"failure_with_stderr": {
+			command: "echo 'vault: permission denied' >&2; exit 3",
+			err:     "controlmonkey: credential process failed: exit status 3: vault: permission denied",
+		},
+		"invalid_output": {
+			command: "echo not json",
+			err:     "controlmonkey: credential process failed: invalid output",
+		},
+		"no_token": {
+			command: `echo '{}'`,
+			err:     "controlmonkey: credential process failed: no token in output",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			creds, err := NewProcessCredentials(test.command).Get()
+			if test.err != "" {
+				if err == nil || !strings.Contains(err.Error(), test.err) {
+					t.Fatalf("want: %v, got: %v", test.err, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("want: nil, got: %v", err)
+			}
+			if e, a := test.want, creds; !reflect.DeepEqual(e, a) {
+				t.Errorf("want: %v, got: %v", e, a)
+			}
+		})
+	}
+}
+
+func TestProcessCredentialsExpiration(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("commands are written for sh")
+	}
+
+	// The command counts its runs in a file and prints a new token each time.
+	counter := filepath.Join(t.TempDir(), "counter")
+	command := func(expiration string) string {
+		return fmt.Sprintf(`echo x >> %s; echo "{\"token\": \"token$(wc -l < %s | tr -d ' ')\", \"expiration\": \"%s\"}"`,
+			counter, counter, expiration)
+	}
+
+	tests := map[string]struct {
+		expiration string
+		want       []string
+	}{
+		"not_expired": {
+			expiration: time.Now().Add(time.Hour).Format(time.RFC3339),
+			want:       []string{"token1", "token1", "token1"},
+		},
+		"expired": {
+			expiration: time.Now().Add(-time.Hour).Format(time.RFC3339),
+			want:       []string{"token1", "token2", "token3"},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			os.Remove(counter)
+			creds := NewChainCredentials(
+				&mockProvider{},
+				&ProcessProvider{Command: command(test.expiration)},
+			)
+
+			for i, want := range test.want {
+				v, err := creds.Get()
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				if v.Token != want {
+					t.Errorf("get %d: want: %v, got: %v", i, want, v.Token)
+				}
+			}
+		})
+	}
+}
+
+func TestFileCredentialsDisableDefaultFallback(t *testing.T) {
+	filename := filepath.Join("testdata", "credentials_profiles_ini")
+
+	creds := NewCredentials(&FileProvider{
+		Profile:                "prod",
+		Filename:               filename,
+		DisableDefaultFallback: true,
+	})
+	value, err := creds.Get()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if e, a := "prod_token", value.Token; e != a {
+		t.Errorf("want: %v, got: %v", e, a)
+	}
+
+	creds = NewCredentials(&FileProvider{
+		Profile:                "staging",
+		Filename:               filename,
+		DisableDefaultFallback: true,
+	})
+	if _, err := creds.Get(); !errors.Is(err, ErrFileCredentialsNotFound) {
+		t.Errorf("want: %v, got: %v", ErrFileCredentialsNotFound, err)
+	}
+}
+
+func TestFileCredentialsProcess(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("commands are written for sh")
+	}
+
+	filename := filepath.Join(t.TempDir(), "credentials")
+	content := "[default]\ntoken = default_token\n\n" +
+		"[vault]\ncredential_process = echo '{\"token\": \"vault_token\"}'\n\n" +
+		"[broken]\ncredential_process = exit 1\n"
+	if err := os.WriteFile(filename, []byte(content), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	creds, err := NewFileCredentials("vault", filename).Get()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := Value{Token: "vault_token", ProviderName: ProcessCredentialsProviderName}
+	if !reflect.DeepEqual(want, creds) {
+		t.Errorf("want: %v, got: %v", want, creds)
+	}
+
+	if _, err := NewFileCredentials("broken", filename).Get(); !errors.Is(err, ErrProcessCredentialsFailed) {
+		t.Errorf("want: %v, got: %v", ErrProcessCredentialsFailed, err)
+	}
+}
+
+func TestWebIdentityCredentials(t *testing.T) {
+	var exchanges int
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		exchanges++
+		if err := r.ParseForm(); err != nil {
+			t.Error(err)
+		}
+		if e, a := tokenExchangeGrantType, r.PostForm.Get("grant_type"); e != a {
+			t.Errorf("want: %v, got: %v", e, a)
+		}
+		if e, a := tokenTypeJWT, r.PostForm.Get("subject_token_type"); e != a {
+			t.Errorf("want: %v, got: %v", e, a)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		switch subject := r.PostForm.Get("subject_token"); subject {
+		case "invalid_jwt":
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprint(w, `{"error": "invalid_grant", "error_description": "token expired"}`)
+		case "short_lived_jwt":
+			fmt.Fprintf(w, `{"access_token": "cm_%d", "expires_in": 30}`, exchanges)
+		default:
+			fmt.Fprintf(w, `{"access_token": "cm_%s_%s", "expires_in": 3600}`, subject, r.PostForm.Get("audience"))
+		}
+	}))
+	defer srv.Close()
+
+	dir := t.TempDir()
+	writeToken := func(token string) string {
+		filename := filepath.Join(dir, token)
+		if err := os.WriteFile(filename, []byte(token+"\n"), 0o600); err != nil {
+			t.Fatal(err)
+		}
+		return filename
+	}
+
+	tests := map[string]struct {
+		provider *WebIdentityProvider
+		env      map[string]string
+		want     []string
+		err      error
+		errMsg   string
+	}{
+		"token_file": {
+			provider: &WebIdentityProvider{TokenURL: srv.URL, TokenFile: writeToken("file_jwt")},
+			want:     []string{"cm_file_jwt_", "cm_file_jwt_"},
+		},
+		"token_env": {
+			provider: &WebIdentityProvider{TokenURL: srv.URL, TokenEnv: "CI_ID_TOKEN", Audience: "controlmonkey"},
+			env:      map[string]string{"CI_ID_TOKEN": "env_jwt"},
+			want:     []string{"cm_env_jwt_controlmonkey"},
+		},
+		"from_environment": {
+			provider: new(WebIdentityProvider),
+			env: map[string]string{
+				WebIdentityEnvVarTokenURL:  srv.URL,
+				WebIdentityEnvVarTokenFile: writeToken("projected_jwt"),
+				WebIdentityEnvVarAudience:  "aud",
+			},
+			want: []string{"cm_projected_jwt_aud"},
+		},
+		"short_lived": {
+			provider: &WebIdentityProvider{TokenURL: srv.URL, TokenFile: writeToken("short_lived_jwt")},
+			want:     []string{"cm_1", "cm_2"},
+		},
+		"not_configured": {
+			provider: &WebIdentityProvider{TokenFile: writeToken("file_jwt")},
+			err:      ErrWebIdentityNotConfigured,
+		},
+		"missing_token_file": {
+			provider: &WebIdentityProvider{TokenURL: srv.URL, TokenFile: filepath.Join(dir, "file_not_exist")},
+			err:      ErrWebIdentityExchangeFailed,
+			errMsg:   "no such file or directory",
+		},
+		"rejected": {
+			provider: &WebIdentityProvider{TokenURL: srv.URL, TokenFile: writeToken("invalid_jwt")},
+			err:      ErrWebIdentityExchangeFailed,
+			errMsg:   "400 Bad Request: invalid_grant: token expired",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			for _, env := range []string{WebIdentityEnvVarTokenURL, WebIdentityEnvVarTokenFile, WebIdentityEnvVarTokenEnv, WebIdentityEnvVarAudience} {
+				t.Setenv(env, "")
+			}
+			for k, v := range test.env {
+				t.Setenv(k, v)
+			}
+			exchanges = 0
+
+			creds := NewCredentials(test.provider)
+			if test.err != nil {
+				_, err := creds.Get()
+				if !errors.Is(err, test.err) || !strings.Contains(err.Error(), test.errMsg) {
+					t.Fatalf("want: %v: %v, got: %v", test.err, test.errMsg, err)
+				}
+				return
+			}
+
+			for i, want := range test.want {
+				v, err := creds.Get()
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				if v.Token != want {
+					t.Errorf("get %d: want: %v, got: %v", i, want, v.Token)
+				}
+				if e, a := WebIdentityCredentialsProviderName, v.ProviderName; e != a {
+					t.Errorf("want: %v, got: %v", e, a)
+				}
+			}
+			if e, a := len(slices.Compact(slices.Clone(test.want))), exchanges; e != a {
+				t.Errorf("want: %v exchanges, got: %v", e, a)
+			}
+		})
+	}
+}
+
+func TestLoadProfile(t *testing.T) {
+	var (
+		filenameINI  = filepath.Join("testdata", "credentials_profiles_ini")
+		filenameJSON = filepath.Join("testdata", "credentials_profiles_json")
+	)
+
+	tests := map[string]struct {
+		filename string
+		profile  string
+		want     *Profile
+		notFound bool
+	}{
+		"ini": {
+			filename: filenameINI,
+			profile:  "prod",
+			want: &Profile{
+				Name:     "prod",
+				Token:    "prod_token",
+				Settings: map[string]string{"base_url": "https://prod.example.com"},
+			},
+		},
+		"ini_without_token": {
+			filename: filenameINI,
+			profile:  "staging",
+			want: &Profile{
+				Name:     "staging",
+				Settings: map[string]string{"base_url": "https://staging-creds.example.com"},
+			},
+		},
+		"json": {
+			filename: filenameJSON,
+			profile:  "prod",
+			want: &Profile{
+				Name:  "prod",
+				Token: "prod_token",
+				Settings: map[string]string{
+					"base_url":     "https://prod.example.com",
+					"max_attempts": "5",
+				},
+			},
+		},
+		"json_single_profile": {
+			filename: filepath.Join("testdata", "credentials_json"),
+			profile:  "prod",
+			want:     &Profile{Name: "prod", Token: "token", Settings: map[string]string{}},
+		},
+		"not_found": {
+			filename: filenameINI,
+			profile:  "dev",
+			notFound: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			prof, err := LoadProfile(test.profile, test.filename)
+			if test.notFound {
+				var notFound *ProfileNotFoundError
+				if !errors.As(err, &notFound) || notFound.Profile != test.profile {
+					t.Fatalf("want: profile not found, got: %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("want: nil, got: %v", err)
+			}
+			if e, a := test.want, prof; !reflect.DeepEqual(e, a) {
+				t.Errorf("want: %v, got: %v", e, a)
+			}
+		})
+	}
+}
+
+func TestListProfiles(t *testing.T) {
+	tests := map[string]struct {
+		filename string
+		want     []string
+	}{
+		"ini": {
+			filename: filepath.Join("testdata", "credentials_profiles_ini"),
+			want:     []string{"default", "prod", "staging"},
+		},
+		"json": {
+			filename: filepath.Join("testdata", "credentials_profiles_json"),
+			want:     []string{"default", "prod"},
+		},
+		"json_single_profile": {
+			filename: filepath.Join("testdata", "credentials_json"),
+			want:     []string{"default"},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := ListProfiles(test.filename)
+			if err != nil {
+				t.Fatalf("want: nil, got: %v", err)
+			}
+			if e, a := test.want, got; !reflect.DeepEqual(e, a) {
+				t.Errorf("want: %v, got: %v", e, a)
+			}
+		})
+	}
+
+	if _, err := ListProfiles("file_not_exist"); !errors.Is(err, os.ErrNotExist) {
+		t.Errorf("want: %v, got: %v", os.ErrNotExist, err)
+	}
+}
+
+func TestSaveProfile(t *testing.T) {
+	const iniContent = "# Managed by onboarding.\n[default]\n# Main token.\ntoken = default_token\n\n[staging]\ntoken = staging_token\nbase_url = https://staging.example.com\n"
+	const jsonContent = `{"default": {"token": "default_token"}, "staging": {"token": "staging_token", "max_attempts": 5}}`
+
+	tests := map[string]struct {
+		content  string // empty: no file
+		profile  *Profile
+		wantText []string
+		want     map[string]*Profile
+		err      string
+	}{
+		"create_file": {
+			profile: &Profile{Name: "prod", Token: "prod_token", Settings: map[string]string{"base_url": "https://prod.example.com"}},
+			want: map[string]*Profile{
+				"prod": {Name: "prod", Token: "prod_token", Settings: map[string]string{"base_url": "https://prod.example.com"}},
+			},
+		},
+		"ini_add_profile": {
+			content:  iniContent,
+			profile:  &Profile{Name: "prod", Token: "prod_token"},
+			wantText: []string{"# Managed by onboarding.", "# Main token."},
+			want: map[string]*Profile{
+				"default": {Name: "default", Token: "default_token", Settings: map[string]string{}},
+				"staging": {Name: "staging", Token: "staging_token", Settings: map[string]string{"base_url": "https://staging.example.com"}},
+				"prod":    {Name: "prod", Token: "prod_token", Settings: map[string]string{}},
+			},
+		},
+		"ini_replace_profile": {
+			content:  iniContent,
+			profile:  &Profile{Name: "staging", Token: "new_token", Settings: map[string]string{"log_level": "requests"}},
+			wantText: []string{"# Managed by onboarding.", "# Main token."},
+			want: map[string]*Profile{
+				"default": {Name: "default", Token: "default_token", Settings: map[string]string{}},
+				"staging": {Name: "staging", Token: "new_token", Settings: map[string]string{"log_level": "requests"}},
+			},
+		},
+		"ini_process_profile": {
+			content: iniContent,
+			profile: &Profile{Name: "staging", Settings: map[string]string{"credential_process": "vault-token"}},
+			want: map[string]*Profile{
+				"default": {Name: "default", Token: "default_token", Settings: map[string]string{}},
+				"staging": {Name: "staging", Settings: map[string]string{"credential_process": "vault-token"}},
+			},
+		},
+		"json_replace_profile": {
+			content:  jsonContent,
+			profile:  &Profile{Name: "default", Token: "new_token"},
+			wantText: []string{`"max_attempts": 5`},
+			want: map[string]*Profile{
+				"default": {Name: "default", Token: "new_token", Settings: map[string]string{}},
+				"staging": {Name: "staging", Token: "staging_token", Settings: map[string]string{"max_attempts": "5"}},
+			},
+		},
+		"json_single_profile": {
+			content: `{"token": "token"}`,
+			profile: &Profile{Name: "prod", Token: "prod_token"},
+			err:     `controlmonkey: credentials file holds a single profile, which applies to every profile: cannot save profile "prod"`,
+			want: map[string]*Profile{
+				"prod":  {Name: "prod", Token: "token", Settings: map[string]string{}},
+				"other": {Name: "other", Token: "token", Settings: map[string]string{}},
+			},
+		},
+		"json_single_profile_default": {
+			content: `{"token": "token"}`,
+			profile: &Profile{Name: "default", Token: "new_token"},
+			want: map[string]*Profile{
+				"other": {Name: "other", Token: "new_token", Settings: map[string]string{}},
+			},
+		},
+		"empty_name": {
+			profile: &Profile{Token: "token"},
+			err:     "controlmonkey: profile name is empty",
+		},
+		"invalid_name": {
+			profile: &Profile{Name: "[prod]", Token: "token"},
+			err:     `controlmonkey: invalid profile name "[prod]"`,
+		},
+		"invalid_token": {
+			profile: &Profile{Name: "prod", Token: "token\n[admin]"},
+			err:     `controlmonkey: profile "prod": invalid token`,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			filename := filepath.Join(t.TempDir(), ".controlmonkey", "credentials")
+			if test.content != "" {
+				if err := os.MkdirAll(filepath.Dir(filename), 0o700); err != nil {
+					t.Fatal(err)
+				}
+				if err := os.WriteFile(filename, []byte(test.content), 0o644); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			err := SaveProfile(filename, test.profile)
+			if test.err != "" {
+				if err == nil || err.Error() != test.err {
+					t.Fatalf("want: %v, got: %v", test.err, err)
+				}
+				// The file is left unchanged.
+				for name, want := range test.want {
+					if got, err := LoadProfile(name, filename); err != nil || !reflect.DeepEqual(want, got) {
+						t.Errorf("want: %+v, got: %+v, %v", want, got, err)
+					}
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if err := ValidateFile(filename); err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+			if info, err := os.Stat(filename); err != nil || (runtime.GOOS != "windows" && info.Mode().Perm() != 0o600) {
+				t.Errorf("want: mode 0600, got: %v, %v", info, err)
+			}
+			b, err := os.ReadFile(filename)
+			if err != nil {
+				t.Fatal(err)
+			}
+			for _, text := range test.wantText {
+				if !strings.Contains(string(b), text) {
+					t.Errorf("want: %q in file, got: %s", text, b)
+				}
+			}
+			for name, want := range test.want {
+				got, err := LoadProfile(name, filename)
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				if !reflect.DeepEqual(want, got) {
+					t.Errorf("want: %+v, got: %+v", want, got)
+				}
+			}
+			if names, _ := ListProfiles(filename); len(names) != len(test.want) {
+				t.Errorf("want: %v profiles, got: %v", len(test.want), names)
+			}
+		})
+	}
+}
+
+func TestSaveProfileConcurrent(t *testing.T) {
+	filename := filepath.Join(t.TempDir(), "credentials")
+
+	const n = 20
+	var wg sync.WaitGroup
+	errs := make(chan error, n)
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			errs <- SaveProfile(filename, &Profile{
+				Name:  fmt.Sprintf("profile_%d", i),
+				Token: fmt.Sprintf("token_%d", i),
+			})
+		}(i)
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	names, err := ListProfiles(filename)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if e, a := n, len(names); e != a {
+		t.Errorf("want: %v, got: %v", e, a)
+	}
+	if _, err := os.Stat(filename + ".lock"); !errors.Is(err, os.ErrNotExist) {
+		t.Errorf("want: %v, got: %v", os.ErrNotExist, err)
+	}
+}
+
+func TestSaveProfileStaleLock(t *testing.T) {
+	filename := filepath.Join(t.TempDir(), "credentials")
+	lockname := filename + ".lock"
+	if err := os.WriteFile(lockname, nil, 0o600); err != nil {
+		t.Fatal(err)
+	}
+	old := time.Now().Add(-2 * lockStaleAge)
+	if err := os.Chtimes(lockname, old, old); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := SaveProfile(filename, &Profile{Name: "prod", Token: "token"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := LoadProfile("prod", filename); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestDeleteProfile(t *testing.T) {
+	filename := filepath.Join(t.TempDir(), "credentials")
+	content := "[default]\ntoken = default_token\n\n# Staging organization.\n[staging]\ntoken = staging_token\n"
+	if err := os.WriteFile(filename, []byte(content), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := DeleteProfile(filename, "staging"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	names, err := ListProfiles(filename)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if e, a := []string{"default"}, names; !reflect.DeepEqual(e, a) {
+		t.Errorf("want: %v, got: %v", e, a)
+	}
+
+	var notFound *ProfileNotFoundError
+	if err := DeleteProfile(filename, "staging"); !errors.As(err, &notFound) {
+		t.Errorf("want: %T, got: %v", notFound, err)
+	}
+
+	if err := os.WriteFile(filename, []byte(`{"token": "token"}`), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if err := DeleteProfile(filename, "default"); !errors.Is(err, ErrSingleProfileFile) {
+		t.Errorf("want: %v, got: %v", ErrSingleProfileFile, err)
+	}
+}
+
+func TestValidateFile(t *testing.T) {
+	tests := map[string]struct {
+		content string
+		mode    os.FileMode
+		err     []string
+	}{
+		"valid": {
+			content: "[default]\ntoken = token\n\n[prod]\ntoken = prod\nbase_url = https://prod.example.com\n",
+			mode:    0o600,
+		},
+		"credential_process": {
+			content: "[prod]\ncredential_process = vault-token\n",
+			mode:    0o600,
+		},
+		"missing_token": {
+			content: "[prod]\ntoken = token\n\n[staging]\nbase_url = https://staging.example.com\n",
+			mode:    0o600,
+			err:     []string{`controlmonkey: profile "staging" has no token or credential_process`},
+		},
+		"missing_token_with_default": {
+			content: "[default]\ntoken = token\n\n[prod]\nbase_url = https://prod.example.com\n",
+			mode:    0o600,
+			err:     []string{`controlmonkey: profile "prod" has no token or credential_process`},
+		},
+		"invalid": {
+			content: "[prod\ntoken = token\n",
+			mode:    0o600,
+			err:     []string{"controlmonkey: failed to load credentials file"},
+		},
+		"permissive_mode": {
+			content: "[default]\ntoken = token\n\n[staging]\n",
+			mode:    0o644,
+			err:     []string{"is accessible by other users (mode 0644), want 0600"},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			if runtime.GOOS == "windows" && test.mode != 0o600 {
+				t.Skip("file modes are not enforced on Windows")
+			}
+
+			filename := filepath.Join(t.TempDir(), "credentials")
+			if err := os.WriteFile(filename, []byte(test.content), test.mode); err != nil {
+				t.Fatal(err)
+			}
+			if err := os.Chmod(filename, test.mode); err != nil {
+				t.Fatal(err)
+			}
+
+			err := ValidateFile(filename)
+			if len(test.err) == 0 {
+				if err != nil {
+					t.Errorf("unexpected error: %v", err)
+				}
+				return
+			}
+			for _, want := range test.err {
+				if err == nil || !strings.Contains(err.Error(), want) {
+					t.Errorf("want: %v, got: %v", want, err)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,136 @@
+package credentials
+
+import "time"
+
+// A ChainResult describes how credentials were retrieved, e.g. to
+// troubleshoot which provider of a ChainProvider supplied the token, and why
+// the ones before it were skipped. See Credentials.Describe.
+type ChainResult struct {
+	// Providers lists the providers tried, in order. Providers after the one
+	// supplying the token are not tried.
+	Providers []ProviderResult
+
+	// Merged reports whether the values of the providers were merged, see
+	// featureflag.MergeCredentialsChain.
+	Merged bool
+
+	// ProviderName is the name of the provider the credentials were
+	// retrieved from, if any.
+	ProviderName string
+
+	// Expires is the time the retrieved token expires at, if any.
+	Expires time.Time
+
+	// Err is the error the retrieval failed with, if any.
+	Err error
+}
+
+// A ProviderResult describes how a provider was tried.
+type ProviderResult struct {
+	// Name of the provider.
+	Name string
+
+	// Found reports whether the provider returned a token.
+	Found bool
+
+	// Used reports whether the value returned by the provider was used.
+	Used bool
+
+	// Err is the error returned by the provider, if any.
+	Err error
+
+	// Profile and Filename are the profile and the path of the credentials
+	// file read by a FileProvider.
+	Profile, Filename string
+}
+
+// A describer is a Provider able to describe how it retrieves credentials.
+type describer interface {
+	describe() (Value, *ChainResult)
+}
+
+// describeProvider describes how p was tried.
+func describeProvider(p Provider, v Value, err error) ProviderResult {
+	result := ProviderResult{
+		Name:  p.String(),
+		Found: err == nil && v.Token != "",
+		Err:   err,
+	}
+	if fp, ok := p.(*FileProvider); ok {
+		result.Profile, result.Filename = fp.profile(), fp.filename()
+	}
+	return result
+}
+
+// resolve records the outcome of the retrieval into r, and returns r.
+func (r *ChainResult) resolve(v Value, err error) *ChainResult {
+	if err == nil {
+		r.ProviderName, r.Expires = v.ProviderName, v.Expires
+		if r.ProviderName == "" {
+			for _, p := range r.Providers {
+				if p.Used {
+					r.ProviderName = p.Name
+					break
+				}
+			}
+		}
+	}
+	r.Err = err
+	return r
+}
+
+// Describe retrieves the credentials again, as Refresh followed by Get
+// would, and reports how they were retrieved: which providers were tried,
+// with the error of each one, and which one supplied the token.
+//
+// The retrieved credentials are cached, as by Get. The returned ChainResult
+// is never nil.
+//
+// Example of a "whoami" command:
+//
+//	result := sess.Config.Credentials.Describe()
+//	for _, p := range result.Providers {
+//		fmt.Printf("%-35s found=%-5t used=%-5t %s %v\n", p.Name, p.Found, p.Used, p.Filename, p.Err)
+//	}
+//	if result.Err != nil {
+//		log.Fatalf("no credentials: %v", result.Err)
+//	}
+//	fmt.Printf("using %s\n", result.ProviderName)
+func (c *Credentials) Describe() *ChainResult {
+	for {
+		c.mu.Lock()
+		r := c.refreshing
+		if r == nil {
+			r = &retrieval{done: make(chan struct{})}
+			c.refreshing = r
+			c.mu.Unlock()
+
+			var result *ChainResult
+			c.retrieveWith(r, func() (Value, error) {
+				var v Value
+				v, result = c.describe()
+				return v, result.Err
+			})
+			return result
+		}
+		c.mu.Unlock()
+		<-r.done
+	}
+}
+
+// describe retrieves the credentials from the provider, and reports how.
+func (c *Credentials) describe() (Value, *ChainResult) {
+	if d, ok := c.provider.(describer); ok {
+		return d.describe()
+	}
+
+	v, err := c.provider.Retrieve()
+	result := &ChainResult{Providers: []ProviderResult{describeProvider(c.provider, v, err)}}
+	if err == nil && v.Token == "" {
+		err = ErrNoValidTokenFound
+	}
+	if err == nil {
+		result.Providers[0].Used = true
+	}
+	return v, result.resolve(v, err)
+}
@@ -0,0 +1,196 @@
+package credentials
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+
+	"gopkg.in/ini.v1"
+)
+
+// tokenKey is the key of the token in a profile of the credentials file.
+const tokenKey = "token"
+
+// A Profile is a named section of the shared credentials file.
+//
+// Besides the token, a profile may hold settings of the service clients
+// used with it, e.g. "base_url" when the organization of the profile is
+// served by its own API endpoint. See controlmonkey.LoadConfig.
+//
+// INI example:
+//
+//	[default]
+//	token = ...
+//
+//	[prod]
+//	token = ...
+//	base_url = https://prod.controlmonkey.example.com
+//
+// JSON example:
+//
+//	{
+//	  "default": {"token": "..."},
+//	  "prod": {"token": "...", "base_url": "https://prod.controlmonkey.example.com"}
+//	}
+//
+// A JSON file holding a single object with a token, e.g. {"token": "..."},
+// applies to every profile.
+//
+// Profiles are written with SaveProfile and DeleteProfile.
+type Profile struct {
+	// Name of the profile.
+	Name string
+
+	// ControlMonkey API token.
+	Token string
+
+	// Settings holds the keys of the profile other than the token, e.g.
+	// "base_url".
+	Settings map[string]string
+}
+
+// A ProfileNotFoundError is returned when a profile does not exist in the
+// credentials file.
+type ProfileNotFoundError struct {
+	Profile string
+}
+
+// Error returns the string representation of the error.
+func (e *ProfileNotFoundError) Error() string {
+	return fmt.Sprintf("section %q does not exist", e.Profile)
+}
+
+// LoadProfile returns a profile of the credentials file. An empty filename
+// defaults to the FileCredentialsEnvVarFile env variable, then to
+// DefaultFilename.
+func LoadProfile(profile, filename string) (*Profile, error) {
+	p := &FileProvider{Profile: profile, Filename: filename}
+
+	profiles, err := loadProfiles(p.filename())
+	if err != nil {
+		return nil, err
+	}
+
+	if prof, ok := profiles[p.profile()]; ok {
+		return prof, nil
+	}
+	if prof, ok := profiles[""]; ok { // single profile JSON file
+		return &Profile{Name: p.profile(), Token: prof.Token, Settings: prof.Settings}, nil
+	}
+
+	return nil, fmt.Errorf("%w: %w", ErrFileCredentialsLoadFailed, &ProfileNotFoundError{Profile: p.profile()})
+}
+
+// ListProfiles returns the sorted names of the profiles of the credentials
+// file. An empty filename defaults to the FileCredentialsEnvVarFile env
+// variable, then to DefaultFilename.
+func ListProfiles(filename string) ([]string, error) {
+	p := &FileProvider{Filename: filename}
+
+	profiles, err := loadProfiles(p.filename())
+	if err != nil {
+		return nil, err
+	}
+
+	names := make([]string, 0, len(profiles))
+	for name := range profiles {
+		if name == "" { // single profile JSON file
+			name = DefaultProfile()
+		}
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
+// loadProfiles loads the profiles of the credentials file, by name. The
+// profile of a JSON file holding a single profile is named "".
+//
+// JSON is tried first, since the INI parser accepts some JSON documents.
+func loadProfiles(filename string) (map[string]*Profile, error) {
+	profiles, jsonErr := loadProfilesJSON(filename)
+	if jsonErr != nil {
+		var iniErr error
+		if profiles, iniErr = loadProfilesINI(filename); iniErr != nil {
+			return nil, fmt.Errorf("%w: %w", ErrFileCredentialsLoadFailed, iniErr)
+		}
+	}
+	return profiles, nil
+}
+
+func loadProfilesINI(filename string) (map[string]*Profile, error) {
+	config, err := ini.Load(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	profiles := make(map[string]*Profile)
+	for _, section := range config.Sections() {
+		if section.Name() == ini.DefaultSection && len(section.Keys()) == 0 {
+			continue
+		}
+
+		prof := &Profile{Name: section.Name(), Settings: make(map[string]string)}
+		for _, key := range section.Keys() {
+			if key.Name() == tokenKey {
+				prof.Token = key.String()
+			} else {
+				prof.Settings[key.Name()] = key.String()
+			}
+		}
+		profiles[prof.Name] = prof
+	}
+	return profiles, nil
+}
+
+func loadProfilesJSON(filename string) (map[string]*Profile, error) {
+	b, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(b, &fields); err != nil {
+		return nil, err
+	}
+
+	if _, ok := fields[tokenKey]; ok {
+		prof, err := profileFromJSON("", b)
+		if err != nil {
+			return nil, err
+		}
+		return map[string]*Profile{"": prof}, nil
+	}
+
+	profiles := make(map[string]*Profile, len(fields))
+	for name, raw := range fields {
+		prof, err := profileFromJSON(name, raw)
+		if err != nil {
+			return nil, err
+		}
+		profiles[name] = prof
+	}
+	return profiles, nil
+}
+
+func profileFromJSON(name string, b []byte) (*Profile, error) {
+	var fields map[string]interface{}
+	if err := json.Unmarshal(b, &fields); err != nil {
+		return nil, fmt.Errorf("profile %q: %w", name, err)
+	}
+
+	prof := &Profile{Name: name, Settings: make(map[string]string)}
+	for key, value := range fields {
+		s, ok := value.(string)
+		if !ok {
+			s = fmt.Sprint(value)
+		}
+		if key == tokenKey {
+			prof.Token = s
+		} else {
+			prof.Settings[key] = s
+		}
+	}
+	return prof, nil
+}
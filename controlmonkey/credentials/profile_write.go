@@ -0,0 +1,397 @@
+package credentials
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"strings"
+	"time"
+
+	"gopkg.in/ini.v1"
+)
+
+const (
+	// credentialsFileMode is the mode of the credentials files written by the
+	// SDK, readable by their owner only.
+	credentialsFileMode fs.FileMode = 0o600
+
+	// lockTimeout is how long to wait for another writer of the credentials
+	// file to release its lock.
+	lockTimeout = 10 * time.Second
+
+	// lockRetryDelay is the delay between attempts to take the lock.
+	lockRetryDelay = 10 * time.Millisecond
+
+	// lockStaleAge is the age after which a lock is considered left behind
+	// by a crashed writer, and is removed.
+	lockStaleAge = time.Minute
+)
+
+// ErrSingleProfileFile is returned when editing a profile of a JSON
+// credentials file holding a single profile, other than the default one. The
+// single profile applies to every profile, so adding or removing one would
+// change the token of the others.
+var ErrSingleProfileFile = errors.New("controlmonkey: credentials file holds a single profile, which applies to every profile")
+
+// SaveProfile creates or replaces a profile of the credentials file, e.g. at
+// the end of a login flow. The other profiles, and the comments of the
+// file, are preserved. The file and its directory are created if missing.
+//
+// An empty filename defaults to the FileCredentialsEnvVarFile env variable,
+// then to DefaultFilename. The file keeps its format: an existing JSON file
+// is written as JSON, other files as INI. A JSON file holding a single
+// profile only accepts the default profile, and ErrSingleProfileFile is
+// returned for the others.
+//
+// The file is replaced atomically, and its mode is set to 0600. Concurrent
+// updates of the file are serialized through a lock file next to it.
+func SaveProfile(filename string, prof *Profile) error {
+	if err := validateProfile(prof); err != nil {
+		return err
+	}
+	return updateProfiles(filename, func(f profilesFile) error {
+		return f.set(prof)
+	})
+}
+
+// DeleteProfile removes a profile of the credentials file. The other
+// profiles, and the comments of the file, are preserved. A
+// ProfileNotFoundError is returned if the profile does not exist, and
+// ErrSingleProfileFile if the file is a JSON file holding a single profile.
+//
+// An empty filename defaults to the FileCredentialsEnvVarFile env variable,
+// then to DefaultFilename. The file is replaced atomically, and its mode is
+// set to 0600. Concurrent updates are serialized as by SaveProfile.
+func DeleteProfile(filename, profile string) error {
+	return updateProfiles(filename, func(f profilesFile) error {
+		found, err := f.remove(profile)
+		if err != nil {
+			return err
+		}
+		if !found {
+			return &ProfileNotFoundError{Profile: profile}
+		}
+		return nil
+	})
+}
+
+// ValidateFile checks the credentials file: it must be valid INI or JSON,
+// readable by its owner only, and every profile must hold a token or a
+// credential_process command of its own. All the problems found are
+// reported, joined by errors.Join.
+//
+// An empty filename defaults to the FileCredentialsEnvVarFile env variable,
+// then to DefaultFilename.
+func ValidateFile(filename string) error {
+	filename = (&FileProvider{Filename: filename}).filename()
+
+	info, err := os.Stat(filename)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrFileCredentialsLoadFailed, err)
+	}
+
+	var errs []error
+	if runtime.GOOS != "windows" && info.Mode().Perm()&0o077 != 0 {
+		errs = append(errs, fmt.Errorf("controlmonkey: credentials file %s is accessible by other users (mode %04o), want %04o",
+			filename, info.Mode().Perm(), credentialsFileMode))
+	}
+
+	profiles, err := loadProfiles(filename)
+	if err != nil {
+		return errors.Join(append(errs, err)...)
+	}
+
+	names := make([]string, 0, len(profiles))
+	for name := range profiles {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		prof := profiles[name]
+		if prof.Token == "" && prof.Settings[credentialProcessKey] == "" {
+			errs = append(errs, fmt.Errorf("controlmonkey: profile %q has no %s or %s", name, tokenKey, credentialProcessKey))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// validateProfile checks a profile can be written to the credentials file.
+func validateProfile(prof *Profile) error {
+	if prof == nil || strings.TrimSpace(prof.Name) == "" {
+		return errors.New("controlmonkey: profile name is empty")
+	}
+	if strings.ContainsAny(prof.Name, "[]\r\n") {
+		return fmt.Errorf("controlmonkey: invalid profile name %q", prof.Name)
+	}
+	if strings.ContainsAny(prof.Token, "\r\n") {
+		return fmt.Errorf("controlmonkey: profile %q: invalid %s", prof.Name, tokenKey)
+	}
+	for key, value := range prof.Settings {
+		if key == "" || key == tokenKey || strings.ContainsAny(key, "=:[]\r\n") {
+			return fmt.Errorf("controlmonkey: profile %q: invalid setting %q", prof.Name, key)
+		}
+		if strings.ContainsAny(value, "\r\n") {
+			return fmt.Errorf("controlmonkey: profile %q: invalid value of setting %q", prof.Name, key)
+		}
+	}
+	return nil
+}
+
+// A profilesFile is a credentials file being edited.
+type profilesFile interface {
+	// set creates or replaces a profile.
+	set(prof *Profile) error
+
+	// remove removes a profile, and reports whether it existed.
+	remove(name string) (bool, error)
+
+	// bytes returns the content of the file.
+	bytes() ([]byte, error)
+}
+
+// updateProfiles applies fn to the credentials file, and replaces it.
+func updateProfiles(filename string, fn func(profilesFile) error) error {
+	filename = (&FileProvider{Filename: filename}).filename()
+
+	// Replace the target of a symlinked file, rather than the symlink.
+	if target, err := filepath.EvalSymlinks(filename); err == nil {
+		filename = target
+	}
+
+	unlock, err := lockFile(filename)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	b, err := os.ReadFile(filename)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("%w: %w", ErrFileCredentialsLoadFailed, err)
+	}
+
+	f, err := parseProfilesFile(b)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrFileCredentialsLoadFailed, err)
+	}
+	if err := fn(f); err != nil {
+		return err
+	}
+
+	out, err := f.bytes()
+	if err != nil {
+		return err
+	}
+	return writeFileAtomic(filename, out)
+}
+
+// lockFile takes an advisory lock on filename, so that concurrent updates,
+// e.g. by several processes, do not lose each other's profiles. The lock is a
+// sibling file created exclusively; the returned func releases it.
+func lockFile(filename string) (func(), error) {
+	if err := os.MkdirAll(filepath.Dir(filename), 0o700); err != nil {
+		return nil, fmt.Errorf("controlmonkey: failed to lock credentials file: %w", err)
+	}
+
+	lockname := filename + ".lock"
+	deadline := time.Now().Add(lockTimeout)
+	for {
+		f, err := os.OpenFile(lockname, os.O_CREATE|os.O_EXCL|os.O_WRONLY, credentialsFileMode)
+		if err == nil {
+			f.Close()
+			return func() { os.Remove(lockname) }, nil
+		}
+		if !errors.Is(err, os.ErrExist) {
+			return nil, fmt.Errorf("controlmonkey: failed to lock credentials file: %w", err)
+		}
+
+		if info, err := os.Stat(lockname); err == nil && time.Since(info.ModTime()) > lockStaleAge {
+			os.Remove(lockname)
+			continue
+		}
+		if time.Now().After(deadline) {
+			return nil, fmt.Errorf("controlmonkey: failed to lock credentials file: %s is held by another process", lockname)
+		}
+		time.Sleep(lockRetryDelay)
+	}
+}
+
+// parseProfilesFile parses the content of a credentials file. JSON is tried
+// first, as by loadProfiles; empty files are INI.
+func parseProfilesFile(b []byte) (profilesFile, error) {
+	if len(bytes.TrimSpace(b)) == 0 {
+		return &iniProfilesFile{file: ini.Empty()}, nil
+	}
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(b, &fields); err == nil {
+		if _, ok := fields[tokenKey]; ok {
+			return new(singleProfileFile), nil
+		}
+		return &jsonProfilesFile{profiles: fields}, nil
+	}
+
+	file, err := ini.Load(b)
+	if err != nil {
+		return nil, err
+	}
+	return &iniProfilesFile{file: file}, nil
+}
+
+// writeFileAtomic replaces filename with a file holding b, readable by its
+// owner only.
+func writeFileAtomic(filename string, b []byte) error {
+	dir := filepath.Dir(filename)
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return fmt.Errorf("controlmonkey: failed to write credentials file: %w", err)
+	}
+
+	tmp, err := os.CreateTemp(dir, "."+filepath.Base(filename)+".tmp*")
+	if err != nil {
+		return fmt.Errorf("controlmonkey: failed to write credentials file: %w", err)
+	}
+	defer os.Remove(tmp.Name()) // no-op once renamed
+
+	err = tmp.Chmod(credentialsFileMode)
+	if err == nil {
+		_, err = tmp.Write(b)
+	}
+	if err == nil {
+		err = tmp.Sync()
+	}
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Rename(tmp.Name(), filename)
+	}
+	if err != nil {
+		return fmt.Errorf("controlmonkey: failed to write credentials file: %w", err)
+	}
+	return nil
+}
+
+// An iniProfilesFile is an INI credentials file being edited.
+type iniProfilesFile struct {
+	file *ini.File
+}
+
+func (f *iniProfilesFile) set(prof *Profile) error {
+	section, err := f.file.GetSection(prof.Name)
+	if err != nil {
+		section, _ = f.file.NewSection(prof.Name)
+	}
+
+	// Existing keys keep their position and comments.
+	for _, key := range section.KeyStrings() {
+		if _, ok := prof.Settings[key]; !ok && (key != tokenKey || prof.Token == "") {
+			section.DeleteKey(key)
+		}
+	}
+	if prof.Token != "" {
+		section.Key(tokenKey).SetValue(prof.Token)
+	}
+	for _, key := range sortedKeys(prof.Settings) {
+		section.Key(key).SetValue(prof.Settings[key])
+	}
+	return nil
+}
+
+func (f *iniProfilesFile) remove(name string) (bool, error) {
+	if _, err := f.file.GetSection(name); err != nil {
+		return false, nil
+	}
+	f.file.DeleteSection(name)
+	return true, nil
+}
+
+func (f *iniProfilesFile) bytes() ([]byte, error) {
+	var buf bytes.Buffer
+	if _, err := f.file.WriteTo(&buf); err != nil {
+		return nil, fmt.Errorf("controlmonkey: failed to encode credentials file: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// A jsonProfilesFile is a JSON credentials file being edited. Profiles are
+// kept as parsed, so only the edited ones are encoded again.
+type jsonProfilesFile struct {
+	profiles map[string]json.RawMessage
+}
+
+func (f *jsonProfilesFile) set(prof *Profile) error {
+	f.profiles[prof.Name] = profileJSON(prof)
+	return nil
+}
+
+func (f *jsonProfilesFile) remove(name string) (bool, error) {
+	if _, ok := f.profiles[name]; !ok {
+		return false, nil
+	}
+	delete(f.profiles, name)
+	return true, nil
+}
+
+func (f *jsonProfilesFile) bytes() ([]byte, error) {
+	b, err := json.MarshalIndent(f.profiles, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("controlmonkey: failed to encode credentials file: %w", err)
+	}
+	return append(b, '\n'), nil
+}
+
+// A singleProfileFile is a JSON credentials file holding a single profile,
+// which applies to every profile. Only the default profile may be saved,
+// and it replaces the single profile.
+type singleProfileFile struct {
+	profile json.RawMessage
+}
+
+func (f *singleProfileFile) set(prof *Profile) error {
+	if prof.Name != DefaultProfile() {
+		return fmt.Errorf("%w: cannot save profile %q", ErrSingleProfileFile, prof.Name)
+	}
+	f.profile = profileJSON(prof)
+	return nil
+}
+
+func (f *singleProfileFile) remove(name string) (bool, error) {
+	return false, fmt.Errorf("%w: cannot delete profile %q", ErrSingleProfileFile, name)
+}
+
+func (f *singleProfileFile) bytes() ([]byte, error) {
+	var buf bytes.Buffer
+	if err := json.Indent(&buf, f.profile, "", "  "); err != nil {
+		return nil, fmt.Errorf("controlmonkey: failed to encode credentials file: %w", err)
+	}
+	buf.WriteByte('\n')
+	return buf.Bytes(), nil
+}
+
+// profileJSON returns the JSON object of a profile.
+func profileJSON(prof *Profile) json.RawMessage {
+	fields := make(map[string]string, len(prof.Settings)+1)
+	for key, value := range prof.Settings {
+		fields[key] = value
+	}
+	if prof.Token != "" {
+		fields[tokenKey] = prof.Token
+	}
+	b, _ := json.Marshal(fields) // map[string]string cannot fail
+	return b
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -1,6 +1,9 @@
 package credentials
 
-import "fmt"
+import (
+	"fmt"
+	"time"
+)
 
 // A Value is the ControlMonkey credentials value for individual credential fields.
 type Value struct {
@@ -9,6 +12,10 @@ type Value struct {
 
 	// Provider used to get credentials.
 	ProviderName string `ini:"-" json:"-"`
+
+	// Expires is the time the token expires at. The zero value means the
+	// token does not expire.
+	Expires time.Time `ini:"-" json:"-"`
 }
 
 // A Provider is the interface for any component which will provide credentials
@@ -24,6 +31,13 @@ type Provider interface {
 	Retrieve() (Value, error)
 }
 
+// An Expirer is a Provider whose credentials expire. Credentials retrieves
+// new credentials from it once the current ones have expired.
+type Expirer interface {
+	// IsExpired returns true if the last retrieved credentials have expired.
+	IsExpired() bool
+}
+
 // IsEmpty if all fields of a Value are empty.
 func (v *Value) IsEmpty() bool { return v.Token == "" }
 
@@ -34,5 +48,11 @@ func (v *Value) IsComplete() bool { return v.Token != "" }
 func (v *Value) Merge(v2 Value) {
 	if v.Token == "" {
 		v.Token = v2.Token
+		v.Expires = v2.Expires
 	}
 }
+
+// expiresWithin reports whether the token expires within d of now.
+func (v *Value) expiresWithin(now time.Time, d time.Duration) bool {
+	return !v.Expires.IsZero() && !now.Add(d).Before(v.Expires)
+}
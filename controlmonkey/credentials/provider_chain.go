@@ -35,6 +35,9 @@ var ErrNoValidProvidersFoundInChain = errors.New("controlmonkey: no valid creden
 //	)
 type ChainProvider struct {
 	Providers []Provider
+
+	// curr is the provider the last retrieved credentials came from.
+	curr Provider
 }
 
 // NewChainCredentials returns a pointer to a new Credentials object
@@ -48,16 +51,28 @@ func NewChainCredentials(providers ...Provider) *Credentials {
 // Retrieve returns the credentials value or error if no provider returned
 // without error.
 func (c *ChainProvider) Retrieve() (Value, error) {
+	value, result := c.describe()
+	return value, result.Err
+}
+
+// describe retrieves the credentials value, and reports how each provider
+// of the chain was tried.
+func (c *ChainProvider) describe() (Value, *ChainResult) {
 	var value Value
 	var errs errorList
+	c.curr = nil
 
+	result := &ChainResult{Merged: featureflag.MergeCredentialsChain.Enabled()}
 	for _, p := range c.Providers {
 		v, err := p.Retrieve()
+		result.Providers = append(result.Providers, describeProvider(p, v, err))
 		if err == nil {
-			if featureflag.MergeCredentialsChain.Enabled() {
+			c.curr = p
+			result.Providers[len(result.Providers)-1].Used = true
+			if result.Merged {
 				value.Merge(v)
 				if value.IsComplete() {
-					return value, nil
+					return value, result.resolve(value, nil)
 				}
 			} else {
 				value = v
@@ -74,10 +89,17 @@ func (c *ChainProvider) Retrieve() (Value, error) {
 			err = errs
 		}
 
-		return Value{ProviderName: c.String()}, err
+		return Value{ProviderName: c.String()}, result.resolve(Value{}, err)
 	}
 
-	return value, nil
+	return value, result.resolve(value, nil)
+}
+
+// IsExpired reports whether the credentials of the provider they were last
+// retrieved from have expired.
+func (c *ChainProvider) IsExpired() bool {
+	e, ok := c.curr.(Expirer)
+	return ok && e.IsExpired()
 }
 
 // String returns the string representation of the provider.
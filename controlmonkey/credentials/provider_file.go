@@ -1,14 +1,11 @@
 package credentials
 
 import (
-	"encoding/json"
 	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
 	"runtime"
-
-	"gopkg.in/ini.v1"
 )
 
 const (
@@ -50,6 +47,9 @@ func DefaultFilename() string {
 }
 
 // A FileProvider retrieves credentials from the current user's home directory.
+//
+// A profile may set a credential_process command in place of the token; the
+// credentials are then retrieved from its output, see ProcessProvider.
 type FileProvider struct {
 	// Profile to load.
 	Profile string
@@ -62,8 +62,18 @@ type FileProvider struct {
 	// - Windows    : %USERPROFILE%\.controlmonkey\credentials
 	Filename string
 
+	// DisableDefaultFallback disables completing the credentials of a profile
+	// with the token of the default profile. A profile without a token then
+	// fails with ErrFileCredentialsNotFound, rather than authenticating with
+	// the token of another profile.
+	DisableDefaultFallback bool
+
 	// retrieved states if the credentials have been successfully retrieved.
 	retrieved bool
+
+	// process retrieves the credentials of a profile setting a
+	// credential_process command, if any.
+	process *ProcessProvider
 }
 
 // NewFileCredentials returns a pointer to a new Credentials object wrapping the
@@ -93,6 +103,12 @@ func (p *FileProvider) Retrieve() (Value, error) {
 	return value, nil
 }
 
+// IsExpired reports whether the credentials retrieved by the credential
+// process of the profile, if any, have expired.
+func (p *FileProvider) IsExpired() bool {
+	return p.process != nil && p.process.IsExpired()
+}
+
 // String returns the string representation of the provider.
 func (p *FileProvider) String() string { return FileCredentialsProviderName }
 
@@ -127,71 +143,28 @@ func (p *FileProvider) filename() string {
 // will be returned if it fails to read from the file, or the data is invalid.
 func (p *FileProvider) loadCredentials(profile, filename string) (Value, error) {
 	var value Value
-	var iniErr, jsonErr error
 
-	if value, iniErr = p.loadCredentialsINI(profile, filename); iniErr != nil {
-		if value, jsonErr = p.loadCredentialsJSON(profile, filename); jsonErr != nil {
-			return value, fmt.Errorf("%v: %v", ErrFileCredentialsLoadFailed, iniErr)
-		}
-	}
-
-	if value.IsEmpty() {
-		return value, ErrFileCredentialsNotFound
-	}
-
-	return value, nil
-}
-
-func (p *FileProvider) loadCredentialsINI(profile, filename string) (Value, error) {
-	var value Value
-
-	config, err := ini.Load(filename)
+	prof, err := LoadProfile(profile, filename)
 	if err != nil {
 		return value, err
 	}
+	value.Token = prof.Token
 
-	value, err = getCredentialsFromINIProfile(profile, config)
-	if err != nil {
-		return value, err
+	p.process = nil
+	if command := prof.Settings[credentialProcessKey]; value.IsEmpty() && command != "" {
+		p.process = &ProcessProvider{Command: command}
+		return p.process.Retrieve()
 	}
 
 	// Try to complete missing fields with default profile.
-	if profile != DefaultProfile() && !value.IsComplete() {
-		defaultValue, err := getCredentialsFromINIProfile(DefaultProfile(), config)
-		if err == nil {
-			value.Merge(defaultValue)
+	if !p.DisableDefaultFallback && profile != DefaultProfile() && !value.IsComplete() {
+		if defaultProf, err := LoadProfile(DefaultProfile(), filename); err == nil {
+			value.Merge(Value{Token: defaultProf.Token})
 		}
 	}
 
-	return value, nil
-}
-
-func getCredentialsFromINIProfile(profile string, config *ini.File) (Value, error) {
-	var value Value
-
-	section, err := config.GetSection(profile)
-	if err != nil {
-		return value, err
-	}
-
-	if err := section.StrictMapTo(&value); err != nil {
-		return value, err
-	}
-
-	return value, nil
-}
-
-func (p *FileProvider) loadCredentialsJSON(profile, filename string) (Value, error) {
-	var value Value
-
-	f, err := os.Open(filename)
-	if err != nil {
-		return value, err
-	}
-	defer f.Close()
-
-	if err := json.NewDecoder(f).Decode(&value); err != nil {
-		return value, err
+	if value.IsEmpty() {
+		return value, fmt.Errorf("%w: profile %q has no token", ErrFileCredentialsNotFound, profile)
 	}
 
 	return value, nil
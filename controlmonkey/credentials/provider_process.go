@@ -0,0 +1,139 @@
+package credentials
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os/exec"
+	"runtime"
+	"strings"
+	"time"
+)
+
+const (
+	// ProcessCredentialsProviderName specifies the name of the Process provider.
+	ProcessCredentialsProviderName = "ProcessCredentialsProvider"
+
+	// DefaultProcessTimeout is the default time the command of a
+	// ProcessProvider may run.
+	DefaultProcessTimeout = time.Minute
+
+	// credentialProcessKey is the key of the command of a profile of the
+	// credentials file.
+	credentialProcessKey = "credential_process"
+)
+
+var (
+	// ErrProcessCredentialsCommandEmpty is returned when the command of a
+	// ProcessProvider is empty.
+	ErrProcessCredentialsCommandEmpty = errors.New("controlmonkey: credential process command is empty")
+
+	// ErrProcessCredentialsFailed is returned when the command of a
+	// ProcessProvider fails, or its output is invalid.
+	ErrProcessCredentialsFailed = errors.New("controlmonkey: credential process failed")
+)
+
+// A ProcessProvider retrieves credentials from the output of an external
+// command, e.g. the CLI of a secret manager.
+//
+// The command is run through the shell ("sh -c", or "cmd.exe /C" on Windows)
+// and must print a JSON document to its standard output:
+//
+//	{"token": "...", "expiration": "2024-01-02T15:04:05Z"}
+//
+// The expiration is optional, in RFC 3339 format. The credentials are
+// cached by Credentials until shortly before they expire; without an
+// expiration they are cached until Credentials.Refresh is called.
+//
+// The command may also be set per profile of the credentials file, in place
+// of the token:
+//
+//	[prod]
+//	credential_process = vault-token --role controlmonkey-prod
+type ProcessProvider struct {
+	// Command to run.
+	Command string
+
+	// Timeout of the command. Defaults to DefaultProcessTimeout.
+	Timeout time.Duration
+
+	// expiration of the last retrieved credentials, if any.
+	expiration time.Time
+}
+
+// NewProcessCredentials returns a pointer to a new Credentials object
+// wrapping the process provider.
+func NewProcessCredentials(command string) *Credentials {
+	return NewCredentials(&ProcessProvider{Command: command})
+}
+
+// processOutput is the JSON document printed by the command.
+type processOutput struct {
+	Token      string     `json:"token"`
+	Expiration *time.Time `json:"expiration,omitempty"`
+}
+
+// Retrieve runs the command and parses its output. The standard error of the
+// command is included in the returned error when it fails.
+func (p *ProcessProvider) Retrieve() (Value, error) {
+	value := Value{ProviderName: ProcessCredentialsProviderName}
+	p.expiration = time.Time{}
+
+	if strings.TrimSpace(p.Command) == "" {
+		return value, ErrProcessCredentialsCommandEmpty
+	}
+
+	timeout := p.Timeout
+	if timeout <= 0 {
+		timeout = DefaultProcessTimeout
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	var stdout, stderr bytes.Buffer
+	cmd := processCommand(ctx, p.Command)
+	cmd.Stdout, cmd.Stderr = &stdout, &stderr
+
+	if err := cmd.Run(); err != nil {
+		if ctx.Err() != nil {
+			err = fmt.Errorf("timed out after %s", timeout)
+		}
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			return value, fmt.Errorf("%w: %w: %s", ErrProcessCredentialsFailed, err, msg)
+		}
+		return value, fmt.Errorf("%w: %w", ErrProcessCredentialsFailed, err)
+	}
+
+	var out processOutput
+	if err := json.Unmarshal(stdout.Bytes(), &out); err != nil {
+		return value, fmt.Errorf("%w: invalid output: %w", ErrProcessCredentialsFailed, err)
+	}
+	if out.Token == "" {
+		return value, fmt.Errorf("%w: no token in output", ErrProcessCredentialsFailed)
+	}
+
+	value.Token = out.Token
+	if out.Expiration != nil {
+		p.expiration = *out.Expiration
+		value.Expires = *out.Expiration
+	}
+	return value, nil
+}
+
+// IsExpired reports whether the last retrieved credentials have expired.
+func (p *ProcessProvider) IsExpired() bool {
+	return !p.expiration.IsZero() && !time.Now().Before(p.expiration)
+}
+
+// String returns the string representation of the provider.
+func (p *ProcessProvider) String() string { return ProcessCredentialsProviderName }
+
+// processCommand returns the command running command through the shell.
+func processCommand(ctx context.Context, command string) *exec.Cmd {
+	if runtime.GOOS == "windows" {
+		return exec.CommandContext(ctx, "cmd.exe", "/C", command)
+	}
+	return exec.CommandContext(ctx, "sh", "-c", command)
+}
@@ -0,0 +1,227 @@
+package credentials
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+)
+
+const (
+	// WebIdentityCredentialsProviderName specifies the name of the
+	// WebIdentity provider.
+	WebIdentityCredentialsProviderName = "WebIdentityCredentialsProvider"
+
+	// WebIdentityEnvVarTokenFile specifies the name of the environment
+	// variable points to the file holding the OIDC token, e.g. a projected
+	// service account token.
+	WebIdentityEnvVarTokenFile = "CONTROL_MONKEY_WEB_IDENTITY_TOKEN_FILE"
+
+	// WebIdentityEnvVarTokenEnv specifies the name of the environment
+	// variable points to the name of the environment variable holding the
+	// OIDC token, e.g. the ID token of a CI job.
+	WebIdentityEnvVarTokenEnv = "CONTROL_MONKEY_WEB_IDENTITY_TOKEN_ENV"
+
+	// WebIdentityEnvVarTokenURL specifies the name of the environment
+	// variable points to the URL of the token exchange endpoint.
+	WebIdentityEnvVarTokenURL = "CONTROL_MONKEY_TOKEN_EXCHANGE_URL"
+
+	// WebIdentityEnvVarAudience specifies the name of the environment
+	// variable points to the audience of the requested token.
+	WebIdentityEnvVarAudience = "CONTROL_MONKEY_TOKEN_EXCHANGE_AUDIENCE"
+
+	// DefaultWebIdentityTimeout is the default timeout of the token exchange.
+	DefaultWebIdentityTimeout = 30 * time.Second
+
+	// Token exchange parameters, see RFC 8693.
+	tokenExchangeGrantType = "urn:ietf:params:oauth:grant-type:token-exchange"
+	tokenTypeJWT           = "urn:ietf:params:oauth:token-type:jwt"
+)
+
+var (
+	// ErrWebIdentityNotConfigured is returned when the OIDC token or the
+	// token exchange endpoint of a WebIdentityProvider are not set.
+	ErrWebIdentityNotConfigured = errors.New("controlmonkey: web identity token or token exchange URL not set")
+
+	// ErrWebIdentityExchangeFailed is returned when the OIDC token cannot be
+	// read, or cannot be exchanged for a ControlMonkey token.
+	ErrWebIdentityExchangeFailed = errors.New("controlmonkey: web identity token exchange failed")
+)
+
+// A WebIdentityProvider exchanges an OIDC token, e.g. the ID token of a CI
+// job or a projected Kubernetes service account token, for a short-lived
+// ControlMonkey token, so no long-lived token has to be stored.
+//
+// The OIDC token is read again before every exchange, since the files and
+// variables exposing it are rotated. It is exchanged at the token endpoint
+// following RFC 8693 (OAuth 2.0 Token Exchange), and the returned token is
+// cached by Credentials until shortly before it expires.
+//
+// Environment variables used when the matching field is empty:
+// * TokenFile : CONTROL_MONKEY_WEB_IDENTITY_TOKEN_FILE
+// * TokenEnv  : CONTROL_MONKEY_WEB_IDENTITY_TOKEN_ENV
+// * TokenURL  : CONTROL_MONKEY_TOKEN_EXCHANGE_URL
+// * Audience  : CONTROL_MONKEY_TOKEN_EXCHANGE_AUDIENCE
+type WebIdentityProvider struct {
+	// Path to the file holding the OIDC token.
+	TokenFile string
+
+	// Name of the environment variable holding the OIDC token. TokenFile
+	// takes precedence over it.
+	TokenEnv string
+
+	// URL of the token exchange endpoint.
+	TokenURL string
+
+	// Audience of the requested token, if required by the endpoint.
+	Audience string
+
+	// HTTPClient used for the exchange. Defaults to a client timing out
+	// after DefaultWebIdentityTimeout. The providers of the default chains,
+	// e.g. controlmonkey.DefaultConfig, use the HTTP client of their config,
+	// so the exchange goes through the same CA bundle, client certificate and
+	// proxy as the requests to the API.
+	HTTPClient *http.Client
+}
+
+// NewWebIdentityCredentials returns a pointer to a new Credentials object
+// wrapping the web identity provider, exchanging the OIDC token read from
+// tokenFile at tokenURL.
+func NewWebIdentityCredentials(tokenURL, tokenFile string) *Credentials {
+	return NewCredentials(&WebIdentityProvider{
+		TokenURL:  tokenURL,
+		TokenFile: tokenFile,
+	})
+}
+
+// tokenExchangeResponse is the response of the token exchange endpoint.
+type tokenExchangeResponse struct {
+	AccessToken string `json:"access_token"`
+	ExpiresIn   int64  `json:"expires_in"`
+
+	Error            string `json:"error"`
+	ErrorDescription string `json:"error_description"`
+}
+
+// Retrieve reads the OIDC token and exchanges it for a ControlMonkey token.
+func (p *WebIdentityProvider) Retrieve() (Value, error) {
+	value := Value{ProviderName: WebIdentityCredentialsProviderName}
+
+	tokenURL := valueOrEnv(p.TokenURL, WebIdentityEnvVarTokenURL)
+	tokenFile := valueOrEnv(p.TokenFile, WebIdentityEnvVarTokenFile)
+	tokenEnv := valueOrEnv(p.TokenEnv, WebIdentityEnvVarTokenEnv)
+	if tokenURL == "" || (tokenFile == "" && tokenEnv == "") {
+		return value, ErrWebIdentityNotConfigured
+	}
+
+	subject, err := p.subjectToken(tokenFile, tokenEnv)
+	if err != nil {
+		return value, fmt.Errorf("%w: %w", ErrWebIdentityExchangeFailed, err)
+	}
+
+	start := time.Now()
+	out, err := p.exchange(tokenURL, subject)
+	if err != nil {
+		return value, fmt.Errorf("%w: %w", ErrWebIdentityExchangeFailed, err)
+	}
+
+	value.Token = out.AccessToken
+	if out.ExpiresIn > 0 {
+		value.Expires = start.Add(time.Duration(out.ExpiresIn) * time.Second)
+	}
+	return value, nil
+}
+
+// String returns the string representation of the provider.
+func (p *WebIdentityProvider) String() string { return WebIdentityCredentialsProviderName }
+
+// subjectToken returns the OIDC token.
+func (p *WebIdentityProvider) subjectToken(tokenFile, tokenEnv string) (string, error) {
+	var token string
+	if tokenFile != "" {
+		b, err := os.ReadFile(tokenFile)
+		if err != nil {
+			return "", err
+		}
+		token = strings.TrimSpace(string(b))
+	} else {
+		token = strings.TrimSpace(os.Getenv(tokenEnv))
+	}
+
+	if token == "" {
+		return "", errors.New("OIDC token is empty")
+	}
+	return token, nil
+}
+
+// exchange exchanges the OIDC token at the token endpoint.
+func (p *WebIdentityProvider) exchange(tokenURL, subject string) (*tokenExchangeResponse, error) {
+	form := url.Values{
+		"grant_type":         {tokenExchangeGrantType},
+		"subject_token":      {subject},
+		"subject_token_type": {tokenTypeJWT},
+	}
+	if audience := valueOrEnv(p.Audience, WebIdentityEnvVarAudience); audience != "" {
+		form.Set("audience", audience)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), DefaultWebIdentityTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, tokenURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+
+	client := p.HTTPClient
+	if client == nil {
+		client = &http.Client{Timeout: DefaultWebIdentityTimeout}
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	b, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	if err != nil {
+		return nil, err
+	}
+
+	var out tokenExchangeResponse
+	jsonErr := json.Unmarshal(b, &out)
+
+	if resp.StatusCode != http.StatusOK {
+		if out.Error != "" {
+			if out.ErrorDescription != "" {
+				return nil, fmt.Errorf("%s: %s: %s", resp.Status, out.Error, out.ErrorDescription)
+			}
+			return nil, fmt.Errorf("%s: %s", resp.Status, out.Error)
+		}
+		return nil, errors.New(resp.Status)
+	}
+	if jsonErr != nil {
+		return nil, fmt.Errorf("invalid response: %w", jsonErr)
+	}
+	if out.AccessToken == "" {
+		return nil, errors.New("no access token in response")
+	}
+	return &out, nil
+}
+
+// valueOrEnv returns value, or the value of the environment variable env if
+// value is empty.
+func valueOrEnv(value, env string) string {
+	if value != "" {
+		return value
+	}
+	return os.Getenv(env)
+}
@@ -0,0 +1,20 @@
+package controlmonkey
+
+import (
+	"context"
+	"net/http"
+)
+
+// An Instrumentation observes the operations performed by the client, e.g. to
+// emit traces and metrics. See the telemetry package for an OpenTelemetry
+// implementation.
+type Instrumentation interface {
+	// StartOperation is called once per operation, before its first attempt.
+	// The returned context is used by every attempt, and end is called once
+	// with the final response and error of the operation.
+	StartOperation(ctx context.Context, operation string) (_ context.Context, end func(*http.Response, error))
+
+	// Middleware wraps every attempt of an operation. It is the innermost
+	// middleware, so it observes the request actually sent.
+	Middleware(next Handler) Handler
+}
@@ -1,8 +1,10 @@
 package log
 
 import (
+	"fmt"
 	"log"
 	"os"
+	"strings"
 )
 
 // DefaultStdLogger represents the default logger which will write log messages
@@ -23,3 +25,49 @@ type LoggerFunc func(format string, args ...interface{})
 func (f LoggerFunc) Printf(format string, args ...interface{}) {
 	f(format, args...)
 }
+
+// Level defines the verbosity of request and response logging.
+type Level int
+
+const (
+	// LevelOff disables request and response logging.
+	LevelOff Level = iota + 1
+
+	// LevelRequests logs a single line per request, with its method, URL,
+	// status and duration.
+	LevelRequests
+
+	// LevelHeaders logs requests and responses along with their headers.
+	LevelHeaders
+
+	// LevelBodies logs requests and responses along with their headers and
+	// bodies. This is the default when a Logger is set.
+	LevelBodies
+)
+
+// String returns the string representation of the level.
+func (l Level) String() string {
+	switch l {
+	case LevelOff:
+		return "off"
+	case LevelRequests:
+		return "requests"
+	case LevelHeaders:
+		return "headers"
+	case LevelBodies:
+		return "bodies"
+	default:
+		return "unknown"
+	}
+}
+
+// ParseLevel returns the level whose string representation is s, ignoring
+// case, e.g. "headers" for LevelHeaders.
+func ParseLevel(s string) (Level, error) {
+	for l := LevelOff; l <= LevelBodies; l++ {
+		if strings.EqualFold(s, l.String()) {
+			return l, nil
+		}
+	}
+	return 0, fmt.Errorf("log: unknown level %q", s)
+}
@@ -0,0 +1,158 @@
+package log
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+)
+
+// An EventKind is the kind of a RequestEvent.
+type EventKind int
+
+const (
+	// EventRequest reports a request attempt, once its response was received
+	// or sending it failed.
+	EventRequest EventKind = iota
+
+	// EventRetry reports a failed request attempt, retried after Delay.
+	EventRetry
+
+	// EventRateLimited reports a rate limited request, pausing the requests
+	// sent by the client for Delay.
+	EventRateLimited
+)
+
+// String returns the string representation of the kind.
+func (k EventKind) String() string {
+	switch k {
+	case EventRequest:
+		return "request"
+	case EventRetry:
+		return "retry"
+	case EventRateLimited:
+		return "rate_limited"
+	default:
+		return "unknown"
+	}
+}
+
+// A RequestEvent describes a single HTTP request attempt made by the client,
+// or what the client does after it.
+type RequestEvent struct {
+	// Kind of the event.
+	Kind EventKind
+
+	// Logical name of the operation, e.g. "stack.CreateStack".
+	Operation string
+
+	// HTTP method and URL path of the request.
+	Method, Path string
+
+	// HTTP status code of the response, or zero if no response was received.
+	Status int
+
+	// Time elapsed between sending the request and receiving the response.
+	Duration time.Duration
+
+	// Attempt number (1-based) of the request.
+	Attempt int
+
+	// Maximum number of attempts of the request, for EventRetry.
+	MaxAttempts int
+
+	// Delay before the request is retried, for EventRetry, or before
+	// requests are sent again, for EventRateLimited.
+	Delay time.Duration
+
+	// ID assigned to the request by the ControlMonkey API, if any. It is read
+	// from the response headers, or from the response body when bodies are
+	// logged (LevelBodies).
+	RequestID string
+
+	// Error returned while sending the request, if any.
+	Err error
+}
+
+// A StructuredLogger is a Logger that receives one structured event per
+// request, retry and rate limit pause instead of formatted, multi-line
+// request and response dumps.
+type StructuredLogger interface {
+	Logger
+
+	// LogRequest logs a single HTTP request attempt, retry or rate limit
+	// pause; see RequestEvent.Kind.
+	LogRequest(ctx context.Context, event RequestEvent)
+}
+
+// SlogLogger is a StructuredLogger backed by a *slog.Logger.
+type SlogLogger struct {
+	logger *slog.Logger
+}
+
+var _ StructuredLogger = &SlogLogger{}
+
+// NewSlogLogger returns a new StructuredLogger writing to logger. If logger is
+// nil, slog.Default() is used.
+func NewSlogLogger(logger *slog.Logger) *SlogLogger {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &SlogLogger{logger: logger}
+}
+
+// Printf logs an informational message.
+func (l *SlogLogger) Printf(format string, args ...interface{}) {
+	l.logger.Info(fmt.Sprintf(format, args...))
+}
+
+// LogRequest logs a single HTTP request attempt, retry or rate limit pause.
+// Failed requests are logged at error level, server errors, rate limited
+// requests, retries and pauses at warn level, and everything else at info
+// level.
+func (l *SlogLogger) LogRequest(ctx context.Context, event RequestEvent) {
+	attrs := []slog.Attr{
+		slog.String("method", event.Method),
+		slog.String("path", event.Path),
+		slog.Int("status", event.Status),
+		slog.Int("attempt", event.Attempt),
+	}
+	switch event.Kind {
+	case EventRetry:
+		attrs = append(attrs,
+			slog.Int("max_attempts", event.MaxAttempts),
+			slog.Duration("delay", event.Delay))
+	case EventRateLimited:
+		attrs = append(attrs, slog.Duration("delay", event.Delay))
+	default:
+		attrs = append(attrs, slog.Duration("duration", event.Duration))
+	}
+	if event.Operation != "" {
+		attrs = append(attrs, slog.String("operation", event.Operation))
+	}
+	if event.RequestID != "" {
+		attrs = append(attrs, slog.String("request_id", event.RequestID))
+	}
+
+	level := slog.LevelInfo
+	switch {
+	case event.Kind != EventRequest:
+		level = slog.LevelWarn
+	case event.Err != nil:
+		level = slog.LevelError
+	case event.Status >= 500 || event.Status == 429:
+		level = slog.LevelWarn
+	}
+	if event.Err != nil {
+		attrs = append(attrs, slog.String("error", event.Err.Error()))
+	}
+
+	msg := "controlmonkey request"
+	switch event.Kind {
+	case EventRetry:
+		msg = "controlmonkey retry"
+	case EventRateLimited:
+		msg = "controlmonkey rate limited"
+	}
+	l.logger.LogAttrs(ctx, level, msg, attrs...)
+}
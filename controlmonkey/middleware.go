@@ -0,0 +1,68 @@
+package controlmonkey
+
+import (
+	"context"
+	"net/http"
+)
+
+// A Handler sends an HTTP request and returns its response.
+type Handler func(*http.Request) (*http.Response, error)
+
+// A Middleware wraps a Handler to observe or mutate the outgoing request and
+// the returned response, e.g. for auditing, custom headers or fault injection.
+//
+// Middlewares run for every attempt of a request, after it was built and
+// signed, and before it is sent by the HTTP client. The logical operation
+// name of the request (e.g. "stack.CreateStack") is available through
+// OperationName(req.Context()).
+//
+// Example of a middleware that adds a custom header to every request:
+//
+//	func tenancy(next controlmonkey.Handler) controlmonkey.Handler {
+//		return func(req *http.Request) (*http.Response, error) {
+//			req.Header.Set("X-Tenant", "acme")
+//			return next(req)
+//		}
+//	}
+type Middleware func(next Handler) Handler
+
+// Chain wraps h with the given middlewares. The first middleware is the
+// outermost one, i.e. it sees the request first and the response last.
+func Chain(h Handler, middlewares ...Middleware) Handler {
+	for i := len(middlewares) - 1; i >= 0; i-- {
+		if mw := middlewares[i]; mw != nil {
+			h = mw(h)
+		}
+	}
+	return h
+}
+
+type operationNameKey struct{}
+
+// WithOperationName returns a copy of ctx carrying the logical name of the
+// operation being performed, e.g. "stack.CreateStack".
+func WithOperationName(ctx context.Context, name string) context.Context {
+	return context.WithValue(ctx, operationNameKey{}, name)
+}
+
+// OperationName returns the logical name of the operation carried by ctx, or
+// "" if there is none.
+func OperationName(ctx context.Context) string {
+	name, _ := ctx.Value(operationNameKey{}).(string)
+	return name
+}
+
+type attemptKey struct{}
+
+// WithAttempt returns a copy of ctx carrying the attempt number (1-based) of
+// the request being sent.
+func WithAttempt(ctx context.Context, attempt int) context.Context {
+	return context.WithValue(ctx, attemptKey{}, attempt)
+}
+
+// Attempt returns the attempt number (1-based) carried by ctx, or 0 if there
+// is none.
+func Attempt(ctx context.Context) int {
+	attempt, _ := ctx.Value(attemptKey{}).(int)
+	return attempt
+}
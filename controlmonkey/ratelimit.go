@@ -0,0 +1,139 @@
+package controlmonkey
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// A RateLimiter throttles outgoing requests using a token bucket, and lets
+// every client sharing it pause together when the API signals that the rate
+// limit was exceeded.
+//
+// A RateLimiter is safe to use across multiple goroutines. Share a single
+// instance (e.g. through the Session's Config) between all clients that
+// should cooperate.
+type RateLimiter struct {
+	mu          sync.Mutex
+	rate        float64
+	burst       float64
+	tokens      float64
+	last        time.Time
+	pausedUntil time.Time
+}
+
+// NewRateLimiter returns a new RateLimiter allowing up to rps requests per
+// second on average, with bursts of up to burst requests. A non-positive rps
+// disables throttling while still honouring pauses requested by the API.
+func NewRateLimiter(rps float64, burst int) *RateLimiter {
+	if burst < 1 {
+		burst = 1
+	}
+	return &RateLimiter{
+		rate:   rps,
+		burst:  float64(burst),
+		tokens: float64(burst),
+	}
+}
+
+// Wait blocks until a request is allowed to be sent, or ctx is done.
+func (l *RateLimiter) Wait(ctx context.Context) error {
+	for {
+		d := l.reserve(time.Now())
+		if d <= 0 {
+			return nil
+		}
+
+		t := time.NewTimer(d)
+		select {
+		case <-ctx.Done():
+			t.Stop()
+			return ctx.Err()
+		case <-t.C:
+		}
+	}
+}
+
+// PauseFor pauses all requests going through the limiter for the given
+// duration. Overlapping pauses are extended, never shortened.
+func (l *RateLimiter) PauseFor(d time.Duration) {
+	l.PauseUntil(time.Now().Add(d))
+}
+
+// PauseUntil pauses all requests going through the limiter until t.
+// Overlapping pauses are extended, never shortened.
+func (l *RateLimiter) PauseUntil(t time.Time) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if t.After(l.pausedUntil) {
+		l.pausedUntil = t
+	}
+}
+
+// reserve takes a token if one is available and returns zero, otherwise it
+// returns how long to wait before trying again.
+func (l *RateLimiter) reserve(now time.Time) time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if now.Before(l.pausedUntil) {
+		return l.pausedUntil.Sub(now)
+	}
+	if l.rate <= 0 {
+		return 0
+	}
+
+	if !l.last.IsZero() {
+		l.tokens += now.Sub(l.last).Seconds() * l.rate
+		if l.tokens > l.burst {
+			l.tokens = l.burst
+		}
+	}
+	l.last = now
+
+	if l.tokens >= 1 {
+		l.tokens--
+		return 0
+	}
+
+	return time.Duration((1 - l.tokens) / l.rate * float64(time.Second))
+}
+
+// RetryAfter returns how long the API asked the client to wait before sending
+// another request, as signalled by the Retry-After header (either in seconds
+// or as an HTTP date) or, failing that, by the X-RateLimit-Reset header (as a
+// Unix timestamp) of a rate limited response.
+func RetryAfter(resp *http.Response) (time.Duration, bool) {
+	if resp == nil {
+		return 0, false
+	}
+
+	if v := resp.Header.Get("Retry-After"); v != "" {
+		if secs, err := strconv.Atoi(v); err == nil && secs >= 0 {
+			return time.Duration(secs) * time.Second, true
+		}
+		if t, err := http.ParseTime(v); err == nil {
+			return nonNegative(time.Until(t)), true
+		}
+	}
+
+	if resp.StatusCode == http.StatusTooManyRequests {
+		if v := resp.Header.Get("X-RateLimit-Reset"); v != "" {
+			if secs, err := strconv.ParseInt(v, 10, 64); err == nil {
+				return nonNegative(time.Until(time.Unix(secs, 0))), true
+			}
+		}
+	}
+
+	return 0, false
+}
+
+func nonNegative(d time.Duration) time.Duration {
+	if d < 0 {
+		return 0
+	}
+	return d
+}
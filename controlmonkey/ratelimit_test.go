@@ -0,0 +1,106 @@
+package controlmonkey
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestRetryAfter(t *testing.T) {
+	tests := map[string]struct {
+		status int
+		header http.Header
+		want   time.Duration
+		wantOK bool
+		approx bool
+	}{
+		"no_header": {
+			status: http.StatusTooManyRequests,
+			header: http.Header{},
+		},
+		"seconds": {
+			status: http.StatusTooManyRequests,
+			header: http.Header{"Retry-After": {"3"}},
+			want:   3 * time.Second,
+			wantOK: true,
+		},
+		"invalid": {
+			status: http.StatusTooManyRequests,
+			header: http.Header{"Retry-After": {"soon"}},
+		},
+		"http_date": {
+			status: http.StatusServiceUnavailable,
+			header: http.Header{"Retry-After": {time.Now().Add(10 * time.Second).UTC().Format(http.TimeFormat)}},
+			want:   10 * time.Second,
+			wantOK: true,
+			approx: true,
+		},
+		"rate_limit_reset": {
+			status: http.StatusTooManyRequests,
+			header: http.Header{"X-Ratelimit-Reset": {strconv.FormatInt(time.Now().Add(5*time.Second).Unix(), 10)}},
+			want:   5 * time.Second,
+			wantOK: true,
+			approx: true,
+		},
+		"rate_limit_reset_ignored_when_not_limited": {
+			status: http.StatusOK,
+			header: http.Header{"X-Ratelimit-Reset": {strconv.FormatInt(time.Now().Add(5*time.Second).Unix(), 10)}},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, ok := RetryAfter(&http.Response{StatusCode: test.status, Header: test.header})
+			if e, a := test.wantOK, ok; e != a {
+				t.Fatalf("want ok: %v, got: %v", e, a)
+			}
+			if test.approx {
+				if diff := test.want - got; diff < 0 || diff > 2*time.Second {
+					t.Errorf("want: ~%v, got: %v", test.want, got)
+				}
+				return
+			}
+			if e, a := test.want, got; e != a {
+				t.Errorf("want: %v, got: %v", e, a)
+			}
+		})
+	}
+}
+
+func TestRateLimiterThrottle(t *testing.T) {
+	l := NewRateLimiter(1, 2)
+	now := time.Now()
+
+	if d := l.reserve(now); d != 0 {
+		t.Fatalf("want: 0, got: %v", d)
+	}
+	if d := l.reserve(now); d != 0 {
+		t.Fatalf("want: 0, got: %v", d)
+	}
+	if d := l.reserve(now); d != time.Second {
+		t.Fatalf("want: %v, got: %v", time.Second, d)
+	}
+	if d := l.reserve(now.Add(time.Second)); d != 0 {
+		t.Fatalf("want: 0, got: %v", d)
+	}
+}
+
+func TestRateLimiterPause(t *testing.T) {
+	l := NewRateLimiter(0, 0)
+	l.PauseFor(time.Hour)
+	l.PauseFor(time.Minute) // must not shorten the pause
+
+	if d := l.reserve(time.Now()); d < 59*time.Minute {
+		t.Fatalf("want: ~1h, got: %v", d)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	if err := l.Wait(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("want: %v, got: %v", context.DeadlineExceeded, err)
+	}
+}
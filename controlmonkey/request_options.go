@@ -0,0 +1,164 @@
+package controlmonkey
+
+import (
+	"context"
+	"net/http"
+	"sync/atomic"
+
+	"github.com/control-monkey/controlmonkey-sdk-go/controlmonkey/credentials"
+)
+
+// IdempotencyKeyHeader is the HTTP header carrying the idempotency key of a
+// request.
+const IdempotencyKeyHeader = "Idempotency-Key"
+
+// RequestOptions customize the requests sent with a context, on top of the
+// configuration of the client. See WithRequestOptions.
+type RequestOptions struct {
+	// Header holds extra HTTP headers, overriding the ones set by the client.
+	Header http.Header
+
+	// Credentials, if set, replace the credentials of the client.
+	Credentials *credentials.Credentials
+
+	// RetryPolicy, if set, replaces the retry policy of the client.
+	RetryPolicy *RetryPolicy
+
+	// IdempotencyKey, if set, is sent in the IdempotencyKeyHeader header. It
+	// lets the API deduplicate the request, so non-idempotent requests
+	// carrying one are retried like idempotent ones. It is only sent with
+	// the first request; see ClaimIdempotencyKey.
+	IdempotencyKey string
+
+	// Capture, if set, records the response of the request. It only records
+	// the first request; see ClaimCapture.
+	Capture *CapturedResponse
+
+	// keyClaim and captureClaim are shared by the copies of the options, so
+	// that the idempotency key and the capture are used by a single request.
+	keyClaim     *atomic.Bool
+	captureClaim *atomic.Bool
+}
+
+// ClaimIdempotencyKey returns the idempotency key for the first request sent
+// with the options, and an empty key afterwards, as the API would deduplicate
+// distinct requests sharing a key into the response of the first one.
+func (o *RequestOptions) ClaimIdempotencyKey() string {
+	if o.IdempotencyKey == "" || o.keyClaim == nil || o.keyClaim.Swap(true) {
+		return ""
+	}
+	return o.IdempotencyKey
+}
+
+// ClaimCapture returns the capture for the first request sent with the
+// options, and nil afterwards, so that it is never written by concurrent
+// requests.
+func (o *RequestOptions) ClaimCapture() *CapturedResponse {
+	if o.Capture == nil || o.captureClaim == nil || o.captureClaim.Swap(true) {
+		return nil
+	}
+	return o.Capture
+}
+
+// A CapturedResponse records the response of a request, e.g. for audit
+// purposes. When several requests are sent with the same context, e.g. by a
+// paginator, it records the first one.
+type CapturedResponse struct {
+	// Response is the raw HTTP response of the last attempt, if any. Its body
+	// is consumed by the SDK.
+	Response *http.Response
+
+	// RequestID is the ID assigned to the request by the API, if any.
+	RequestID string
+
+	// Attempts is the number of attempts made.
+	Attempts int
+}
+
+// A RequestOption sets an option of the requests sent with a context.
+type RequestOption func(*RequestOptions)
+
+type requestOptionsKey struct{}
+
+// WithRequestOptions returns a copy of ctx carrying options honoured by the
+// requests sent with it, on top of the options already carried by ctx.
+//
+// The idempotency key and the response capture apply to a single request:
+// they are consumed by the first request sent with ctx, or with a context
+// derived from it, so they are set for every call.
+//
+// Example:
+//
+//	var capture controlmonkey.CapturedResponse
+//	ctx = controlmonkey.WithRequestOptions(ctx,
+//		controlmonkey.WithRequestHeader("X-Correlation-Id", id),
+//		controlmonkey.WithIdempotencyKey(key),
+//		controlmonkey.WithResponseCapture(&capture))
+//
+//	stack, err := svc.CreateStack(ctx, in)
+//	log.Printf("request %s", capture.RequestID)
+func WithRequestOptions(ctx context.Context, opts ...RequestOption) context.Context {
+	options := RequestOptionsFromContext(ctx)
+	options.Header = options.Header.Clone()
+	for _, opt := range opts {
+		opt(options)
+	}
+	if options.keyClaim == nil {
+		options.keyClaim = new(atomic.Bool)
+	}
+	if options.captureClaim == nil {
+		options.captureClaim = new(atomic.Bool)
+	}
+	return context.WithValue(ctx, requestOptionsKey{}, options)
+}
+
+// RequestOptionsFromContext returns a copy of the request options carried by
+// ctx. It is never nil.
+func RequestOptionsFromContext(ctx context.Context) *RequestOptions {
+	options := new(RequestOptions)
+	if o, ok := ctx.Value(requestOptionsKey{}).(*RequestOptions); ok {
+		*options = *o
+	}
+	return options
+}
+
+// WithRequestHeader sets an extra HTTP header.
+func WithRequestHeader(key, value string) RequestOption {
+	return func(o *RequestOptions) {
+		if o.Header == nil {
+			o.Header = make(http.Header)
+		}
+		o.Header.Set(key, value)
+	}
+}
+
+// WithRequestCredentials replaces the credentials of the client.
+func WithRequestCredentials(creds *credentials.Credentials) RequestOption {
+	return func(o *RequestOptions) { o.Credentials = creds }
+}
+
+// WithRequestRetryPolicy replaces the retry policy of the client.
+func WithRequestRetryPolicy(policy *RetryPolicy) RequestOption {
+	return func(o *RequestOptions) { o.RetryPolicy = policy }
+}
+
+// WithRetriesDisabled disables retries.
+func WithRetriesDisabled() RequestOption {
+	return WithRequestRetryPolicy(NoRetryPolicy())
+}
+
+// WithIdempotencyKey sets the idempotency key of the next request.
+func WithIdempotencyKey(key string) RequestOption {
+	return func(o *RequestOptions) {
+		o.IdempotencyKey = key
+		o.keyClaim = nil
+	}
+}
+
+// WithResponseCapture records the response of the next request into c.
+func WithResponseCapture(c *CapturedResponse) RequestOption {
+	return func(o *RequestOptions) {
+		o.Capture = c
+		o.captureClaim = nil
+	}
+}
@@ -0,0 +1,200 @@
+package controlmonkey
+
+import (
+	"context"
+	"errors"
+	"io"
+	"math"
+	"math/rand"
+	"net"
+	"net/http"
+	"syscall"
+	"time"
+)
+
+const (
+	// defaultRetryMaxAttempts is the default maximum number of attempts
+	// (including the initial one) made for a single request.
+	defaultRetryMaxAttempts = 3
+
+	// defaultRetryBaseDelay is the default delay before the first retry.
+	defaultRetryBaseDelay = 500 * time.Millisecond
+
+	// defaultRetryMaxDelay is the default upper bound of a single delay.
+	defaultRetryMaxDelay = 20 * time.Second
+
+	// defaultRetryJitter is the default fraction of each delay that is
+	// randomized.
+	defaultRetryJitter = 0.2
+
+	// defaultRetryMaxRetryAfter is the default longest wait requested by the
+	// API (through Retry-After) that the client is willing to honour.
+	defaultRetryMaxRetryAfter = time.Minute
+)
+
+// A RetryPolicy controls how failed requests are retried.
+//
+// Requests are retried with exponential backoff: the n-th retry waits
+// BaseDelay * 2^(n-1), capped by MaxDelay, randomized by Jitter.
+type RetryPolicy struct {
+	// The maximum number of attempts, including the initial one. A value
+	// lower than 2 disables retries.
+	MaxAttempts int
+
+	// The delay before the first retry.
+	BaseDelay time.Duration
+
+	// The upper bound of a single delay.
+	MaxDelay time.Duration
+
+	// The fraction (0 to 1) of each delay that is randomized, to avoid many
+	// clients retrying in lockstep.
+	Jitter float64
+
+	// The HTTP status codes that are considered retryable.
+	RetryableStatusCodes []int
+
+	// Whether to retry requests that failed with a transient network error,
+	// e.g. a connection reset or a timeout.
+	RetryNetworkErrors bool
+
+	// Whether to retry non-idempotent requests (POST, PATCH) as well. By
+	// default only idempotent methods are retried, since the API may have
+	// applied the request before failing. Requests carrying an idempotency
+	// key are retried regardless of their method; see WithIdempotencyKey.
+	RetryNonIdempotent bool
+
+	// The longest wait requested by the API through the Retry-After header
+	// that will be honoured. Requests asking to wait longer are not retried.
+	// Zero means no limit.
+	MaxRetryAfter time.Duration
+}
+
+// DefaultRetryableStatusCodes returns the HTTP status codes retried by default.
+func DefaultRetryableStatusCodes() []int {
+	return []int{
+		http.StatusTooManyRequests,
+		http.StatusBadGateway,
+		http.StatusServiceUnavailable,
+		http.StatusGatewayTimeout,
+	}
+}
+
+// DefaultRetryPolicy returns the default retry policy.
+func DefaultRetryPolicy() *RetryPolicy {
+	return &RetryPolicy{
+		MaxAttempts:          defaultRetryMaxAttempts,
+		BaseDelay:            defaultRetryBaseDelay,
+		MaxDelay:             defaultRetryMaxDelay,
+		Jitter:               defaultRetryJitter,
+		RetryableStatusCodes: DefaultRetryableStatusCodes(),
+		RetryNetworkErrors:   true,
+		MaxRetryAfter:        defaultRetryMaxRetryAfter,
+	}
+}
+
+// NoRetryPolicy returns a retry policy that disables retries.
+func NoRetryPolicy() *RetryPolicy {
+	return &RetryPolicy{MaxAttempts: 1}
+}
+
+// ShouldRetry reports whether a request should be retried after the given
+// attempt (1-based) returned resp and err.
+func (p *RetryPolicy) ShouldRetry(req *http.Request, resp *http.Response, err error, attempt int) bool {
+	if p == nil || attempt >= p.MaxAttempts || req == nil {
+		return false
+	}
+	if err != nil {
+		return p.RetryNetworkErrors && IsRetryableNetworkError(err) &&
+			(p.RetryNonIdempotent || IsIdempotentMethod(req.Method))
+	}
+	if resp == nil {
+		return false
+	}
+	if d, ok := RetryAfter(resp); ok && p.MaxRetryAfter > 0 && d > p.MaxRetryAfter {
+		return false
+	}
+	if !p.RetryNonIdempotent && !IsIdempotentMethod(req.Method) {
+		return false
+	}
+	for _, code := range p.RetryableStatusCodes {
+		if resp.StatusCode == code {
+			return true
+		}
+	}
+	return false
+}
+
+// Delay returns the delay before the retry that follows the given attempt
+// (1-based).
+//
+// The computed backoff is extended to honour the wait requested by the API
+// through the Retry-After header of resp, if any.
+func (p *RetryPolicy) Delay(resp *http.Response, attempt int) time.Duration {
+	delay := p.backoff(attempt)
+	if d, ok := RetryAfter(resp); ok && d > delay {
+		delay = d
+	}
+	return delay
+}
+
+// backoff returns the exponential backoff delay for the given attempt.
+func (p *RetryPolicy) backoff(attempt int) time.Duration {
+	if p == nil || p.BaseDelay <= 0 {
+		return 0
+	}
+	if attempt < 1 {
+		attempt = 1
+	}
+
+	delay := float64(p.BaseDelay) * math.Pow(2, float64(attempt-1))
+	if p.MaxDelay > 0 && delay > float64(p.MaxDelay) {
+		delay = float64(p.MaxDelay)
+	}
+	if jitter := p.Jitter; jitter > 0 {
+		if jitter > 1 {
+			jitter = 1
+		}
+		delay = delay*(1-jitter) + rand.Float64()*delay*jitter
+	}
+
+	return time.Duration(delay)
+}
+
+// IsIdempotentMethod reports whether the HTTP method is idempotent as defined
+// by RFC 7231, section 4.2.2.
+func IsIdempotentMethod(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodTrace,
+		http.MethodPut, http.MethodDelete:
+		return true
+	default:
+		return false
+	}
+}
+
+// IsRetryableNetworkError reports whether err is a transient network error
+// that is worth retrying. Context cancellation is never retryable.
+func IsRetryableNetworkError(err error) bool {
+	if err == nil {
+		return false
+	}
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+	if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+		return true
+	}
+	if errors.Is(err, syscall.ECONNRESET) || errors.Is(err, syscall.ECONNREFUSED) ||
+		errors.Is(err, syscall.ECONNABORTED) || errors.Is(err, syscall.EPIPE) {
+		return true
+	}
+
+	var netErr net.Error
+	if errors.As(err, &netErr) && netErr.Timeout() {
+		return true
+	}
+
+	var opErr *net.OpError
+	return errors.As(err, &opErr)
+}
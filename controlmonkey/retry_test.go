@@ -0,0 +1,83 @@
+package controlmonkey
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"syscall"
+	"testing"
+	"time"
+)
+
+func TestRetryPolicyDelay(t *testing.T) {
+	policy := &RetryPolicy{
+		BaseDelay: 100 * time.Millisecond,
+		MaxDelay:  time.Second,
+	}
+
+	tests := map[string]struct {
+		attempt int
+		want    time.Duration
+	}{
+		"first":  {attempt: 1, want: 100 * time.Millisecond},
+		"second": {attempt: 2, want: 200 * time.Millisecond},
+		"third":  {attempt: 3, want: 400 * time.Millisecond},
+		"capped": {attempt: 10, want: time.Second},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			if e, a := test.want, policy.Delay(nil, test.attempt); e != a {
+				t.Errorf("want: %v, got: %v", e, a)
+			}
+		})
+	}
+}
+
+func TestRetryPolicyDelayJitter(t *testing.T) {
+	policy := &RetryPolicy{
+		BaseDelay: 100 * time.Millisecond,
+		Jitter:    0.5,
+	}
+
+	for i := 0; i < 100; i++ {
+		if d := policy.Delay(nil, 1); d < 50*time.Millisecond || d > 100*time.Millisecond {
+			t.Fatalf("want delay within [50ms, 100ms], got: %v", d)
+		}
+	}
+}
+
+func TestRetryPolicyShouldRetry(t *testing.T) {
+	get, _ := http.NewRequest(http.MethodGet, "https://api.controlmonkey.io/stack", nil)
+	post, _ := http.NewRequest(http.MethodPost, "https://api.controlmonkey.io/stack", nil)
+
+	tests := map[string]struct {
+		req     *http.Request
+		status  int
+		err     error
+		attempt int
+		want    bool
+	}{
+		"retryable_status":     {req: get, status: http.StatusServiceUnavailable, attempt: 1, want: true},
+		"non_retryable_status": {req: get, status: http.StatusNotFound, attempt: 1, want: false},
+		"max_attempts_reached": {req: get, status: http.StatusServiceUnavailable, attempt: 3, want: false},
+		"non_idempotent":       {req: post, status: http.StatusServiceUnavailable, attempt: 1, want: false},
+		"rate_limited":         {req: get, status: http.StatusTooManyRequests, attempt: 1, want: true},
+		"rate_limited_post":    {req: post, status: http.StatusTooManyRequests, attempt: 1, want: false},
+		"connection_reset":     {req: get, err: syscall.ECONNRESET, attempt: 1, want: true},
+		"unexpected_eof":       {req: get, err: io.ErrUnexpectedEOF, attempt: 1, want: true},
+		"other_error":          {req: get, err: errors.New("boom"), attempt: 1, want: false},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var resp *http.Response
+			if test.err == nil {
+				resp = &http.Response{StatusCode: test.status}
+			}
+			if e, a := test.want, DefaultRetryPolicy().ShouldRetry(test.req, resp, test.err, test.attempt); e != a {
+				t.Errorf("want: %v, got: %v", e, a)
+			}
+		})
+	}
+}
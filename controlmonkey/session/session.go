@@ -1,6 +1,8 @@
 package session
 
 import (
+	"errors"
+
 	"github.com/control-monkey/controlmonkey-sdk-go/controlmonkey"
 )
 
@@ -15,8 +17,53 @@ type Session struct {
 // New creates a new instance of Session. Once the Session is created it
 // can be mutated to modify the Config. The Session is safe to be read
 // concurrently, but it should not be written to concurrently.
+//
+// The Session starts from controlmonkey.DefaultConfig, so the settings of the
+// environment variables and of the shared config file, e.g. the base URL, are
+// ignored; use Load to honour them.
 func New(cfgs ...*controlmonkey.Config) *Session {
 	s := &Session{Config: controlmonkey.DefaultConfig()}
 	s.Config.Merge(cfgs...)
 	return s
 }
+
+// Load creates a new instance of Session from the shared configuration,
+// i.e. the environment variables and the shared config file, overridden by
+// the passed in configs. See controlmonkey.LoadConfig for details.
+//
+// An error is returned if the shared configuration cannot be loaded, or if
+// the resulting config is invalid.
+func Load(cfgs ...*controlmonkey.Config) (*Session, error) {
+	cfg, err := controlmonkey.LoadConfig("", "")
+	if err != nil {
+		return nil, err
+	}
+	cfg.Merge(cfgs...)
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+	return &Session{Config: cfg}, nil
+}
+
+// NewFromProfile creates a new instance of Session from a profile of the
+// shared credentials and config files, overridden by the passed in configs.
+// The token is read from that profile only, and its settings take precedence
+// over the environment variables, so sessions of several profiles, e.g. of
+// several organizations, can be used concurrently. See
+// controlmonkey.LoadConfig for details.
+//
+// Use controlmonkey.ListProfiles to list the available profiles.
+func NewFromProfile(profile string, cfgs ...*controlmonkey.Config) (*Session, error) {
+	if profile == "" {
+		return nil, errors.New("controlmonkey: profile name is empty")
+	}
+	cfg, err := controlmonkey.LoadConfig(profile, "")
+	if err != nil {
+		return nil, err
+	}
+	cfg.Merge(cfgs...)
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+	return &Session{Config: cfg}, nil
+}
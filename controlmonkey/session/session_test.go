@@ -0,0 +1,76 @@
+package session_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/control-monkey/controlmonkey-sdk-go/controlmonkey"
+	"github.com/control-monkey/controlmonkey-sdk-go/controlmonkey/credentials"
+	"github.com/control-monkey/controlmonkey-sdk-go/controlmonkey/session"
+	"github.com/control-monkey/controlmonkey-sdk-go/controlmonkey/testing/fakeapi"
+	"github.com/control-monkey/controlmonkey-sdk-go/services/namespace"
+)
+
+func TestNewFromProfile(t *testing.T) {
+	prod := fakeapi.NewServer(fakeapi.WithToken("prod_token"))
+	defer prod.Close()
+	dev := fakeapi.NewServer(fakeapi.WithToken("dev_token"))
+	defer dev.Close()
+
+	filename := filepath.Join(t.TempDir(), "credentials")
+	content := fmt.Sprintf("[prod]\ntoken = prod_token\nbase_url = %s\n\n[dev]\ntoken = dev_token\nbase_url = %s\n", prod.URL, dev.URL)
+	if err := os.WriteFile(filename, []byte(content), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv("HOME", t.TempDir())
+	t.Setenv(credentials.FileCredentialsEnvVarFile, filename)
+	t.Setenv(credentials.EnvCredentialsVarToken, "env_token")
+	// The settings of the profiles win over the environment, as their token.
+	t.Setenv(controlmonkey.EnvVarBaseURL, "http://127.0.0.1:1")
+
+	tests := map[string]struct {
+		profile string
+		fake    *fakeapi.Server
+	}{
+		"prod": {profile: "prod", fake: prod},
+		"dev":  {profile: "dev", fake: dev},
+	}
+
+	sessions := make(map[string]*session.Session)
+	for name, test := range tests {
+		sess, err := session.NewFromProfile(test.profile)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		sessions[name] = sess
+	}
+
+	var wg sync.WaitGroup
+	for name := range tests {
+		for i := 0; i < 5; i++ {
+			wg.Add(1)
+			go func(name string, i int) {
+				defer wg.Done()
+				in := &namespace.Namespace{Name: controlmonkey.String(fmt.Sprintf("ns%d", i))}
+				if _, err := namespace.New(sessions[name]).CreateNamespace(context.Background(), in); err != nil {
+					t.Errorf("%s: unexpected error: %v", name, err)
+				}
+			}(name, i)
+		}
+	}
+	wg.Wait()
+
+	for name, test := range tests {
+		if got := len(test.fake.Items("/namespace")); got != 5 {
+			t.Errorf("%s: want: 5 namespaces, got: %d", name, got)
+		}
+	}
+
+	if _, err := session.NewFromProfile("unknown"); err == nil {
+		t.Error("want: error, got: nil")
+	}
+}
@@ -0,0 +1,258 @@
+// Package telemetry provides an OpenTelemetry instrumentation for the
+// ControlMonkey SDK, emitting a span per operation (e.g. "stack.CreateStack"),
+// a child span per HTTP attempt, and request, retry, failure and duration
+// metrics.
+//
+// Unless configured otherwise, the global OpenTelemetry providers are used,
+// which are no-ops until the application registers real ones.
+//
+//	sess := session.New(controlmonkey.DefaultConfig().
+//		WithInstrumentation(telemetry.New()))
+package telemetry
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/control-monkey/controlmonkey-sdk-go/controlmonkey"
+	"github.com/control-monkey/controlmonkey-sdk-go/controlmonkey/client"
+)
+
+// ScopeName is the instrumentation scope name used for tracers and meters.
+const ScopeName = "github.com/control-monkey/controlmonkey-sdk-go/controlmonkey/telemetry"
+
+// Attribute keys set on spans and metrics.
+const (
+	AttributeOperation  = attribute.Key("controlmonkey.operation")
+	AttributeRequestID  = attribute.Key("controlmonkey.request.id")
+	AttributeResourceID = attribute.Key("controlmonkey.resource.id")
+	AttributeErrorCodes = attribute.Key("controlmonkey.error.codes")
+	AttributeAttempt    = attribute.Key("controlmonkey.attempt")
+	AttributeMethod     = attribute.Key("http.request.method")
+	AttributeStatusCode = attribute.Key("http.response.status_code")
+	AttributeURL        = attribute.Key("url.full")
+	AttributeServer     = attribute.Key("server.address")
+)
+
+// An Option configures an Instrumentation.
+type Option func(*options)
+
+type options struct {
+	tracerProvider trace.TracerProvider
+	meterProvider  metric.MeterProvider
+	propagator     propagation.TextMapPropagator
+}
+
+// WithTracerProvider defines the tracer provider used to create spans.
+func WithTracerProvider(tp trace.TracerProvider) Option {
+	return func(o *options) { o.tracerProvider = tp }
+}
+
+// WithMeterProvider defines the meter provider used to record metrics.
+func WithMeterProvider(mp metric.MeterProvider) Option {
+	return func(o *options) { o.meterProvider = mp }
+}
+
+// WithPropagator defines the propagator used to inject the trace context
+// into outgoing requests.
+func WithPropagator(p propagation.TextMapPropagator) Option {
+	return func(o *options) { o.propagator = p }
+}
+
+// Instrumentation is an OpenTelemetry implementation of
+// controlmonkey.Instrumentation.
+type Instrumentation struct {
+	tracer     trace.Tracer
+	propagator propagation.TextMapPropagator
+
+	requests metric.Int64Counter
+	retries  metric.Int64Counter
+	failures metric.Int64Counter
+	duration metric.Float64Histogram
+}
+
+var _ controlmonkey.Instrumentation = &Instrumentation{}
+
+// New returns a new Instrumentation.
+func New(opts ...Option) *Instrumentation {
+	o := &options{
+		tracerProvider: otel.GetTracerProvider(),
+		meterProvider:  otel.GetMeterProvider(),
+		propagator:     otel.GetTextMapPropagator(),
+	}
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	meter := o.meterProvider.Meter(ScopeName, metric.WithInstrumentationVersion(controlmonkey.SDKVersion))
+	i := &Instrumentation{
+		tracer:     o.tracerProvider.Tracer(ScopeName, trace.WithInstrumentationVersion(controlmonkey.SDKVersion)),
+		propagator: o.propagator,
+	}
+
+	// Instrument creation only fails on invalid names, in which case a
+	// no-op instrument is returned.
+	i.requests, _ = meter.Int64Counter("controlmonkey.client.requests",
+		metric.WithDescription("Number of HTTP requests sent, including retries."),
+		metric.WithUnit("{request}"))
+	i.retries, _ = meter.Int64Counter("controlmonkey.client.retries",
+		metric.WithDescription("Number of HTTP requests retried."),
+		metric.WithUnit("{request}"))
+	i.failures, _ = meter.Int64Counter("controlmonkey.client.failures",
+		metric.WithDescription("Number of operations that failed."),
+		metric.WithUnit("{operation}"))
+	i.duration, _ = meter.Float64Histogram("controlmonkey.client.operation.duration",
+		metric.WithDescription("Duration of operations, including retries."),
+		metric.WithUnit("s"))
+
+	return i
+}
+
+// StartOperation starts a span for the given operation. The span ends, and
+// the operation metrics are recorded, when end is called.
+func (i *Instrumentation) StartOperation(ctx context.Context, operation string) (context.Context, func(*http.Response, error)) {
+	name := operation
+	if name == "" {
+		name = "controlmonkey.request"
+	}
+	opAttr := AttributeOperation.String(operation)
+
+	start := time.Now()
+	ctx, span := i.tracer.Start(ctx, name,
+		trace.WithSpanKind(trace.SpanKindInternal),
+		trace.WithAttributes(opAttr))
+
+	end := func(resp *http.Response, err error) {
+		defer span.End()
+
+		attrs := []attribute.KeyValue{opAttr}
+		failed := err != nil
+		if resp != nil {
+			attrs = append(attrs, AttributeStatusCode.Int(resp.StatusCode))
+			failed = failed || resp.StatusCode >= http.StatusBadRequest
+			if span.IsRecording() {
+				span.SetAttributes(AttributeStatusCode.Int(resp.StatusCode))
+				annotate(span, resp)
+			}
+		}
+
+		i.duration.Record(ctx, time.Since(start).Seconds(), metric.WithAttributes(attrs...))
+		if failed {
+			i.failures.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+
+		switch {
+		case err != nil:
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		case resp != nil && resp.StatusCode >= http.StatusBadRequest:
+			span.SetStatus(codes.Error, resp.Status)
+		}
+	}
+
+	return ctx, end
+}
+
+// Middleware starts a child span for every HTTP attempt, and injects the
+// trace context into the outgoing request.
+func (i *Instrumentation) Middleware(next controlmonkey.Handler) controlmonkey.Handler {
+	return func(req *http.Request) (*http.Response, error) {
+		ctx := req.Context()
+		operation := controlmonkey.OperationName(ctx)
+		attempt := controlmonkey.Attempt(ctx)
+
+		ctx, span := i.tracer.Start(ctx, "HTTP "+req.Method,
+			trace.WithSpanKind(trace.SpanKindClient),
+			trace.WithAttributes(
+				AttributeOperation.String(operation),
+				AttributeAttempt.Int(attempt),
+				AttributeMethod.String(req.Method),
+				AttributeURL.String(req.URL.String()),
+				AttributeServer.String(req.URL.Host),
+			))
+		defer span.End()
+
+		req = req.WithContext(ctx)
+		i.propagator.Inject(ctx, propagation.HeaderCarrier(req.Header))
+
+		attrs := []attribute.KeyValue{
+			AttributeOperation.String(operation),
+			AttributeMethod.String(req.Method),
+		}
+		if attempt > 1 {
+			i.retries.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+
+		resp, err := next(req)
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		if resp != nil {
+			attrs = append(attrs, AttributeStatusCode.Int(resp.StatusCode))
+			span.SetAttributes(AttributeStatusCode.Int(resp.StatusCode))
+			if resp.StatusCode >= http.StatusBadRequest {
+				span.SetStatus(codes.Error, resp.Status)
+			}
+		}
+		i.requests.Add(ctx, 1, metric.WithAttributes(attrs...))
+
+		return resp, err
+	}
+}
+
+// annotate sets the request ID, resource ID and error codes found in the
+// response body as span attributes. The body of resp is restored so it can
+// still be read by the caller.
+func annotate(span trace.Span, resp *http.Response) {
+	if resp.Body == nil || resp.Body == http.NoBody {
+		return
+	}
+
+	data, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	resp.Body = io.NopCloser(bytes.NewReader(data))
+	if err != nil {
+		return
+	}
+
+	var out client.Response
+	if err := json.Unmarshal(data, &out); err != nil {
+		return
+	}
+
+	if id := out.Request.ID; id != "" {
+		span.SetAttributes(AttributeRequestID.String(id))
+	}
+
+	if errs := out.Response.Errors; len(errs) > 0 {
+		errCodes := make([]string, len(errs))
+		for n, e := range errs {
+			errCodes[n] = e.Code
+		}
+		span.SetAttributes(AttributeErrorCodes.StringSlice(errCodes))
+	} else if resp.StatusCode >= http.StatusBadRequest {
+		span.SetAttributes(AttributeErrorCodes.StringSlice([]string{strconv.Itoa(resp.StatusCode)}))
+	}
+
+	if len(out.Response.Items) > 0 {
+		var item struct {
+			ID string `json:"id"`
+		}
+		if err := json.Unmarshal(out.Response.Items[0], &item); err == nil && item.ID != "" {
+			span.SetAttributes(AttributeResourceID.String(item.ID))
+		}
+	}
+}
@@ -0,0 +1,286 @@
+package telemetry
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/metric"
+	metricnoop "go.opentelemetry.io/otel/metric/noop"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+	tracenoop "go.opentelemetry.io/otel/trace/noop"
+
+	"github.com/control-monkey/controlmonkey-sdk-go/controlmonkey"
+	"github.com/control-monkey/controlmonkey-sdk-go/controlmonkey/client"
+	"github.com/control-monkey/controlmonkey-sdk-go/controlmonkey/credentials"
+)
+
+func TestInstrumentation(t *testing.T) {
+	var attempts int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Traceparent") == "" {
+			t.Errorf("want trace context to be propagated")
+		}
+		if atomic.AddInt32(&attempts, 1) == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`{"request":{"id":"req-1"},"response":{"errors":[{"code":"not_found","message":"stack not found"}]}}`))
+	}))
+	defer srv.Close()
+
+	spans := new(fakeTracerProvider)
+	meters := new(fakeMeterProvider)
+	inst := New(
+		WithTracerProvider(spans),
+		WithMeterProvider(meters),
+		WithPropagator(propagation.TraceContext{}),
+	)
+
+	cfg := controlmonkey.DefaultConfig().
+		WithBaseURL(srv.URL).
+		WithCredentials(credentials.NewStaticCredentials("token")).
+		WithRetryPolicy(&controlmonkey.RetryPolicy{
+			MaxAttempts:          2,
+			BaseDelay:            time.Millisecond,
+			RetryableStatusCodes: controlmonkey.DefaultRetryableStatusCodes(),
+		}).
+		WithInstrumentation(inst)
+
+	r := client.NewRequest(http.MethodGet, "/stack/stk-1")
+	r.Operation = "stack.ReadStack"
+
+	_, err := client.RequireOK(client.New(cfg).Do(context.Background(), r))
+	if err == nil {
+		t.Fatal("want: error, got: nil")
+	}
+
+	ended := spans.ended
+	if e, a := 3, len(ended); e != a {
+		t.Fatalf("want spans: %d, got: %d", e, a)
+	}
+
+	op := ended[2]
+	if e, a := "stack.ReadStack", op.name; e != a {
+		t.Errorf("want span name: %q, got: %q", e, a)
+	}
+	if e, a := codes.Error, op.status; e != a {
+		t.Errorf("want span status: %v, got: %v", e, a)
+	}
+	wantAttrs := map[attribute.Key]attribute.Value{
+		AttributeOperation:  attribute.StringValue("stack.ReadStack"),
+		AttributeStatusCode: attribute.IntValue(http.StatusNotFound),
+		AttributeRequestID:  attribute.StringValue("req-1"),
+		AttributeErrorCodes: attribute.StringSliceValue([]string{"not_found"}),
+	}
+	for k, v := range wantAttrs {
+		if !reflect.DeepEqual(v, op.attrs[k]) {
+			t.Errorf("want attribute %s: %v, got: %v", k, v.Emit(), op.attrs[k].Emit())
+		}
+	}
+
+	for n, span := range ended[:2] {
+		if e, a := "HTTP GET", span.name; e != a {
+			t.Errorf("want span name: %q, got: %q", e, a)
+		}
+		if e, a := op.SpanContext().SpanID(), span.parent.SpanID(); e != a {
+			t.Errorf("want parent: %v, got: %v", e, a)
+		}
+		if e, a := int64(n+1), span.attrs[AttributeAttempt].AsInt64(); e != a {
+			t.Errorf("want attempt: %d, got: %d", e, a)
+		}
+	}
+
+	opAttr := AttributeOperation.String("stack.ReadStack")
+	get := AttributeMethod.String(http.MethodGet)
+	want := []fakeMeasurement{
+		{name: "controlmonkey.client.requests", value: 1, attrs: attribute.NewSet(opAttr, get, AttributeStatusCode.Int(http.StatusServiceUnavailable))},
+		{name: "controlmonkey.client.retries", value: 1, attrs: attribute.NewSet(opAttr, get)},
+		{name: "controlmonkey.client.requests", value: 1, attrs: attribute.NewSet(opAttr, get, AttributeStatusCode.Int(http.StatusNotFound))},
+		{name: "controlmonkey.client.operation.duration", attrs: attribute.NewSet(opAttr, AttributeStatusCode.Int(http.StatusNotFound))},
+		{name: "controlmonkey.client.failures", value: 1, attrs: attribute.NewSet(opAttr, AttributeStatusCode.Int(http.StatusNotFound))},
+	}
+	got := meters.measurements
+	if e, a := len(want), len(got); e != a {
+		t.Fatalf("want measurements: %d, got: %d", e, a)
+	}
+	for n, m := range got {
+		if m.name == "controlmonkey.client.operation.duration" {
+			if m.value <= 0 {
+				t.Errorf("want positive duration, got: %v", m.value)
+			}
+			m.value = 0
+		}
+		if w := want[n]; m.name != w.name || m.value != w.value || !m.attrs.Equals(&w.attrs) {
+			t.Errorf("measurement %d: want: %s %v %v, got: %s %v %v",
+				n, w.name, w.value, w.attrs.Encoded(attribute.DefaultEncoder()),
+				m.name, m.value, m.attrs.Encoded(attribute.DefaultEncoder()))
+		}
+	}
+}
+
+func TestInstrumentationNoop(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"response":{"items":[{"id":"stk-1"}]}}`))
+	}))
+	defer srv.Close()
+
+	cfg := controlmonkey.DefaultConfig().
+		WithBaseURL(srv.URL).
+		WithCredentials(credentials.NewStaticCredentials("token")).
+		WithInstrumentation(New())
+
+	resp, err := client.RequireOK(client.New(cfg).Do(context.Background(), client.NewRequest(http.MethodGet, "/stack")))
+	if err != nil {
+		t.Fatalf("want: nil, got: %v", err)
+	}
+	resp.Body.Close()
+}
+
+// fakeTracerProvider records the spans started by its tracers, in the order
+// they end.
+type fakeTracerProvider struct {
+	tracenoop.TracerProvider
+
+	mu     sync.Mutex
+	nextID uint64
+	ended  []*fakeSpan
+}
+
+func (p *fakeTracerProvider) Tracer(string, ...trace.TracerOption) trace.Tracer {
+	return &fakeTracer{provider: p}
+}
+
+type fakeTracer struct {
+	tracenoop.Tracer
+	provider *fakeTracerProvider
+}
+
+func (t *fakeTracer) Start(ctx context.Context, name string, opts ...trace.SpanStartOption) (context.Context, trace.Span) {
+	p := t.provider
+	p.mu.Lock()
+	p.nextID++
+	id := p.nextID
+	p.mu.Unlock()
+
+	parent := trace.SpanContextFromContext(ctx)
+	traceID := parent.TraceID()
+	if !traceID.IsValid() {
+		traceID = trace.TraceID{1}
+	}
+	var spanID trace.SpanID
+	spanID[7] = byte(id)
+
+	span := &fakeSpan{
+		provider: p,
+		name:     name,
+		parent:   parent,
+		sc: trace.NewSpanContext(trace.SpanContextConfig{
+			TraceID:    traceID,
+			SpanID:     spanID,
+			TraceFlags: trace.FlagsSampled,
+		}),
+		attrs: make(map[attribute.Key]attribute.Value),
+	}
+	cfg := trace.NewSpanStartConfig(opts...)
+	span.SetAttributes(cfg.Attributes()...)
+	return trace.ContextWithSpan(ctx, span), span
+}
+
+type fakeSpan struct {
+	tracenoop.Span
+	provider *fakeTracerProvider
+
+	name   string
+	sc     trace.SpanContext
+	parent trace.SpanContext
+	attrs  map[attribute.Key]attribute.Value
+	status codes.Code
+}
+
+func (s *fakeSpan) SpanContext() trace.SpanContext { return s.sc }
+
+func (s *fakeSpan) IsRecording() bool { return true }
+
+func (s *fakeSpan) SetStatus(code codes.Code, _ string) { s.status = code }
+
+func (s *fakeSpan) SetAttributes(kvs ...attribute.KeyValue) {
+	for _, kv := range kvs {
+		s.attrs[kv.Key] = kv.Value
+	}
+}
+
+func (s *fakeSpan) End(...trace.SpanEndOption) {
+	s.provider.mu.Lock()
+	defer s.provider.mu.Unlock()
+	s.provider.ended = append(s.provider.ended, s)
+}
+
+// fakeMeterProvider records the measurements of its instruments, in the
+// order they are made.
+type fakeMeterProvider struct {
+	metricnoop.MeterProvider
+
+	mu           sync.Mutex
+	measurements []fakeMeasurement
+}
+
+// A fakeMeasurement is a value added to a counter or recorded by a
+// histogram, along with its attributes.
+type fakeMeasurement struct {
+	name  string
+	value float64
+	attrs attribute.Set
+}
+
+func (p *fakeMeterProvider) Meter(string, ...metric.MeterOption) metric.Meter {
+	return &fakeMeter{provider: p}
+}
+
+func (p *fakeMeterProvider) record(name string, value float64, attrs attribute.Set) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.measurements = append(p.measurements, fakeMeasurement{name: name, value: value, attrs: attrs})
+}
+
+type fakeMeter struct {
+	metricnoop.Meter
+	provider *fakeMeterProvider
+}
+
+func (m *fakeMeter) Int64Counter(name string, _ ...metric.Int64CounterOption) (metric.Int64Counter, error) {
+	return &fakeCounter{name: name, provider: m.provider}, nil
+}
+
+func (m *fakeMeter) Float64Histogram(name string, _ ...metric.Float64HistogramOption) (metric.Float64Histogram, error) {
+	return &fakeHistogram{name: name, provider: m.provider}, nil
+}
+
+type fakeCounter struct {
+	metricnoop.Int64Counter
+	name     string
+	provider *fakeMeterProvider
+}
+
+func (c *fakeCounter) Add(_ context.Context, n int64, opts ...metric.AddOption) {
+	c.provider.record(c.name, float64(n), metric.NewAddConfig(opts).Attributes())
+}
+
+type fakeHistogram struct {
+	metricnoop.Float64Histogram
+	name     string
+	provider *fakeMeterProvider
+}
+
+func (h *fakeHistogram) Record(_ context.Context, value float64, opts ...metric.RecordOption) {
+	h.provider.record(h.name, value, metric.NewRecordConfig(opts).Attributes())
+}
@@ -0,0 +1,553 @@
+// Package fakeapi provides an in-memory fake of the ControlMonkey API, served
+// by an httptest.Server, for exercising code built on the SDK end-to-end
+// without a live API.
+//
+// The fake implements the endpoints called by the services packages, keeps
+// resources in memory, and answers using the API envelope. It reports
+// not_found, already_exist and validation_error errors the way the API does,
+// and moves plans and deployments through their statuses as they are read.
+//
+// Example:
+//
+//	fake := fakeapi.NewServer()
+//	defer fake.Close()
+//
+//	sess := session.New(controlmonkey.DefaultConfig().
+//		WithBaseURL(fake.URL).
+//		WithCredentials(credentials.NewStaticCredentials("token")))
+package fakeapi
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/control-monkey/controlmonkey-sdk-go/controlmonkey/client"
+)
+
+// DefaultRunStatuses are the statuses plans and deployments go through, one
+// step per read, until the last one is reached.
+var DefaultRunStatuses = []string{"queued", "running", "completed"}
+
+// An Option configures a Server.
+type Option func(*Server)
+
+// WithToken requires requests to be authenticated with the given token.
+// By default, any non-empty bearer token is accepted.
+func WithToken(token string) Option {
+	return func(s *Server) { s.token = token }
+}
+
+// WithRunStatuses defines the statuses plans and deployments go through.
+// Defaults to DefaultRunStatuses.
+func WithRunStatuses(statuses ...string) Option {
+	return func(s *Server) { s.runStatuses = statuses }
+}
+
+// A Server is an in-memory fake of the ControlMonkey API. It is safe for
+// concurrent use.
+type Server struct {
+	// URL is the base URL of the server, to be used with
+	// controlmonkey.Config.WithBaseURL.
+	URL string
+
+	srv         *httptest.Server
+	token       string
+	runStatuses []string
+	collections []*collection
+
+	mu        sync.Mutex
+	items     map[string][]object
+	runSteps  map[string]int
+	orgConfig object
+	nextID    int
+	nextReqID int
+}
+
+var _ http.Handler = &Server{}
+
+// NewServer starts and returns a new Server. The caller should call Close
+// when finished, to shut it down.
+func NewServer(opts ...Option) *Server {
+	s := &Server{
+		runStatuses: DefaultRunStatuses,
+		collections: collections(),
+		items:       make(map[string][]object),
+		runSteps:    make(map[string]int),
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+
+	// Longer paths first, so that e.g. "/stack/plan" is matched before
+	// "/stack".
+	sort.SliceStable(s.collections, func(i, j int) bool {
+		return len(s.collections[i].path) > len(s.collections[j].path)
+	})
+
+	s.srv = httptest.NewServer(s)
+	s.URL = s.srv.URL
+	return s
+}
+
+// Close shuts down the server.
+func (s *Server) Close() { s.srv.Close() }
+
+// Seed stores a resource in the collection at path (e.g. "/namespace"),
+// bypassing validation, and returns its ID. An ID is generated unless the
+// resource already has one.
+func (s *Server) Seed(path string, item map[string]interface{}) (string, error) {
+	c := s.collection(path)
+	if c == nil {
+		return "", fmt.Errorf("fakeapi: unknown collection %q", path)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	o := clone(item)
+	if !c.isMapping() {
+		if id, _ := o["id"].(string); id == "" {
+			o["id"] = s.newID(c.idPrefix)
+		}
+	}
+	s.items[c.path] = append(s.items[c.path], o)
+
+	id, _ := o["id"].(string)
+	return id, nil
+}
+
+// Items returns a copy of the resources of the collection at path.
+func (s *Server) Items(path string) []map[string]interface{} {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	items := s.items[path]
+	out := make([]map[string]interface{}, len(items))
+	for i, o := range items {
+		out[i] = clone(o)
+	}
+	return out
+}
+
+// ServeHTTP implements http.Handler.
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	rw := &responseWriter{w: w, requestID: s.newRequestID()}
+
+	if !s.authorized(r) {
+		rw.error(http.StatusUnauthorized, "unauthorized", "", "invalid or missing token")
+		return
+	}
+
+	body, err := decodeBody(r)
+	if err != nil {
+		rw.error(http.StatusBadRequest, client.ErrorCodeValidationError, "", "invalid request body: "+err.Error())
+		return
+	}
+
+	if r.URL.Path == pathOrgConfiguration {
+		s.serveOrgConfiguration(rw, r, body)
+		return
+	}
+
+	for _, c := range s.collections {
+		switch {
+		case r.URL.Path == c.path:
+			s.serveCollection(rw, r, c, body)
+			return
+		case strings.HasPrefix(r.URL.Path, c.path+"/"):
+			if id := strings.TrimPrefix(r.URL.Path, c.path+"/"); id != "" && !strings.Contains(id, "/") && !c.isMapping() {
+				s.serveItem(rw, r, c, id, body)
+				return
+			}
+		}
+	}
+
+	rw.error(http.StatusNotFound, client.ErrorCodeNotFound, "", "no route for "+r.Method+" "+r.URL.Path)
+}
+
+func (s *Server) serveCollection(rw *responseWriter, r *http.Request, c *collection, body object) {
+	switch {
+	case r.Method == http.MethodGet:
+		s.list(rw, r, c)
+	case r.Method == http.MethodPost:
+		s.create(rw, c, body)
+	case r.Method == http.MethodPut && c.isMapping():
+		s.updateMapping(rw, c, body)
+	case r.Method == http.MethodDelete && c.isMapping():
+		s.deleteMapping(rw, c, body)
+	default:
+		rw.methodNotAllowed(r)
+	}
+}
+
+func (s *Server) serveItem(rw *responseWriter, r *http.Request, c *collection, id string, body object) {
+	i := s.find(c, id)
+	if i < 0 {
+		rw.error(http.StatusNotFound, client.ErrorCodeNotFound, "", fmt.Sprintf("%s %s not found", c.path, id))
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		o := s.items[c.path][i]
+		if c.run {
+			s.advance(o)
+		}
+		rw.items(s.render(c, o))
+	case http.MethodPut:
+		if c.run {
+			rw.methodNotAllowed(r)
+			return
+		}
+		o := clone(s.items[c.path][i])
+		merge(o, body)
+		o["id"] = id
+		if !s.validate(rw, c, o, i) {
+			return
+		}
+		s.items[c.path][i] = o
+		rw.items(s.render(c, o))
+	case http.MethodDelete:
+		if c.run {
+			rw.methodNotAllowed(r)
+			return
+		}
+		s.remove(c, i)
+		rw.items()
+	default:
+		rw.methodNotAllowed(r)
+	}
+}
+
+func (s *Server) list(rw *responseWriter, r *http.Request, c *collection) {
+	query := r.URL.Query()
+
+	var out []object
+	for _, o := range s.items[c.path] {
+		if c.match(o, query) {
+			out = append(out, s.render(c, o))
+		}
+	}
+
+	// Page through the results, as requested by client.Paginator.
+	if offset, err := strconv.Atoi(query.Get("offset")); err == nil && offset > 0 {
+		out = out[min(offset, len(out)):]
+	}
+	if limit, err := strconv.Atoi(query.Get("limit")); err == nil && limit > 0 {
+		out = out[:min(limit, len(out))]
+	}
+
+	rw.items(out...)
+}
+
+func (s *Server) create(rw *responseWriter, c *collection, body object) {
+	if inner, ok := body[c.createKey].(map[string]interface{}); ok && c.createKey != "" {
+		body = inner
+	}
+
+	o := clone(body)
+	delete(o, "id")
+	if !s.validate(rw, c, o, -1) {
+		return
+	}
+
+	if !c.isMapping() {
+		o["id"] = s.newID(c.idPrefix)
+	}
+	if c.run {
+		o["status"] = s.runStatuses[0]
+		o["isActive"] = len(s.runStatuses) > 1
+		o["createdAt"] = time.Now().UTC().Format(time.RFC3339)
+	}
+
+	s.items[c.path] = append(s.items[c.path], o)
+	rw.items(s.render(c, o))
+}
+
+func (s *Server) updateMapping(rw *responseWriter, c *collection, body object) {
+	i := s.findMapping(c, body)
+	if i < 0 {
+		rw.error(http.StatusNotFound, client.ErrorCodeNotFound, "", c.path+" mapping not found")
+		return
+	}
+
+	o := clone(s.items[c.path][i])
+	merge(o, body)
+	if !s.validate(rw, c, o, i) {
+		return
+	}
+	s.items[c.path][i] = o
+	rw.items(s.render(c, o))
+}
+
+func (s *Server) deleteMapping(rw *responseWriter, c *collection, body object) {
+	i := s.findMapping(c, body)
+	if i < 0 {
+		rw.error(http.StatusNotFound, client.ErrorCodeNotFound, "", c.path+" mapping not found")
+		return
+	}
+	s.remove(c, i)
+	rw.items()
+}
+
+func (s *Server) serveOrgConfiguration(rw *responseWriter, r *http.Request, body object) {
+	switch r.Method {
+	case http.MethodGet:
+		if s.orgConfig == nil {
+			rw.items()
+			return
+		}
+		rw.items(clone(s.orgConfig))
+	case http.MethodPut:
+		if s.orgConfig == nil {
+			s.orgConfig = object{"id": s.newID("org")}
+		}
+		merge(s.orgConfig, body)
+		rw.items(clone(s.orgConfig))
+	case http.MethodDelete:
+		s.orgConfig = nil
+		rw.items()
+	default:
+		rw.methodNotAllowed(r)
+	}
+}
+
+// validate checks that o, stored at index self of its collection (or -1 if
+// new), has its required fields, refers to existing resources, and does not
+// conflict with another resource. It writes the error response, if any.
+func (s *Server) validate(rw *responseWriter, c *collection, o object, self int) bool {
+	for _, field := range c.required {
+		if isEmpty(o[field]) {
+			rw.error(http.StatusBadRequest, client.ErrorCodeValidationError, field, field+" is required")
+			return false
+		}
+	}
+
+	fields := make([]string, 0, len(c.refs))
+	for field := range c.refs {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+	for _, field := range fields {
+		id, _ := o[field].(string)
+		if id == "" {
+			continue
+		}
+		if s.find(s.collection(c.refs[field]), id) < 0 {
+			rw.error(http.StatusBadRequest, client.ErrorCodeValidationError, field,
+				fmt.Sprintf("%s %s does not exist", field, id))
+			return false
+		}
+	}
+
+	unique := c.unique
+	if c.isMapping() {
+		unique = c.keys
+	}
+	if len(unique) > 0 {
+		for i, other := range s.items[c.path] {
+			if i != self && sameFields(o, other, unique) {
+				rw.error(http.StatusConflict, client.ErrorCodeAlreadyExist, "",
+					fmt.Sprintf("%s with the same %s already exists", c.path, strings.Join(unique, ", ")))
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// advance moves a run to its next status.
+func (s *Server) advance(o object) {
+	id, _ := o["id"].(string)
+	step := s.runSteps[id]
+	if step < len(s.runStatuses)-1 {
+		step++
+		s.runSteps[id] = step
+	}
+	o["status"] = s.runStatuses[step]
+	o["isActive"] = step < len(s.runStatuses)-1
+}
+
+func (s *Server) render(c *collection, o object) object {
+	if c.render != nil {
+		return c.render(o)
+	}
+	return clone(o)
+}
+
+func (s *Server) authorized(r *http.Request) bool {
+	token := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+	if token == "" || token == r.Header.Get("Authorization") {
+		return false
+	}
+	return s.token == "" || token == s.token
+}
+
+func (s *Server) collection(path string) *collection {
+	for _, c := range s.collections {
+		if c.path == path {
+			return c
+		}
+	}
+	return nil
+}
+
+func (s *Server) find(c *collection, id string) int {
+	if c == nil {
+		return -1
+	}
+	for i, o := range s.items[c.path] {
+		if o["id"] == id {
+			return i
+		}
+	}
+	return -1
+}
+
+func (s *Server) findMapping(c *collection, key object) int {
+	for i, o := range s.items[c.path] {
+		if sameFields(o, key, c.keys) {
+			return i
+		}
+	}
+	return -1
+}
+
+func (s *Server) remove(c *collection, i int) {
+	items := s.items[c.path]
+	s.items[c.path] = append(items[:i:i], items[i+1:]...)
+}
+
+func (s *Server) newID(prefix string) string {
+	s.nextID++
+	return fmt.Sprintf("%s-%d", prefix, s.nextID)
+}
+
+func (s *Server) newRequestID() string {
+	s.nextReqID++
+	return fmt.Sprintf("req-%d", s.nextReqID)
+}
+
+// responseWriter writes responses using the API envelope.
+type responseWriter struct {
+	w         http.ResponseWriter
+	requestID string
+}
+
+type envelope struct {
+	Request struct {
+		ID string `json:"id"`
+	} `json:"request"`
+	Response struct {
+		Items  []object        `json:"items,omitempty"`
+		Errors []responseError `json:"errors,omitempty"`
+	} `json:"response"`
+}
+
+type responseError struct {
+	Code    string `json:"code"`
+	Message string `json:"message"`
+	Field   string `json:"field,omitempty"`
+}
+
+func (rw *responseWriter) items(items ...object) {
+	var env envelope
+	env.Response.Items = items
+	if env.Response.Items == nil {
+		env.Response.Items = make([]object, 0)
+	}
+	rw.write(http.StatusOK, &env)
+}
+
+func (rw *responseWriter) error(status int, code, field, message string) {
+	var env envelope
+	env.Response.Errors = []responseError{{Code: code, Message: message, Field: field}}
+	rw.write(status, &env)
+}
+
+func (rw *responseWriter) methodNotAllowed(r *http.Request) {
+	rw.error(http.StatusMethodNotAllowed, "method_not_allowed", "", r.Method+" is not allowed on "+r.URL.Path)
+}
+
+func (rw *responseWriter) write(status int, env *envelope) {
+	env.Request.ID = rw.requestID
+	rw.w.Header().Set("Content-Type", "application/json")
+	rw.w.Header().Set("X-Request-Id", rw.requestID)
+	rw.w.WriteHeader(status)
+	json.NewEncoder(rw.w).Encode(env)
+}
+
+// decodeBody decodes the JSON body of r, unwrapping the entity the SDK wraps
+// request bodies in.
+func decodeBody(r *http.Request) (object, error) {
+	var buf bytes.Buffer
+	if r.Body != nil {
+		if _, err := buf.ReadFrom(r.Body); err != nil {
+			return nil, err
+		}
+	}
+	if len(bytes.TrimSpace(buf.Bytes())) == 0 {
+		return object{}, nil
+	}
+
+	var body object
+	if err := json.Unmarshal(buf.Bytes(), &body); err != nil {
+		return nil, err
+	}
+	if entity, ok := body["entity"].(map[string]interface{}); ok && len(body) == 1 {
+		return entity, nil
+	}
+	return body, nil
+}
+
+// merge sets the fields of src into dst. Null fields are removed from dst.
+func merge(dst, src object) {
+	for k, v := range src {
+		if v == nil {
+			delete(dst, k)
+			continue
+		}
+		dst[k] = v
+	}
+}
+
+func clone(o object) object {
+	out := make(object, len(o))
+	for k, v := range o {
+		out[k] = v
+	}
+	return out
+}
+
+func isEmpty(v interface{}) bool {
+	switch t := v.(type) {
+	case nil:
+		return true
+	case string:
+		return t == ""
+	default:
+		return false
+	}
+}
+
+func sameFields(a, b object, fields []string) bool {
+	for _, f := range fields {
+		if !reflect.DeepEqual(a[f], b[f]) {
+			return false
+		}
+	}
+	return true
+}
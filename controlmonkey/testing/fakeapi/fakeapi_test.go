@@ -0,0 +1,208 @@
+package fakeapi_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/control-monkey/controlmonkey-sdk-go/controlmonkey"
+	"github.com/control-monkey/controlmonkey-sdk-go/controlmonkey/client"
+	"github.com/control-monkey/controlmonkey-sdk-go/controlmonkey/credentials"
+	"github.com/control-monkey/controlmonkey-sdk-go/controlmonkey/session"
+	"github.com/control-monkey/controlmonkey-sdk-go/controlmonkey/testing/fakeapi"
+	"github.com/control-monkey/controlmonkey-sdk-go/services/namespace"
+	"github.com/control-monkey/controlmonkey-sdk-go/services/stack"
+	"github.com/control-monkey/controlmonkey-sdk-go/services/team"
+)
+
+func newSession(fake *fakeapi.Server, token string) *session.Session {
+	return session.New(controlmonkey.DefaultConfig().
+		WithBaseURL(fake.URL).
+		WithCredentials(credentials.NewStaticCredentials(token)).
+		WithRetryPolicy(controlmonkey.NoRetryPolicy()))
+}
+
+func TestStackLifecycle(t *testing.T) {
+	ctx := context.Background()
+	fake := fakeapi.NewServer()
+	defer fake.Close()
+
+	sess := newSession(fake, "token")
+	namespaces, stacks := namespace.New(sess), stack.New(sess)
+
+	ns, err := namespaces.CreateNamespace(ctx, &namespace.Namespace{Name: controlmonkey.String("dev")})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	st, err := stacks.CreateStack(ctx, &stack.Stack{
+		Name:        controlmonkey.String("app"),
+		NamespaceId: ns.ID,
+		IacType:     controlmonkey.String("terraform"),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	stackID := controlmonkey.StringValue(st.ID)
+
+	// Duplicate name in the same namespace.
+	_, err = stacks.CreateStack(ctx, &stack.Stack{
+		Name:        controlmonkey.String("app"),
+		NamespaceId: ns.ID,
+		IacType:     controlmonkey.String("terraform"),
+	})
+	if !client.IsAlreadyExists(err) {
+		t.Errorf("want: already exists error, got: %v", err)
+	}
+
+	// Missing required field.
+	_, err = stacks.CreateStack(ctx, &stack.Stack{NamespaceId: ns.ID, IacType: controlmonkey.String("terraform")})
+	if errs := client.ValidationErrors(err); len(errs) != 1 || errs[0].Field != "name" {
+		t.Errorf("want: validation error on name, got: %v", err)
+	}
+
+	// Unknown namespace.
+	_, err = stacks.CreateStack(ctx, &stack.Stack{
+		Name:        controlmonkey.String("other"),
+		NamespaceId: controlmonkey.String("ns-unknown"),
+		IacType:     controlmonkey.String("terraform"),
+	})
+	if errs := client.ValidationErrors(err); len(errs) != 1 || errs[0].Field != "namespaceId" {
+		t.Errorf("want: validation error on namespaceId, got: %v", err)
+	}
+
+	updated, err := stacks.UpdateStack(ctx, stackID, &stack.Stack{Description: controlmonkey.String("main app")})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := controlmonkey.StringValue(updated.Description); got != "main app" {
+		t.Errorf("want: main app, got: %s", got)
+	}
+	if got := controlmonkey.StringValue(updated.Name); got != "app" {
+		t.Errorf("want: app, got: %s", got)
+	}
+
+	list, err := stacks.ListStacks(ctx, nil, nil, ns.ID)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(list) != 1 || controlmonkey.StringValue(list[0].ID) != stackID {
+		t.Errorf("want: [%s], got: %v", stackID, list)
+	}
+
+	if _, err := stacks.DeleteStack(ctx, stackID); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := stacks.ReadStack(ctx, stackID); !client.IsNotFound(err) {
+		t.Errorf("want: not found error, got: %v", err)
+	}
+}
+
+func TestPlanStatusTransitions(t *testing.T) {
+	ctx := context.Background()
+	fake := fakeapi.NewServer(fakeapi.WithRunStatuses("queued", "running", "succeeded"))
+	defer fake.Close()
+
+	stackID, err := fake.Seed("/stack", map[string]interface{}{"name": "app"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	stacks := stack.New(newSession(fake, "token"))
+	created, err := stacks.CreatePlan(ctx, &stack.CreatePlanInput{StackId: controlmonkey.String(stackID)})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := controlmonkey.StringValue(created.Plan.Status); got != "queued" {
+		t.Errorf("want: queued, got: %s", got)
+	}
+
+	for _, want := range []string{"running", "succeeded", "succeeded"} {
+		out, err := stacks.ReadPlan(ctx, &stack.ReadPlanInput{PlanId: created.Plan.ID})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if got := controlmonkey.StringValue(out.Plan.Status); got != want {
+			t.Errorf("want: %s, got: %s", want, got)
+		}
+		if got, want := controlmonkey.BoolValue(out.Plan.IsActive), want != "succeeded"; got != want {
+			t.Errorf("want: active %v, got: %v", want, got)
+		}
+	}
+
+	_, err = stacks.CreatePlan(ctx, &stack.CreatePlanInput{StackId: controlmonkey.String("stk-unknown")})
+	if !client.IsValidationError(err) {
+		t.Errorf("want: validation error, got: %v", err)
+	}
+}
+
+func TestPagination(t *testing.T) {
+	fake := fakeapi.NewServer()
+	defer fake.Close()
+
+	for i := 0; i < 5; i++ {
+		if _, err := fake.Seed("/namespace", map[string]interface{}{"name": fmt.Sprintf("ns%d", i)}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	p := namespace.New(newSession(fake, "token")).
+		ListNamespacesPaginator(nil, nil, &client.PageOptions{PageSize: 2})
+	items, err := p.All(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(items) != 5 {
+		t.Errorf("want: 5 namespaces, got: %d", len(items))
+	}
+}
+
+func TestTeamUsers(t *testing.T) {
+	ctx := context.Background()
+	fake := fakeapi.NewServer()
+	defer fake.Close()
+
+	teams := team.New(newSession(fake, "token"))
+	tm, err := teams.CreateTeam(ctx, &team.Team{Name: controlmonkey.String("ops")})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	user := &team.TeamUser{TeamId: tm.ID, UserEmail: controlmonkey.String("jo@example.com")}
+	if _, err := teams.CreateTeamUser(ctx, user); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := teams.CreateTeamUser(ctx, user); !client.IsAlreadyExists(err) {
+		t.Errorf("want: already exists error, got: %v", err)
+	}
+
+	users, err := teams.ListTeamUsers(ctx, controlmonkey.StringValue(tm.ID))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(users) != 1 || controlmonkey.StringValue(users[0].UserEmail) != "jo@example.com" {
+		t.Errorf("want: [jo@example.com], got: %v", users)
+	}
+
+	if _, err := teams.DeleteTeamUser(ctx, user); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := teams.DeleteTeamUser(ctx, user); !client.IsNotFound(err) {
+		t.Errorf("want: not found error, got: %v", err)
+	}
+}
+
+func TestToken(t *testing.T) {
+	fake := fakeapi.NewServer(fakeapi.WithToken("good"))
+	defer fake.Close()
+
+	_, err := namespace.New(newSession(fake, "bad")).ListNamespaces(context.Background(), nil, nil)
+	if code, _ := client.StatusCode(err); code != 401 {
+		t.Errorf("want: 401, got: %v", err)
+	}
+
+	_, err = namespace.New(newSession(fake, "good")).ListNamespaces(context.Background(), nil, nil)
+	if err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
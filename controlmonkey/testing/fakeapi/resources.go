@@ -0,0 +1,355 @@
+package fakeapi
+
+import (
+	"net/url"
+)
+
+// object is a resource, as encoded in JSON.
+type object = map[string]interface{}
+
+// A filter reports whether a resource matches the value of a query
+// parameter of a list request.
+type filter func(o object, value string) bool
+
+// A collection describes the endpoints of a resource type.
+type collection struct {
+	// path is the path of the collection, e.g. "/stack".
+	path string
+
+	// idPrefix is the prefix of generated IDs. Collections without a prefix
+	// hold mappings, which have no ID and are identified by keys instead.
+	idPrefix string
+
+	// keys are the fields identifying a mapping.
+	keys []string
+
+	// createKey, if set, is the field the resource is wrapped in when
+	// created.
+	createKey string
+
+	// required are the fields required to create a resource.
+	required []string
+
+	// unique are the fields whose values must be unique, together, among
+	// the resources of the collection.
+	unique []string
+
+	// refs maps fields to the path of the collection they refer to.
+	refs map[string]string
+
+	// filters maps query parameters of list requests to filters.
+	filters map[string]filter
+
+	// run states whether resources are runs (i.e. plans and deployments)
+	// going through status transitions.
+	run bool
+
+	// render, if set, converts a stored resource to its API representation.
+	render func(object) object
+}
+
+func (c *collection) isMapping() bool { return c.idPrefix == "" }
+
+// match reports whether o matches the filters of a list request.
+func (c *collection) match(o object, query url.Values) bool {
+	for param, f := range c.filters {
+		if v := query.Get(param); v != "" && !f(o, v) {
+			return false
+		}
+	}
+	return true
+}
+
+// eq returns a filter matching resources whose field equals the value.
+func eq(field string) filter {
+	return func(o object, value string) bool {
+		s, _ := o[field].(string)
+		return s == value
+	}
+}
+
+// scoped returns a filter matching resources of the given scope, whose scope
+// ID equals the value.
+func scoped(scope string) filter {
+	return func(o object, value string) bool {
+		return eq("scope")(o, scope) && eq("scopeId")(o, value)
+	}
+}
+
+// orgOnly is a filter matching organization-scoped resources.
+func orgOnly(o object, value string) bool {
+	return value != "true" || eq("scope")(o, "organization")
+}
+
+// ignore is a filter matching every resource.
+func ignore(object, string) bool { return true }
+
+// Paths of the collections referred to elsewhere in the package.
+const (
+	pathNamespace          = "/namespace"
+	pathStack              = "/stack"
+	pathTemplate           = "/template"
+	pathBlueprint          = "/blueprint"
+	pathTeam               = "/iam/org/team"
+	pathCustomRole         = "/iam/org/customRole"
+	pathControlPolicy      = "/controlPolicy"
+	pathControlPolicyGroup = "/controlPolicyGroup"
+	pathEndpoint           = "/notification/endpoint"
+	pathPlan               = "/stack/plan"
+	pathDeployment         = "/stack/deployment"
+	pathOrgConfiguration   = "/org/configuration"
+)
+
+// collections returns the collections served by the fake API.
+func collections() []*collection {
+	return []*collection{
+		{
+			path:     pathNamespace,
+			idPrefix: "ns",
+			required: []string{"name"},
+			unique:   []string{"name"},
+			filters: map[string]filter{
+				"namespaceId":   eq("id"),
+				"namespaceName": eq("name"),
+			},
+		},
+		{
+			path:      pathStack,
+			idPrefix:  "stk",
+			createKey: "stack",
+			required:  []string{"name", "namespaceId", "iacType"},
+			unique:    []string{"namespaceId", "name"},
+			refs:      map[string]string{"namespaceId": pathNamespace},
+			filters: map[string]filter{
+				"stackId":     eq("id"),
+				"stackName":   eq("name"),
+				"namespaceId": eq("namespaceId"),
+			},
+		},
+		{
+			path:     "/stack/dependency",
+			idPrefix: "dep",
+			required: []string{"stackId", "dependsOnStackId"},
+			unique:   []string{"stackId", "dependsOnStackId"},
+			refs: map[string]string{
+				"stackId":          pathStack,
+				"dependsOnStackId": pathStack,
+			},
+		},
+		{
+			path:     pathPlan,
+			idPrefix: "plan",
+			required: []string{"stackId"},
+			refs:     map[string]string{"stackId": pathStack},
+			run:      true,
+		},
+		{
+			path:     pathDeployment,
+			idPrefix: "deploy",
+			required: []string{"stackId"},
+			refs:     map[string]string{"stackId": pathStack},
+			run:      true,
+		},
+		{
+			path:     "/stackDiscoveryConfiguration",
+			idPrefix: "sdc",
+			required: []string{"name", "namespaceId"},
+			unique:   []string{"name"},
+			refs:     map[string]string{"namespaceId": pathNamespace},
+		},
+		{
+			path:     "/variable",
+			idPrefix: "var",
+			required: []string{"scope", "key"},
+			unique:   []string{"scope", "scopeId", "key"},
+			filters: map[string]filter{
+				"stackId":     scoped("stack"),
+				"namespaceId": scoped("namespace"),
+				"templateId":  scoped("template"),
+				"orgOnly":     orgOnly,
+				"stackRunId":  ignore,
+			},
+		},
+		{
+			path:     pathTemplate,
+			idPrefix: "tmpl",
+			required: []string{"name", "iacType"},
+			unique:   []string{"name"},
+			filters: map[string]filter{
+				"templateId":   eq("id"),
+				"templateName": eq("name"),
+			},
+		},
+		{
+			path:     "/template/templateNamespaceMapping",
+			keys:     []string{"templateId", "namespaceId"},
+			required: []string{"templateId", "namespaceId"},
+			refs: map[string]string{
+				"templateId":  pathTemplate,
+				"namespaceId": pathNamespace,
+			},
+			filters: map[string]filter{"templateId": eq("templateId")},
+		},
+		{
+			path:     pathBlueprint,
+			idPrefix: "blp",
+			required: []string{"name"},
+			unique:   []string{"name"},
+			filters: map[string]filter{
+				"blueprintId":   eq("id"),
+				"blueprintName": eq("name"),
+			},
+		},
+		{
+			path:     "/blueprint/blueprintNamespaceMapping",
+			keys:     []string{"blueprintId", "namespaceId"},
+			required: []string{"blueprintId", "namespaceId"},
+			refs: map[string]string{
+				"blueprintId": pathBlueprint,
+				"namespaceId": pathNamespace,
+			},
+			filters: map[string]filter{"blueprintId": eq("blueprintId")},
+		},
+		{
+			path:     pathTeam,
+			idPrefix: "team",
+			required: []string{"name"},
+			unique:   []string{"name"},
+			filters: map[string]filter{
+				"teamId":   eq("id"),
+				"teamName": eq("name"),
+			},
+		},
+		{
+			path:     "/iam/org/teamUser",
+			keys:     []string{"teamId", "userEmail"},
+			required: []string{"teamId", "userEmail"},
+			refs:     map[string]string{"teamId": pathTeam},
+			filters:  map[string]filter{"teamId": eq("teamId")},
+			render: func(o object) object {
+				return object{"email": o["userEmail"]}
+			},
+		},
+		{
+			path:     "/iam/org/namespacePermission",
+			keys:     []string{"namespaceId", "stackId", "userEmail", "programmaticUserName", "teamId"},
+			required: []string{"role"},
+			filters: map[string]filter{
+				"namespaceId": eq("namespaceId"),
+				"stackId":     eq("stackId"),
+			},
+		},
+		{
+			path:     pathCustomRole,
+			idPrefix: "cro",
+			required: []string{"name"},
+			unique:   []string{"name"},
+			filters: map[string]filter{
+				"customRoleId":   eq("id"),
+				"customRoleName": eq("name"),
+			},
+		},
+		{
+			path:     "/iam/org/customAbacConfiguration",
+			idPrefix: "abac",
+			required: []string{"name"},
+			unique:   []string{"name"},
+			filters: map[string]filter{
+				"customAbacConfigurationId":   eq("id"),
+				"customAbacConfigurationName": eq("name"),
+			},
+		},
+		{
+			path:     pathControlPolicy,
+			idPrefix: "cmp",
+			required: []string{"name", "type"},
+			unique:   []string{"name"},
+			filters: map[string]filter{
+				"controlPolicyId":   eq("id"),
+				"controlPolicyName": eq("name"),
+				"includeManaged":    ignore,
+			},
+		},
+		{
+			path:     "/controlPolicy/controlPolicyMapping",
+			keys:     []string{"controlPolicyId", "targetId", "targetType"},
+			required: []string{"controlPolicyId", "targetId", "targetType"},
+			refs:     map[string]string{"controlPolicyId": pathControlPolicy},
+			filters:  map[string]filter{"controlPolicyId": eq("controlPolicyId")},
+		},
+		{
+			path:     pathControlPolicyGroup,
+			idPrefix: "cmpg",
+			required: []string{"name"},
+			unique:   []string{"name"},
+			filters: map[string]filter{
+				"controlPolicyGroupId":   eq("id"),
+				"controlPolicyGroupName": eq("name"),
+				"includeManaged":         ignore,
+			},
+		},
+		{
+			path:     "/controlPolicyGroup/controlPolicyGroupMapping",
+			keys:     []string{"controlPolicyGroupId", "targetId", "targetType"},
+			required: []string{"controlPolicyGroupId", "targetId", "targetType"},
+			refs:     map[string]string{"controlPolicyGroupId": pathControlPolicyGroup},
+			filters:  map[string]filter{"controlPolicyGroupId": eq("controlPolicyGroupId")},
+		},
+		{
+			path:     "/runTask",
+			idPrefix: "rt",
+			required: []string{"name", "url"},
+			unique:   []string{"name"},
+			filters: map[string]filter{
+				"runTaskId":   eq("id"),
+				"runTaskName": eq("name"),
+			},
+		},
+		{
+			path:     pathEndpoint,
+			idPrefix: "ne",
+			required: []string{"name", "protocol"},
+			unique:   []string{"name"},
+			filters: map[string]filter{
+				"endpointId":   eq("id"),
+				"endpointName": eq("name"),
+			},
+		},
+		{
+			path:     "/notification/subscription",
+			idPrefix: "nes",
+			required: []string{"notificationEndpointId", "scope", "eventType"},
+			unique:   []string{"notificationEndpointId", "scope", "scopeId", "eventType"},
+			refs:     map[string]string{"notificationEndpointId": pathEndpoint},
+			filters: map[string]filter{
+				"namespaceId": scoped("namespace"),
+				"orgOnly":     orgOnly,
+			},
+		},
+		{
+			path:     "/notification/slackApp",
+			idPrefix: "nsa",
+			required: []string{"name"},
+			unique:   []string{"name"},
+			filters: map[string]filter{
+				"slackAppId":   eq("id"),
+				"slackAppName": eq("name"),
+			},
+		},
+		{
+			path:     "/org/externalCredentials",
+			idPrefix: "ext",
+			required: []string{"name"},
+			filters: map[string]filter{
+				"credentialsId":     eq("id"),
+				"credentialsName":   eq("name"),
+				"credentialsVendor": eq("vendor"),
+			},
+		},
+		{
+			path:     "/disasterRecovery/configuration",
+			idPrefix: "drc",
+			required: []string{"scope"},
+		},
+	}
+}
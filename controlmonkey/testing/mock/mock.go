@@ -0,0 +1,167 @@
+// Package mock provides the call recording and expectation helpers shared by
+// the generated mocks of the services packages (e.g. services/stack/mocks).
+//
+// Example:
+//
+//	m := new(mocks.Service)
+//	m.OnReadStack(&stack.Stack{ID: controlmonkey.String("stk-1")}, nil)
+//
+//	runAutomation(ctx, m)
+//
+//	m.AssertCalled(t, "ReadStack", "stk-1")
+//	m.AssertNotCalled(t, "DeleteStack")
+package mock
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"sync"
+)
+
+// ErrNotStubbed is returned by mocked methods that were called without a
+// canned response or implementation.
+var ErrNotStubbed = errors.New("mock: method not stubbed")
+
+// Any matches any argument in expectations.
+var Any = anyArg{}
+
+type anyArg struct{}
+
+func (anyArg) String() string { return "mock.Any" }
+
+// A Call is a recorded call of a mocked method. The context argument of the
+// method, if any, is not recorded.
+type Call struct {
+	Method string
+	Args   []interface{}
+}
+
+// String returns the string representation of the call.
+func (c Call) String() string {
+	return fmt.Sprintf("%s%v", c.Method, c.Args)
+}
+
+// TB is the subset of testing.TB used by the expectation helpers.
+type TB interface {
+	Helper()
+	Errorf(format string, args ...interface{})
+}
+
+// Recorder records the calls of mocked methods. It is embedded in the
+// generated mocks, and is safe for concurrent use.
+type Recorder struct {
+	mu    sync.Mutex
+	calls []Call
+}
+
+// Record records a call of method with the given arguments.
+func (r *Recorder) Record(method string, args ...interface{}) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.calls = append(r.calls, Call{Method: method, Args: args})
+}
+
+// Calls returns the recorded calls, in order.
+func (r *Recorder) Calls() []Call {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]Call(nil), r.calls...)
+}
+
+// CallsTo returns the recorded calls of method, in order.
+func (r *Recorder) CallsTo(method string) []Call {
+	var out []Call
+	for _, c := range r.Calls() {
+		if c.Method == method {
+			out = append(out, c)
+		}
+	}
+	return out
+}
+
+// CallCount returns the number of recorded calls of method.
+func (r *Recorder) CallCount(method string) int {
+	return len(r.CallsTo(method))
+}
+
+// Reset forgets the recorded calls.
+func (r *Recorder) Reset() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.calls = nil
+}
+
+// Called reports whether method was called with the given arguments. Use Any
+// to match any argument. Arguments are compared after dereferencing pointers,
+// so e.g. "stk-1" matches a *string pointing to "stk-1".
+func (r *Recorder) Called(method string, args ...interface{}) bool {
+	for _, c := range r.CallsTo(method) {
+		if matchArgs(c.Args, args) {
+			return true
+		}
+	}
+	return false
+}
+
+// AssertCalled fails the test unless method was called with the given
+// arguments. See Called.
+func (r *Recorder) AssertCalled(t TB, method string, args ...interface{}) bool {
+	t.Helper()
+	if r.Called(method, args...) {
+		return true
+	}
+	t.Errorf("mock: want: call %s%v, got: %v", method, args, r.CallsTo(method))
+	return false
+}
+
+// AssertNotCalled fails the test if method was called.
+func (r *Recorder) AssertNotCalled(t TB, method string) bool {
+	t.Helper()
+	if calls := r.CallsTo(method); len(calls) > 0 {
+		t.Errorf("mock: want: no call to %s, got: %v", method, calls)
+		return false
+	}
+	return true
+}
+
+// AssertCallCount fails the test unless method was called n times.
+func (r *Recorder) AssertCallCount(t TB, method string, n int) bool {
+	t.Helper()
+	if got := r.CallCount(method); got != n {
+		t.Errorf("mock: want: %d calls to %s, got: %d", n, method, got)
+		return false
+	}
+	return true
+}
+
+func matchArgs(got, want []interface{}) bool {
+	if len(got) != len(want) {
+		return false
+	}
+	for i := range want {
+		if want[i] == Any {
+			continue
+		}
+		if !reflect.DeepEqual(indirect(got[i]), indirect(want[i])) {
+			return false
+		}
+	}
+	return true
+}
+
+// indirect dereferences pointers, so that values can be compared regardless
+// of whether they are passed by pointer.
+func indirect(v interface{}) interface{} {
+	rv := reflect.ValueOf(v)
+	for rv.Kind() == reflect.Pointer {
+		if rv.IsNil() {
+			return nil
+		}
+		rv = rv.Elem()
+	}
+	if !rv.IsValid() {
+		return nil
+	}
+	return rv.Interface()
+}
@@ -0,0 +1,144 @@
+package mock_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/control-monkey/controlmonkey-sdk-go/controlmonkey"
+	"github.com/control-monkey/controlmonkey-sdk-go/controlmonkey/client"
+	"github.com/control-monkey/controlmonkey-sdk-go/controlmonkey/testing/mock"
+	"github.com/control-monkey/controlmonkey-sdk-go/services/stack"
+	"github.com/control-monkey/controlmonkey-sdk-go/services/stack/mocks"
+)
+
+// fakeTB records the failures of expectation helpers.
+type fakeTB struct {
+	errors []string
+}
+
+func (*fakeTB) Helper() {}
+
+func (t *fakeTB) Errorf(format string, args ...interface{}) {
+	t.errors = append(t.errors, fmt.Sprintf(format, args...))
+}
+
+func TestCalled(t *testing.T) {
+	var r mock.Recorder
+	r.Record("ReadStack", "stk-1")
+	r.Record("ListStacks", nil, controlmonkey.String("app"), (*string)(nil))
+
+	tests := map[string]struct {
+		method string
+		args   []interface{}
+		want   bool
+	}{
+		"exact": {
+			method: "ReadStack",
+			args:   []interface{}{"stk-1"},
+			want:   true,
+		},
+		"different_args": {
+			method: "ReadStack",
+			args:   []interface{}{"stk-2"},
+			want:   false,
+		},
+		"pointer_dereferenced": {
+			method: "ListStacks",
+			args:   []interface{}{nil, "app", nil},
+			want:   true,
+		},
+		"any": {
+			method: "ListStacks",
+			args:   []interface{}{mock.Any, mock.Any, mock.Any},
+			want:   true,
+		},
+		"wrong_arity": {
+			method: "ListStacks",
+			args:   []interface{}{mock.Any},
+			want:   false,
+		},
+		"not_called": {
+			method: "DeleteStack",
+			args:   []interface{}{"stk-1"},
+			want:   false,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := r.Called(tc.method, tc.args...); got != tc.want {
+				t.Errorf("want: %v, got: %v", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestAssertions(t *testing.T) {
+	var r mock.Recorder
+	r.Record("ReadStack", "stk-1")
+	r.Record("ReadStack", "stk-2")
+
+	tb := new(fakeTB)
+	r.AssertCalled(tb, "ReadStack", "stk-2")
+	r.AssertCallCount(tb, "ReadStack", 2)
+	r.AssertNotCalled(tb, "DeleteStack")
+	if len(tb.errors) != 0 {
+		t.Errorf("want: no failures, got: %v", tb.errors)
+	}
+
+	r.AssertCalled(tb, "ReadStack", "stk-3")
+	r.AssertCallCount(tb, "ReadStack", 1)
+	r.AssertNotCalled(tb, "ReadStack")
+	if len(tb.errors) != 3 {
+		t.Errorf("want: 3 failures, got: %v", tb.errors)
+	}
+
+	r.Reset()
+	if calls := r.Calls(); len(calls) != 0 {
+		t.Errorf("want: no calls, got: %v", calls)
+	}
+}
+
+func TestGeneratedMock(t *testing.T) {
+	ctx := context.Background()
+	want := &stack.Stack{ID: controlmonkey.String("stk-1")}
+
+	var svc stack.Service = new(mocks.Service).OnReadStack(want, nil)
+
+	got, err := svc.ReadStack(ctx, "stk-1")
+	if err != nil || got != want {
+		t.Errorf("want: %v, got: %v (%v)", want, got, err)
+	}
+
+	// Methods without canned responses fail.
+	if _, err := svc.DeleteStack(ctx, "stk-1"); !errors.Is(err, mock.ErrNotStubbed) {
+		t.Errorf("want: %v, got: %v", mock.ErrNotStubbed, err)
+	}
+
+	m := svc.(*mocks.Service)
+	m.UpdateStackFunc = func(_ context.Context, id string, in *stack.Stack) (*stack.Stack, error) {
+		return &stack.Stack{ID: controlmonkey.String(id), Name: in.Name}, nil
+	}
+	updated, err := svc.UpdateStack(ctx, "stk-1", &stack.Stack{Name: controlmonkey.String("app")})
+	if err != nil || controlmonkey.StringValue(updated.Name) != "app" {
+		t.Errorf("want: app, got: %v (%v)", updated, err)
+	}
+
+	// Unstubbed paginators yield the error rather than being nil.
+	if _, err := svc.ListStacksPaginator(nil, nil, nil, nil).All(ctx); !errors.Is(err, mock.ErrNotStubbed) {
+		t.Errorf("want: %v, got: %v", mock.ErrNotStubbed, err)
+	}
+	m.OnListStacksPaginator(client.NewSlicePager([]*stack.Stack{want}, nil))
+	stacks, err := svc.ListStacksPaginator(nil, nil, nil, nil).All(ctx)
+	if err != nil || len(stacks) != 1 || stacks[0] != want {
+		t.Errorf("want: [%v], got: %v (%v)", want, stacks, err)
+	}
+
+	m.AssertCalled(t, "ReadStack", "stk-1")
+	m.AssertCalled(t, "DeleteStack", "stk-1")
+	m.AssertCalled(t, "UpdateStack", "stk-1", mock.Any)
+	m.AssertCallCount(t, "ReadStack", 1)
+	m.AssertNotCalled(t, "CreateStack")
+}
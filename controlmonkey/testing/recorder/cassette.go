@@ -0,0 +1,124 @@
+package recorder
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"reflect"
+	"strconv"
+)
+
+// CassetteVersion is the version of the cassette format.
+const CassetteVersion = 1
+
+// A Cassette holds recorded interactions.
+type Cassette struct {
+	Version      int            `json:"version"`
+	Interactions []*Interaction `json:"interactions"`
+}
+
+// An Interaction is a recorded request along with its response.
+type Interaction struct {
+	Request  *Request  `json:"request"`
+	Response *Response `json:"response"`
+}
+
+// A Request is a recorded HTTP request. Its body is normalized, so that
+// JSON bodies can be compared regardless of their formatting.
+type Request struct {
+	Method string      `json:"method"`
+	Path   string      `json:"path"`
+	Query  url.Values  `json:"query,omitempty"`
+	Header http.Header `json:"header,omitempty"`
+	Body   string      `json:"body,omitempty"`
+
+	// BodyHash is the hex-encoded SHA-256 checksum of the normalized body
+	// before secrets were scrubbed from Body, if any were. It tells apart
+	// requests differing only by their secrets.
+	BodyHash string `json:"bodyHash,omitempty"`
+}
+
+// A Response is a recorded HTTP response.
+type Response struct {
+	StatusCode int         `json:"statusCode"`
+	Header     http.Header `json:"header,omitempty"`
+	Body       string      `json:"body,omitempty"`
+}
+
+// A Matcher reports whether an outgoing request matches a recorded one.
+type Matcher func(req, recorded *Request) bool
+
+// DefaultMatcher matches requests on their method, path, query and
+// normalized body. Bodies holding secrets are matched on their checksum,
+// unless the recorded request has none.
+func DefaultMatcher(req, recorded *Request) bool {
+	return req.Method == recorded.Method &&
+		req.Path == recorded.Path &&
+		equalQuery(req.Query, recorded.Query) &&
+		req.Body == recorded.Body &&
+		(recorded.BodyHash == "" || req.BodyHash == recorded.BodyHash)
+}
+
+// Load loads the cassette at path.
+func Load(path string) (*Cassette, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("recorder: failed to load cassette: %w", err)
+	}
+
+	c := new(Cassette)
+	if err := json.Unmarshal(data, c); err != nil {
+		return nil, fmt.Errorf("recorder: failed to decode cassette %s: %w", path, err)
+	}
+	if c.Version != CassetteVersion {
+		return nil, fmt.Errorf("recorder: unsupported cassette version %d in %s", c.Version, path)
+	}
+	return c, nil
+}
+
+// Save writes the cassette to path.
+func (c *Cassette) Save(path string) error {
+	c.Version = CassetteVersion
+	if c.Interactions == nil {
+		c.Interactions = make([]*Interaction, 0)
+	}
+
+	data, err := json.MarshalIndent(c, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, append(data, '\n'), 0644)
+}
+
+// toHTTP converts the recorded response to an HTTP response to req.
+func (r *Response) toHTTP(req *http.Request) *http.Response {
+	header := r.Header.Clone()
+	if header == nil {
+		header = make(http.Header)
+	}
+	// The recorded body may have been scrubbed.
+	header.Del("Content-Length")
+
+	return &http.Response{
+		Status:        strconv.Itoa(r.StatusCode) + " " + http.StatusText(r.StatusCode),
+		StatusCode:    r.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(bytes.NewBufferString(r.Body)),
+		ContentLength: int64(len(r.Body)),
+		Request:       req,
+	}
+}
+
+func equalQuery(a, b url.Values) bool {
+	if len(a) == 0 && len(b) == 0 {
+		return true
+	}
+	return reflect.DeepEqual(a, b)
+}
@@ -0,0 +1,303 @@
+// Package recorder provides an http.RoundTripper that records the requests
+// sent by the SDK, along with their responses, to a cassette file, and
+// replays them offline. It allows testing code built on the SDK without
+// network access.
+//
+// Secrets are scrubbed before being written to the cassette: the values of
+// sensitive headers (e.g. Authorization) and JSON fields (e.g. token) are
+// replaced by redact.Mask. Requests are still matched on their actual body,
+// through a checksum recorded along with the scrubbed one.
+//
+// Example:
+//
+//	rec, err := recorder.New("testdata/stacks.json", recorder.WithMode(mode))
+//	if err != nil {
+//		t.Fatal(err)
+//	}
+//	defer rec.Stop()
+//
+//	sess := session.New(controlmonkey.DefaultConfig().
+//		WithHTTPClient(rec.HTTPClient()).
+//		WithCredentials(credentials.NewStaticCredentials("token")))
+package recorder
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/control-monkey/controlmonkey-sdk-go/controlmonkey/util/redact"
+)
+
+// Mode defines whether interactions are recorded or replayed.
+type Mode int
+
+const (
+	// ModeReplay replays the interactions of the cassette, and fails the
+	// requests that match none of them. The network is never used.
+	ModeReplay Mode = iota
+
+	// ModeRecord sends every request and records the interactions,
+	// overwriting the cassette when the Recorder is stopped.
+	ModeRecord
+
+	// ModeReplayOrRecord replays the interactions of the cassette if it
+	// exists, and records them otherwise.
+	ModeReplayOrRecord
+)
+
+// String returns the name of the mode.
+func (m Mode) String() string {
+	switch m {
+	case ModeReplay:
+		return "replay"
+	case ModeRecord:
+		return "record"
+	case ModeReplayOrRecord:
+		return "replay_or_record"
+	default:
+		return fmt.Sprintf("Mode(%d)", int(m))
+	}
+}
+
+// ErrInteractionNotFound is returned, when replaying, for requests that match
+// no unused interaction of the cassette.
+var ErrInteractionNotFound = errors.New("recorder: interaction not found")
+
+// An Option configures a Recorder.
+type Option func(*Recorder)
+
+// WithMode defines the mode of the Recorder. Defaults to ModeReplay.
+func WithMode(mode Mode) Option {
+	return func(r *Recorder) { r.mode = mode }
+}
+
+// WithTransport defines the transport used to send the requests being
+// recorded. Defaults to http.DefaultTransport.
+func WithTransport(rt http.RoundTripper) Option {
+	return func(r *Recorder) { r.transport = rt }
+}
+
+// WithSensitiveHeaders adds headers whose values are scrubbed from the
+// cassette, in addition to redact.DefaultHeaders.
+func WithSensitiveHeaders(names ...string) Option {
+	return func(r *Recorder) { r.headers = append(r.headers, names...) }
+}
+
+// WithSensitiveFields adds JSON fields whose values are scrubbed from the
+// cassette, in addition to redact.DefaultFields.
+func WithSensitiveFields(fields ...string) Option {
+	return func(r *Recorder) { r.fields = append(r.fields, fields...) }
+}
+
+// WithMatcher defines the function used to match requests against recorded
+// interactions. Defaults to DefaultMatcher.
+func WithMatcher(m Matcher) Option {
+	return func(r *Recorder) { r.matcher = m }
+}
+
+// A Recorder is an http.RoundTripper that records or replays interactions.
+// It is safe for concurrent use.
+type Recorder struct {
+	path      string
+	mode      Mode
+	transport http.RoundTripper
+	matcher   Matcher
+	headers   []string
+	fields    []string
+
+	mu       sync.Mutex
+	cassette *Cassette
+	used     []bool
+}
+
+var _ http.RoundTripper = &Recorder{}
+
+// New returns a new Recorder using the cassette at path. When replaying, the
+// cassette is loaded immediately.
+func New(path string, opts ...Option) (*Recorder, error) {
+	r := &Recorder{
+		path:      path,
+		mode:      ModeReplay,
+		transport: http.DefaultTransport,
+		matcher:   DefaultMatcher,
+		headers:   redact.DefaultHeaders(),
+		fields:    redact.DefaultFields(),
+	}
+	for _, opt := range opts {
+		opt(r)
+	}
+
+	if r.mode == ModeReplayOrRecord {
+		r.mode = ModeRecord
+		if _, err := os.Stat(path); err == nil {
+			r.mode = ModeReplay
+		}
+	}
+
+	if r.mode == ModeReplay {
+		c, err := Load(path)
+		if err != nil {
+			return nil, err
+		}
+		r.cassette = c
+		r.used = make([]bool, len(c.Interactions))
+	} else {
+		r.cassette = new(Cassette)
+	}
+
+	return r, nil
+}
+
+// Mode returns the effective mode of the Recorder, i.e. either ModeReplay or
+// ModeRecord.
+func (r *Recorder) Mode() Mode { return r.mode }
+
+// HTTPClient returns an HTTP client using the Recorder as transport.
+func (r *Recorder) HTTPClient() *http.Client {
+	return &http.Client{Transport: r}
+}
+
+// RoundTrip implements http.RoundTripper. As required by http.RoundTripper,
+// req is not modified, and its body is closed.
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	body, err := readBody(req)
+	if err != nil {
+		return nil, err
+	}
+	recorded := r.newRequest(req, body)
+
+	if r.mode == ModeReplay {
+		return r.replay(req, recorded)
+	}
+	return r.record(withBody(req, body), recorded)
+}
+
+// Stop saves the cassette when recording. It is a no-op when replaying.
+func (r *Recorder) Stop() error {
+	if r.mode != ModeRecord {
+		return nil
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if dir := filepath.Dir(r.path); dir != "" {
+		if err := os.MkdirAll(dir, 0755); err != nil {
+			return err
+		}
+	}
+	return r.cassette.Save(r.path)
+}
+
+func (r *Recorder) replay(req *http.Request, recorded *Request) (*http.Response, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for i, in := range r.cassette.Interactions {
+		if r.used[i] || !r.matcher(recorded, in.Request) {
+			continue
+		}
+		r.used[i] = true
+		return in.Response.toHTTP(req), nil
+	}
+
+	return nil, fmt.Errorf("%w: %s %s", ErrInteractionNotFound, req.Method, req.URL.String())
+}
+
+func (r *Recorder) record(req *http.Request, recorded *Request) (*http.Response, error) {
+	resp, err := r.transport.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	resp.Body = io.NopCloser(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+
+	r.mu.Lock()
+	r.cassette.Interactions = append(r.cassette.Interactions, &Interaction{
+		Request: recorded,
+		Response: &Response{
+			StatusCode: resp.StatusCode,
+			Header:     redact.Header(resp.Header, r.headers...),
+			Body:       string(redact.JSON(data, r.fields...)),
+		},
+	})
+	r.mu.Unlock()
+
+	return resp, nil
+}
+
+// newRequest returns the scrubbed representation of req.
+func (r *Recorder) newRequest(req *http.Request, body []byte) *Request {
+	out := &Request{
+		Method: req.Method,
+		Path:   req.URL.Path,
+		Query:  req.URL.Query(),
+		Header: redact.Header(req.Header, r.headers...),
+		Body:   string(normalizeBody(redact.JSON(body, r.fields...))),
+	}
+	if normalized := normalizeBody(body); string(normalized) != out.Body {
+		sum := sha256.Sum256(normalized)
+		out.BodyHash = hex.EncodeToString(sum[:])
+	}
+	return out
+}
+
+// readBody reads and closes the body of req.
+func readBody(req *http.Request) ([]byte, error) {
+	if req.Body == nil || req.Body == http.NoBody {
+		return nil, nil
+	}
+
+	data, err := io.ReadAll(req.Body)
+	req.Body.Close()
+	return data, err
+}
+
+// withBody returns a copy of req sending body, which was read from req.
+func withBody(req *http.Request, body []byte) *http.Request {
+	out := req.Clone(req.Context())
+	if body == nil {
+		return out
+	}
+	out.Body = io.NopCloser(bytes.NewReader(body))
+	out.GetBody = func() (io.ReadCloser, error) {
+		return io.NopCloser(bytes.NewReader(body)), nil
+	}
+	return out
+}
+
+// normalizeBody returns a canonical representation of JSON bodies, so they
+// can be compared regardless of their formatting and field order. Bodies
+// that are not valid JSON are returned as is.
+func normalizeBody(body []byte) []byte {
+	if len(bytes.TrimSpace(body)) == 0 {
+		return nil
+	}
+
+	var v interface{}
+	dec := json.NewDecoder(bytes.NewReader(body))
+	dec.UseNumber()
+	if err := dec.Decode(&v); err != nil {
+		return body
+	}
+
+	out, err := json.Marshal(v)
+	if err != nil {
+		return body
+	}
+	return out
+}
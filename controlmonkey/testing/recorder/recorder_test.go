@@ -0,0 +1,291 @@
+package recorder_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/control-monkey/controlmonkey-sdk-go/controlmonkey"
+	"github.com/control-monkey/controlmonkey-sdk-go/controlmonkey/credentials"
+	"github.com/control-monkey/controlmonkey-sdk-go/controlmonkey/session"
+	"github.com/control-monkey/controlmonkey-sdk-go/controlmonkey/testing/recorder"
+	"github.com/control-monkey/controlmonkey-sdk-go/services/namespace"
+	"github.com/control-monkey/controlmonkey-sdk-go/services/stack"
+)
+
+const testToken = "super-secret-token"
+
+func newSession(baseURL string, rec *recorder.Recorder) *session.Session {
+	return session.New(controlmonkey.DefaultConfig().
+		WithBaseURL(baseURL).
+		WithHTTPClient(rec.HTTPClient()).
+		WithCredentials(credentials.NewStaticCredentials(testToken)).
+		WithRetryPolicy(controlmonkey.NoRetryPolicy()))
+}
+
+// newAPI returns a server serving a namespace and its stacks.
+func newAPI(t *testing.T) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/namespace":
+			var in struct {
+				Entity struct {
+					Name string `json:"name"`
+				} `json:"entity"`
+			}
+			if err := json.NewDecoder(r.Body).Decode(&in); err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+			fmt.Fprintf(w, `{"response":{"items":[{"id":"ns-1","name":%q}]}}`, in.Entity.Name)
+		case r.Method == http.MethodGet && r.URL.Path == "/stack":
+			fmt.Fprintf(w, `{"response":{"items":[{"id":"stk-1","namespaceId":%q,"name":"app"}]}}`,
+				r.URL.Query().Get("namespaceId"))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, `{"response":{"errors":[{"code":"not_found","message":"not found"}]}}`)
+		}
+	}))
+}
+
+// runFlow creates a namespace and lists its stacks.
+func runFlow(ctx context.Context, sess *session.Session) (*namespace.Namespace, []*stack.Stack, error) {
+	ns, err := namespace.New(sess).CreateNamespace(ctx, &namespace.Namespace{
+		Name: controlmonkey.String("dev"),
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	stacks, err := stack.New(sess).ListStacks(ctx, nil, nil, ns.ID)
+	if err != nil {
+		return nil, nil, err
+	}
+	return ns, stacks, nil
+}
+
+func TestRecordReplay(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "cassettes", "flow.json")
+
+	// Record.
+	srv := newAPI(t)
+	rec, err := recorder.New(path, recorder.WithMode(recorder.ModeRecord))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err := runFlow(ctx, newSession(srv.URL, rec)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := rec.Stop(); err != nil {
+		t.Fatal(err)
+	}
+	srv.Close()
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(string(data), testToken) {
+		t.Errorf("want: token to be scrubbed from the cassette, got: %s", data)
+	}
+
+	// Replay, while the server is down.
+	rec, err = recorder.New(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ns, stacks, err := runFlow(ctx, newSession(srv.URL, rec))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := controlmonkey.StringValue(ns.ID); got != "ns-1" {
+		t.Errorf("want: ns-1, got: %s", got)
+	}
+	if len(stacks) != 1 || controlmonkey.StringValue(stacks[0].NamespaceId) != "ns-1" {
+		t.Errorf("want: 1 stack in ns-1, got: %v", stacks)
+	}
+
+	// Every interaction was used.
+	_, err = stack.New(newSession(srv.URL, rec)).ListStacks(ctx, nil, nil, ns.ID)
+	if !errors.Is(err, recorder.ErrInteractionNotFound) {
+		t.Errorf("want: %v, got: %v", recorder.ErrInteractionNotFound, err)
+	}
+}
+
+func TestReplayCassette(t *testing.T) {
+	rec, err := recorder.New(filepath.Join("testdata", "flow.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ns, stacks, err := runFlow(context.Background(), newSession("https://api.controlmonkey.io", rec))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := controlmonkey.StringValue(ns.Name); got != "dev" {
+		t.Errorf("want: dev, got: %s", got)
+	}
+	if len(stacks) != 1 || controlmonkey.StringValue(stacks[0].Name) != "app" {
+		t.Errorf("want: 1 stack named app, got: %v", stacks)
+	}
+}
+
+func TestScrubbing(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("X-Api-Key", "key")
+		fmt.Fprint(w, `{"response":{"items":[{"id":"var-1","value":"s3cr3t","apiKey":"k3y"}]}}`)
+	}))
+	defer srv.Close()
+
+	path := filepath.Join(t.TempDir(), "scrub.json")
+	rec, err := recorder.New(path,
+		recorder.WithMode(recorder.ModeRecord),
+		recorder.WithSensitiveHeaders("X-Api-Key"),
+		recorder.WithSensitiveFields("apiKey"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	req, _ := http.NewRequest(http.MethodPost, srv.URL+"/variable", strings.NewReader(`{"value":"s3cr3t"}`))
+	req.Header.Set("Authorization", "Bearer "+testToken)
+	resp, err := rec.RoundTrip(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	body, _ := io.ReadAll(resp.Body)
+	if !strings.Contains(string(body), "s3cr3t") {
+		t.Errorf("want: the live response not to be scrubbed, got: %s", body)
+	}
+	if err := rec.Stop(); err != nil {
+		t.Fatal(err)
+	}
+
+	c, err := recorder.Load(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, _ := json.Marshal(c)
+	for _, secret := range []string{testToken, "s3cr3t", "k3y", `"key"`} {
+		if strings.Contains(string(data), secret) {
+			t.Errorf("want: %s to be scrubbed, got: %s", secret, data)
+		}
+	}
+}
+
+func TestDefaultMatcher(t *testing.T) {
+	recorded := &recorder.Request{
+		Method: http.MethodPost,
+		Path:   "/stack",
+		Query:  map[string][]string{"namespaceId": {"ns-1"}},
+		Body:   `{"a":1,"b":2}`,
+	}
+
+	tests := map[string]struct {
+		req  string
+		body string
+		want bool
+	}{
+		"match": {
+			req:  "/stack?namespaceId=ns-1",
+			body: `{"b":2,  "a":1}`,
+			want: true,
+		},
+		"different_query": {
+			req:  "/stack?namespaceId=ns-2",
+			body: `{"a":1,"b":2}`,
+			want: false,
+		},
+		"different_body": {
+			req:  "/stack?namespaceId=ns-1",
+			body: `{"a":1}`,
+			want: false,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "match.json")
+			c := &recorder.Cassette{Interactions: []*recorder.Interaction{{
+				Request:  recorded,
+				Response: &recorder.Response{StatusCode: http.StatusOK, Body: "{}"},
+			}}}
+			if err := c.Save(path); err != nil {
+				t.Fatal(err)
+			}
+
+			rec, err := recorder.New(path)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			req, _ := http.NewRequest(http.MethodPost, "https://api.controlmonkey.io"+tc.req, strings.NewReader(tc.body))
+			_, err = rec.RoundTrip(req)
+			if got := err == nil; got != tc.want {
+				t.Errorf("want: %v, got: %v (%v)", tc.want, got, err)
+			}
+		})
+	}
+}
+
+func TestReplaySecrets(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		fmt.Fprintf(w, `{"response":{"items":[{"id":"var-%t"}]}}`, strings.Contains(string(body), "one"))
+	}))
+	defer srv.Close()
+
+	path := filepath.Join(t.TempDir(), "secrets.json")
+	send := func(rec *recorder.Recorder, value string) string {
+		t.Helper()
+		body := strings.NewReader(fmt.Sprintf(`{"value":%q}`, value))
+		req, _ := http.NewRequest(http.MethodPost, srv.URL+"/variable", body)
+		reqBody := req.Body
+
+		resp, err := rec.RoundTrip(req)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if req.Body != reqBody {
+			t.Errorf("want: the request not to be modified")
+		}
+		data, _ := io.ReadAll(resp.Body)
+		return string(data)
+	}
+
+	rec, err := recorder.New(path, recorder.WithMode(recorder.ModeRecord))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := map[string]string{"one": send(rec, "one"), "two": send(rec, "two")}
+	if err := rec.Stop(); err != nil {
+		t.Fatal(err)
+	}
+
+	data, _ := os.ReadFile(path)
+	for _, secret := range []string{"one", "two"} {
+		if strings.Contains(string(data), secret) {
+			t.Errorf("want: %s to be scrubbed, got: %s", secret, data)
+		}
+	}
+
+	// Requests differing only by a secret replay their own interaction.
+	rec, err = recorder.New(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, value := range []string{"two", "one"} {
+		if e, a := want[value], send(rec, value); e != a {
+			t.Errorf("want: %v, got: %v", e, a)
+		}
+	}
+}
@@ -0,0 +1,269 @@
+package controlmonkey
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/control-monkey/controlmonkey-sdk-go/controlmonkey/credentials"
+)
+
+// writeClientCert generates a self-signed client certificate, writes it and
+// its key as PEM files, and returns their paths along with a pool trusting
+// the certificate.
+func writeClientCert(t *testing.T) (certFile, keyFile string, pool *x509.CertPool) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "client"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+		IsCA:         true,
+
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	dir := t.TempDir()
+	certFile, keyFile = filepath.Join(dir, "client.crt"), filepath.Join(dir, "client.key")
+	writePEM(t, certFile, "CERTIFICATE", der)
+	writePEM(t, keyFile, "EC PRIVATE KEY", keyDER)
+
+	pool = x509.NewCertPool()
+	pool.AddCert(cert)
+	return certFile, keyFile, pool
+}
+
+func writePEM(t *testing.T, filename, typ string, der []byte) {
+	t.Helper()
+	b := pem.EncodeToMemory(&pem.Block{Type: typ, Bytes: der})
+	if err := os.WriteFile(filename, b, 0o600); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestNewTransportTLS(t *testing.T) {
+	certFile, keyFile, clientCAs := writeClientCert(t)
+
+	srv := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	srv.TLS = &tls.Config{ClientAuth: tls.RequireAndVerifyClientCert, ClientCAs: clientCAs}
+	srv.StartTLS()
+	defer srv.Close()
+
+	caFile := filepath.Join(t.TempDir(), "ca.pem")
+	writePEM(t, caFile, "CERTIFICATE", srv.Certificate().Raw)
+
+	tests := map[string]struct {
+		opts    *TransportOptions
+		wantErr bool
+	}{
+		"default": {
+			opts:    nil,
+			wantErr: true,
+		},
+		"ca_bundle_only": {
+			opts:    &TransportOptions{CABundleFile: caFile},
+			wantErr: true,
+		},
+		"mtls": {
+			opts: &TransportOptions{
+				CABundleFile:   caFile,
+				ClientCertFile: certFile,
+				ClientKeyFile:  keyFile,
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			transport, err := NewTransport(test.opts)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			defer transport.CloseIdleConnections()
+
+			resp, err := (&http.Client{Transport: transport}).Get(srv.URL)
+			if err == nil {
+				resp.Body.Close()
+			}
+			if e, a := test.wantErr, err != nil; e != a {
+				t.Errorf("want: error %v, got: %v", e, err)
+			}
+		})
+	}
+}
+
+func TestNewTransportErrors(t *testing.T) {
+	invalidPEM := filepath.Join(t.TempDir(), "invalid.pem")
+	if err := os.WriteFile(invalidPEM, []byte("not a certificate"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := map[string]*TransportOptions{
+		"missing_ca_bundle":  {CABundleFile: "file_not_exist"},
+		"invalid_ca_bundle":  {CABundleFile: invalidPEM},
+		"invalid_ca_pem":     {CABundlePEM: []byte("not a certificate")},
+		"cert_without_key":   {ClientCertFile: invalidPEM},
+		"invalid_cert":       {ClientCertFile: invalidPEM, ClientKeyFile: invalidPEM},
+		"proxy_without_host": {ProxyURL: "proxy.internal:3128"},
+	}
+
+	for name, opts := range tests {
+		t.Run(name, func(t *testing.T) {
+			if _, err := NewTransport(opts); err == nil {
+				t.Error("want: error, got: nil")
+			}
+		})
+	}
+}
+
+func TestNewTransportProxy(t *testing.T) {
+	var gotAuth, gotURL string
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotAuth, gotURL = r.Header.Get("Proxy-Authorization"), r.URL.String()
+	}))
+	defer proxy.Close()
+
+	transport, err := NewTransport(&TransportOptions{
+		ProxyURL:      proxy.URL,
+		ProxyUsername: "user",
+		ProxyPassword: "secret",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	resp, err := (&http.Client{Transport: transport}).Get("http://api.example.com/stack")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	resp.Body.Close()
+
+	if e, a := "Basic "+base64.StdEncoding.EncodeToString([]byte("user:secret")), gotAuth; e != a {
+		t.Errorf("want: %v, got: %v", e, a)
+	}
+	if e, a := "http://api.example.com/stack", gotURL; e != a {
+		t.Errorf("want: %v, got: %v", e, a)
+	}
+}
+
+func TestWithTransportOptions(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.HTTPClient.Timeout = 5 * time.Second
+	shared := cfg.HTTPClient
+
+	cfg.WithTransportOptions(&TransportOptions{MaxConnsPerHost: 4})
+
+	if cfg.HTTPClient == shared {
+		t.Fatal("want: new HTTP client, got: shared one")
+	}
+	if e, a := 5*time.Second, cfg.HTTPClient.Timeout; e != a {
+		t.Errorf("want: %v, got: %v", e, a)
+	}
+	transport := cfg.HTTPClient.Transport.(*http.Transport)
+	if e, a := 4, transport.MaxConnsPerHost; e != a {
+		t.Errorf("want: %v, got: %v", e, a)
+	}
+	if e, a := defaultMaxIdleConnsPerHost, transport.MaxIdleConnsPerHost; e != a {
+		t.Errorf("want: %v, got: %v", e, a)
+	}
+
+	cfg.WithTransportOptions(&TransportOptions{CABundleFile: "file_not_exist"})
+	if err := cfg.Validate(); err == nil {
+		t.Error("want: error, got: nil")
+	}
+}
+
+func TestWebIdentityTransport(t *testing.T) {
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"access_token":"exchanged","expires_in":3600}`)
+	}))
+	defer srv.Close()
+
+	caFile := filepath.Join(t.TempDir(), "ca.pem")
+	writePEM(t, caFile, "CERTIFICATE", srv.Certificate().Raw)
+
+	tests := map[string]struct {
+		config  func() (*Config, error)
+		wantErr bool
+	}{
+		"untrusted": {
+			config: func() (*Config, error) {
+				return DefaultConfig(), nil
+			},
+			wantErr: true,
+		},
+		"default_config": {
+			config: func() (*Config, error) {
+				return DefaultConfig().WithTransportOptions(&TransportOptions{CABundleFile: caFile}), nil
+			},
+		},
+		"load_config": {
+			config: func() (*Config, error) {
+				t.Setenv(EnvVarCABundle, caFile)
+				return LoadConfig("", "")
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			clearConfigEnv(t)
+			t.Setenv(credentials.EnvCredentialsVarToken, "")
+			t.Setenv(credentials.WebIdentityEnvVarTokenURL, srv.URL)
+			t.Setenv(credentials.WebIdentityEnvVarTokenEnv, "TEST_OIDC_TOKEN")
+			t.Setenv("TEST_OIDC_TOKEN", "oidc")
+
+			cfg, err := test.config()
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			v, err := cfg.Credentials.Get()
+			if test.wantErr {
+				if err == nil {
+					t.Errorf("want: error, got: %v", v.Token)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if e, a := "exchanged", v.Token; e != a {
+				t.Errorf("want: %v, got: %v", e, a)
+			}
+		})
+	}
+}
@@ -0,0 +1,97 @@
+package redact
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"strings"
+)
+
+// Mask is the value that replaces redacted data.
+const Mask = "[REDACTED]"
+
+// DefaultHeaders returns the HTTP headers redacted by default.
+func DefaultHeaders() []string {
+	return []string{
+		"Authorization",
+		"Proxy-Authorization",
+		"Cookie",
+		"Set-Cookie",
+	}
+}
+
+// DefaultFields returns the JSON fields redacted by default. Field names are
+// matched case-insensitively, at any depth.
+func DefaultFields() []string {
+	return []string{
+		"token",
+		"value",
+		"hmacKey",
+		"botAuthToken",
+		"password",
+		"secret",
+	}
+}
+
+// Header returns a copy of h where the values of the given headers are
+// replaced by Mask.
+func Header(h http.Header, names ...string) http.Header {
+	out := h.Clone()
+	for _, name := range names {
+		if values := out.Values(name); len(values) > 0 {
+			masked := make([]string, len(values))
+			for i := range masked {
+				masked[i] = Mask
+			}
+			out[http.CanonicalHeaderKey(name)] = masked
+		}
+	}
+	return out
+}
+
+// JSON returns a copy of body where the values of the given fields are
+// replaced by Mask. Bodies that are not valid JSON are returned as is.
+func JSON(body []byte, fields ...string) []byte {
+	if len(bytes.TrimSpace(body)) == 0 || len(fields) == 0 {
+		return body
+	}
+
+	var v interface{}
+	dec := json.NewDecoder(bytes.NewReader(body))
+	dec.UseNumber()
+	if err := dec.Decode(&v); err != nil {
+		return body
+	}
+
+	set := make(map[string]struct{}, len(fields))
+	for _, f := range fields {
+		set[strings.ToLower(f)] = struct{}{}
+	}
+
+	out, err := json.Marshal(redactValue(v, set))
+	if err != nil {
+		return body
+	}
+	return out
+}
+
+func redactValue(v interface{}, fields map[string]struct{}) interface{} {
+	switch t := v.(type) {
+	case map[string]interface{}:
+		for k, val := range t {
+			if _, ok := fields[strings.ToLower(k)]; ok && val != nil {
+				t[k] = Mask
+				continue
+			}
+			t[k] = redactValue(val, fields)
+		}
+		return t
+	case []interface{}:
+		for i, val := range t {
+			t[i] = redactValue(val, fields)
+		}
+		return t
+	default:
+		return v
+	}
+}
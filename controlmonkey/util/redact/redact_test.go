@@ -0,0 +1,78 @@
+package redact
+
+import (
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestHeader(t *testing.T) {
+	in := http.Header{
+		"Authorization": {"Bearer secret"},
+		"Content-Type":  {"application/json"},
+	}
+
+	got := Header(in, DefaultHeaders()...)
+	want := http.Header{
+		"Authorization": {Mask},
+		"Content-Type":  {"application/json"},
+	}
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("want: %v, got: %v", want, got)
+	}
+	if e, a := "Bearer secret", in.Get("Authorization"); e != a {
+		t.Errorf("input was mutated, want: %q, got: %q", e, a)
+	}
+}
+
+func TestJSON(t *testing.T) {
+	tests := map[string]struct {
+		body   string
+		fields []string
+		want   string
+	}{
+		"empty": {
+			body:   "",
+			fields: DefaultFields(),
+			want:   "",
+		},
+		"not_json": {
+			body:   "<html>502 Bad Gateway</html>",
+			fields: DefaultFields(),
+			want:   "<html>502 Bad Gateway</html>",
+		},
+		"nested": {
+			body:   `{"entity":{"key":"k","value":"v","isSensitive":true}}`,
+			fields: DefaultFields(),
+			want:   `{"entity":{"isSensitive":true,"key":"k","value":"[REDACTED]"}}`,
+		},
+		"case_insensitive_in_items": {
+			body:   `{"response":{"items":[{"HmacKey":"k","botAuthToken":"t","url":"u"}]}}`,
+			fields: DefaultFields(),
+			want:   `{"response":{"items":[{"HmacKey":"[REDACTED]","botAuthToken":"[REDACTED]","url":"u"}]}}`,
+		},
+		"custom_fields": {
+			body:   `{"url":"https://hooks.slack.com/secret","name":"n"}`,
+			fields: []string{"url"},
+			want:   `{"name":"n","url":"[REDACTED]"}`,
+		},
+		"null_kept": {
+			body:   `{"value":null}`,
+			fields: DefaultFields(),
+			want:   `{"value":null}`,
+		},
+		"numbers_preserved": {
+			body:   `{"ttl":{"value":12345678901234567890}}`,
+			fields: []string{"token"},
+			want:   `{"ttl":{"value":12345678901234567890}}`,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			if e, a := test.want, string(JSON([]byte(test.body), test.fields...)); e != a {
+				t.Errorf("want: %s, got: %s", e, a)
+			}
+		})
+	}
+}
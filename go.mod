@@ -1,7 +1,15 @@
 module github.com/control-monkey/controlmonkey-sdk-go
 
-go 1.19
+go 1.23
 
-require gopkg.in/ini.v1 v1.67.0
+require (
+	go.opentelemetry.io/otel v1.29.0
+	go.opentelemetry.io/otel/metric v1.29.0
+	go.opentelemetry.io/otel/trace v1.29.0
+	gopkg.in/ini.v1 v1.67.0
+)
 
-require github.com/stretchr/testify v1.8.2 // indirect
+require (
+	github.com/go-logr/logr v1.4.2 // indirect
+	github.com/go-logr/stdr v1.2.2 // indirect
+)
@@ -0,0 +1,375 @@
+// Command mockgen generates a mock implementation of the Service interface of
+// a services package, built on the controlmonkey/testing/mock package.
+//
+// It is run through go:generate from the directory of the service:
+//
+//	//go:generate go run ../../internal/mockgen
+package main
+
+import (
+	"bytes"
+	"errors"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"log"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const mockImportPath = "github.com/control-monkey/controlmonkey-sdk-go/controlmonkey/testing/mock"
+
+var (
+	source    = flag.String("source", "service.go", "file declaring the interface")
+	iface     = flag.String("interface", "Service", "name of the interface to mock")
+	output    = flag.String("out", filepath.Join("mocks", "service.go"), "output file")
+	outputPkg = flag.String("package", "mocks", "package name of the output file")
+)
+
+func main() {
+	log.SetFlags(0)
+	log.SetPrefix("mockgen: ")
+	flag.Parse()
+
+	src, err := generate(*source, *iface, *outputPkg)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(*output), 0755); err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile(*output, src, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// method is a method of the mocked interface.
+type method struct {
+	name    string
+	params  []string // types of the parameters
+	results []string // types of the results
+	hasCtx  bool     // whether the first parameter is a context.Context
+}
+
+// generator renders the types of the source package from the mock package.
+type generator struct {
+	pkgName string            // name of the source package
+	imports map[string]string // import paths of the source file, by name
+	used    map[string]string // import paths used by the mock, by name
+}
+
+func generate(filename, ifaceName, outPkg string) ([]byte, error) {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, filename, nil, parser.ParseComments)
+	if err != nil {
+		return nil, err
+	}
+
+	pkgPath, err := importPath(filepath.Dir(filename))
+	if err != nil {
+		return nil, err
+	}
+
+	g := &generator{
+		pkgName: file.Name.Name,
+		imports: make(map[string]string),
+		used: map[string]string{
+			"mock":         mockImportPath,
+			file.Name.Name: pkgPath,
+		},
+	}
+	for _, spec := range file.Imports {
+		p, _ := strconv.Unquote(spec.Path.Value)
+		name := path.Base(p)
+		if spec.Name != nil {
+			name = spec.Name.Name
+		}
+		g.imports[name] = p
+	}
+
+	it := findInterface(file, ifaceName)
+	if it == nil {
+		return nil, fmt.Errorf("interface %s not found in %s", ifaceName, filename)
+	}
+
+	var methods []method
+	for _, field := range it.Methods.List {
+		ft, ok := field.Type.(*ast.FuncType)
+		if !ok || len(field.Names) == 0 {
+			return nil, errors.New("embedded interfaces are not supported")
+		}
+		m := method{name: field.Names[0].Name}
+		for _, p := range expand(ft.Params) {
+			m.params = append(m.params, g.typeString(p))
+		}
+		for _, r := range expand(ft.Results) {
+			m.results = append(m.results, g.typeString(r))
+		}
+		m.hasCtx = len(m.params) > 0 && m.params[0] == "context.Context"
+		methods = append(methods, m)
+	}
+
+	var buf bytes.Buffer
+	g.render(&buf, outPkg, ifaceName, methods)
+
+	out, err := format.Source(buf.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("failed to format the generated code: %w\n%s", err, buf.Bytes())
+	}
+	return out, nil
+}
+
+func (g *generator) render(buf *bytes.Buffer, outPkg, ifaceName string, methods []method) {
+	qualified := g.pkgName + "." + ifaceName
+
+	fmt.Fprintf(buf, "// Code generated by mockgen. DO NOT EDIT.\n\n")
+	fmt.Fprintf(buf, "// Package %s provides a mock implementation of %s.\n", outPkg, qualified)
+	fmt.Fprintf(buf, "package %s\n\n", outPkg)
+
+	names := make([]string, 0, len(g.used))
+	for name := range g.used {
+		names = append(names, name)
+	}
+	sort.Slice(names, func(i, j int) bool {
+		pi, pj := g.used[names[i]], g.used[names[j]]
+		if isStd(pi) != isStd(pj) {
+			return isStd(pi)
+		}
+		return pi < pj
+	})
+	buf.WriteString("import (\n")
+	for i, name := range names {
+		p := g.used[name]
+		// Separate the standard library from other packages.
+		if i > 0 && !isStd(g.used[names[i-1]]) == isStd(p) {
+			buf.WriteString("\n")
+		}
+		if path.Base(p) == name {
+			fmt.Fprintf(buf, "\t%q\n", p)
+		} else {
+			fmt.Fprintf(buf, "\t%s %q\n", name, p)
+		}
+	}
+	buf.WriteString(")\n\n")
+
+	fmt.Fprintf(buf, "// %s is a mock implementation of %s. Every call is recorded, and\n", ifaceName, qualified)
+	fmt.Fprintf(buf, "// answered by the matching Func field if set, or with zero values and\n")
+	fmt.Fprintf(buf, "// mock.ErrNotStubbed otherwise. Use the On methods to stub canned responses.\n")
+	fmt.Fprintf(buf, "type %s struct {\n\tmock.Recorder\n\n", ifaceName)
+	for _, m := range methods {
+		fmt.Fprintf(buf, "\t%sFunc func(%s) %s\n", m.name, strings.Join(m.params, ", "), results(m.results))
+	}
+	buf.WriteString("}\n\n")
+	fmt.Fprintf(buf, "var _ %s = &%s{}\n", qualified, ifaceName)
+
+	for _, m := range methods {
+		args := make([]string, len(m.params))
+		params := make([]string, len(m.params))
+		for i, p := range m.params {
+			switch {
+			case i == 0 && m.hasCtx:
+				args[i] = "ctx"
+			case m.hasCtx:
+				args[i] = fmt.Sprintf("a%d", i-1)
+			default:
+				args[i] = fmt.Sprintf("a%d", i)
+			}
+			params[i] = args[i] + " " + p
+		}
+		recorded := args
+		if m.hasCtx {
+			recorded = args[1:]
+		}
+
+		fmt.Fprintf(buf, "\n// %s records the call and calls %sFunc.\n", m.name, m.name)
+		fmt.Fprintf(buf, "func (m *%s) %s(%s) %s {\n", ifaceName, m.name, strings.Join(params, ", "), results(m.results))
+		fmt.Fprintf(buf, "\tm.Record(%s)\n", strings.Join(append([]string{strconv.Quote(m.name)}, recorded...), ", "))
+		fmt.Fprintf(buf, "\tif m.%sFunc == nil {\n", m.name)
+		if len(m.results) > 0 {
+			zeros := make([]string, len(m.results))
+			for i, r := range m.results {
+				zeros[i] = zero(r)
+			}
+			fmt.Fprintf(buf, "\t\treturn %s\n", strings.Join(zeros, ", "))
+		} else {
+			buf.WriteString("\t\treturn\n")
+		}
+		buf.WriteString("\t}\n")
+		if len(m.results) > 0 {
+			buf.WriteString("\treturn ")
+		} else {
+			buf.WriteString("\t")
+		}
+		fmt.Fprintf(buf, "m.%sFunc(%s)\n}\n", m.name, strings.Join(args, ", "))
+
+		if len(m.results) == 0 {
+			continue
+		}
+		rets := make([]string, len(m.results))
+		rparams := make([]string, len(m.results))
+		for i, r := range m.results {
+			rets[i] = fmt.Sprintf("r%d", i)
+			rparams[i] = rets[i] + " " + r
+		}
+		fmt.Fprintf(buf, "\n// On%s stubs %s to return the given values.\n", m.name, m.name)
+		fmt.Fprintf(buf, "func (m *%s) On%s(%s) *%s {\n", ifaceName, m.name, strings.Join(rparams, ", "), ifaceName)
+		fmt.Fprintf(buf, "\tm.%sFunc = func(%s) %s {\n\t\treturn %s\n\t}\n", m.name,
+			strings.Join(m.params, ", "), results(m.results), strings.Join(rets, ", "))
+		buf.WriteString("\treturn m\n}\n")
+	}
+}
+
+// typeString renders a type of the source package, as seen from the mock
+// package.
+func (g *generator) typeString(expr ast.Expr) string {
+	switch t := expr.(type) {
+	case *ast.Ident:
+		if ast.IsExported(t.Name) {
+			return g.pkgName + "." + t.Name
+		}
+		return t.Name
+	case *ast.SelectorExpr:
+		pkg := t.X.(*ast.Ident).Name
+		g.used[pkg] = g.imports[pkg]
+		return pkg + "." + t.Sel.Name
+	case *ast.StarExpr:
+		return "*" + g.typeString(t.X)
+	case *ast.ArrayType:
+		if t.Len != nil {
+			return "[" + nodeString(t.Len) + "]" + g.typeString(t.Elt)
+		}
+		return "[]" + g.typeString(t.Elt)
+	case *ast.MapType:
+		return "map[" + g.typeString(t.Key) + "]" + g.typeString(t.Value)
+	case *ast.Ellipsis:
+		return "..." + g.typeString(t.Elt)
+	case *ast.IndexExpr:
+		return g.typeString(t.X) + "[" + g.typeString(t.Index) + "]"
+	case *ast.IndexListExpr:
+		indices := make([]string, len(t.Indices))
+		for i, index := range t.Indices {
+			indices[i] = g.typeString(index)
+		}
+		return g.typeString(t.X) + "[" + strings.Join(indices, ", ") + "]"
+	case *ast.InterfaceType:
+		return "interface{}"
+	default:
+		return nodeString(expr)
+	}
+}
+
+func nodeString(expr ast.Expr) string {
+	var buf bytes.Buffer
+	format.Node(&buf, token.NewFileSet(), expr)
+	return buf.String()
+}
+
+// zero returns the zero value of a rendered type, or mock.ErrNotStubbed for
+// errors. Pagers yield mock.ErrNotStubbed rather than being nil, so that
+// ranging over an unstubbed paginator does not panic.
+func zero(typ string) string {
+	switch {
+	case typ == "error":
+		return "mock.ErrNotStubbed"
+	case strings.HasPrefix(typ, "client.Pager["):
+		return "client.NewSlicePager[" + strings.TrimPrefix(typ, "client.Pager[") + "(nil, mock.ErrNotStubbed)"
+	case strings.HasPrefix(typ, "*"), strings.HasPrefix(typ, "[]"), strings.HasPrefix(typ, "map["),
+		strings.HasPrefix(typ, "func("), typ == "interface{}":
+		return "nil"
+	case typ == "string":
+		return `""`
+	case typ == "bool":
+		return "false"
+	case strings.HasPrefix(typ, "int"), strings.HasPrefix(typ, "uint"), strings.HasPrefix(typ, "float"):
+		return "0"
+	default:
+		return "*new(" + typ + ")"
+	}
+}
+
+// isStd reports whether the import path belongs to the standard library.
+func isStd(importPath string) bool {
+	return !strings.Contains(strings.SplitN(importPath, "/", 2)[0], ".")
+}
+
+func results(rs []string) string {
+	switch len(rs) {
+	case 0:
+		return ""
+	case 1:
+		return rs[0]
+	default:
+		return "(" + strings.Join(rs, ", ") + ")"
+	}
+}
+
+// expand returns the type of every parameter of a field list, e.g. twice the
+// same type for "a, b string".
+func expand(fl *ast.FieldList) []ast.Expr {
+	if fl == nil {
+		return nil
+	}
+	var out []ast.Expr
+	for _, f := range fl.List {
+		n := len(f.Names)
+		if n == 0 {
+			n = 1
+		}
+		for i := 0; i < n; i++ {
+			out = append(out, f.Type)
+		}
+	}
+	return out
+}
+
+func findInterface(file *ast.File, name string) *ast.InterfaceType {
+	for _, decl := range file.Decls {
+		gd, ok := decl.(*ast.GenDecl)
+		if !ok || gd.Tok != token.TYPE {
+			continue
+		}
+		for _, spec := range gd.Specs {
+			ts := spec.(*ast.TypeSpec)
+			if it, ok := ts.Type.(*ast.InterfaceType); ok && ts.Name.Name == name {
+				return it
+			}
+		}
+	}
+	return nil
+}
+
+// importPath returns the import path of the package in dir, based on the
+// module declared by the closest go.mod.
+func importPath(dir string) (string, error) {
+	dir, err := filepath.Abs(dir)
+	if err != nil {
+		return "", err
+	}
+
+	for root := dir; ; root = filepath.Dir(root) {
+		data, err := os.ReadFile(filepath.Join(root, "go.mod"))
+		if err == nil {
+			for _, line := range strings.Split(string(data), "\n") {
+				if mod, ok := strings.CutPrefix(strings.TrimSpace(line), "module "); ok {
+					rel, err := filepath.Rel(root, dir)
+					if err != nil {
+						return "", err
+					}
+					return path.Join(strings.TrimSpace(mod), filepath.ToSlash(rel)), nil
+				}
+			}
+			return "", fmt.Errorf("no module declared in %s", filepath.Join(root, "go.mod"))
+		}
+		if filepath.Dir(root) == root {
+			return "", errors.New("go.mod not found")
+		}
+	}
+}
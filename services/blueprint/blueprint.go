@@ -2,8 +2,6 @@ package blueprint
 
 import (
 	"context"
-	"encoding/json"
-	"io"
 	"net/http"
 
 	"github.com/control-monkey/controlmonkey-sdk-go/controlmonkey/util/uritemplates"
@@ -105,29 +103,32 @@ type TtlDefinition struct {
 
 func (s *ServiceOp) CreateBlueprint(ctx context.Context, input *Blueprint) (*Blueprint, error) {
 	r := client.NewRequest(http.MethodPost, "/blueprint")
+	r.Operation = "blueprint.CreateBlueprint"
 	r.Obj = input
 
-	resp, err := client.RequireOK(s.Client.Do(ctx, r))
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
+	return client.DoOne[Blueprint](ctx, s.Client, r)
+}
+
+func (s *ServiceOp) ListBlueprints(ctx context.Context, blueprintId *string, blueprintName *string) ([]*Blueprint, error) {
+	r := listBlueprintsRequest(blueprintId, blueprintName)
 
-	blueprint, err := blueprintsFromHttpResponse(resp)
+	blueprints, err := client.DoItems[Blueprint](ctx, s.Client, r)
 	if err != nil {
 		return nil, err
 	}
 
-	output := new(Blueprint)
-	if len(blueprint) > 0 {
-		output = blueprint[0]
-	}
+	return blueprints, nil
+}
 
-	return output, nil
+func (s *ServiceOp) ListBlueprintsPaginator(blueprintId *string, blueprintName *string, opts *client.PageOptions) client.Pager[Blueprint] {
+	return client.NewPaginator[Blueprint](s.Client, func() *client.Request {
+		return listBlueprintsRequest(blueprintId, blueprintName)
+	}, opts)
 }
 
-func (s *ServiceOp) ListBlueprints(ctx context.Context, blueprintId *string, blueprintName *string) ([]*Blueprint, error) {
+func listBlueprintsRequest(blueprintId *string, blueprintName *string) *client.Request {
 	r := client.NewRequest(http.MethodGet, "/blueprint")
+	r.Operation = "blueprint.ListBlueprints"
 
 	if blueprintId != nil {
 		r.Params.Set("blueprintId", *blueprintId)
@@ -136,18 +137,7 @@ func (s *ServiceOp) ListBlueprints(ctx context.Context, blueprintId *string, blu
 		r.Params.Set("blueprintName", *blueprintName)
 	}
 
-	resp, err := client.RequireOK(s.Client.Do(ctx, r))
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	blueprints, err := blueprintsFromHttpResponse(resp)
-	if err != nil {
-		return nil, err
-	}
-
-	return blueprints, nil
+	return r
 }
 
 func (s *ServiceOp) ReadBlueprint(ctx context.Context, blueprintId string) (*Blueprint, error) {
@@ -159,23 +149,8 @@ func (s *ServiceOp) ReadBlueprint(ctx context.Context, blueprintId string) (*Blu
 	}
 
 	r := client.NewRequest(http.MethodGet, path)
-	resp, err := client.RequireOK(s.Client.Do(ctx, r))
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	blueprint, err := blueprintsFromHttpResponse(resp)
-	if err != nil {
-		return nil, err
-	}
-
-	output := new(Blueprint)
-	if len(blueprint) > 0 {
-		output = blueprint[0]
-	}
-
-	return output, nil
+	r.Operation = "blueprint.ReadBlueprint"
+	return client.DoOne[Blueprint](ctx, s.Client, r)
 }
 
 func (s *ServiceOp) UpdateBlueprint(ctx context.Context, blueprintId string, input *Blueprint) (*Blueprint, error) {
@@ -185,25 +160,10 @@ func (s *ServiceOp) UpdateBlueprint(ctx context.Context, blueprintId string, inp
 	}
 
 	r := client.NewRequest(http.MethodPut, path)
+	r.Operation = "blueprint.UpdateBlueprint"
 	r.Obj = input
 
-	resp, err := client.RequireOK(s.Client.Do(ctx, r))
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	blueprint, err := blueprintsFromHttpResponse(resp)
-	if err != nil {
-		return nil, err
-	}
-
-	output := new(Blueprint)
-	if len(blueprint) > 0 {
-		output = blueprint[0]
-	}
-
-	return output, nil
+	return client.DoOne[Blueprint](ctx, s.Client, r)
 }
 
 func (s *ServiceOp) DeleteBlueprint(ctx context.Context, blueprintId string) (*commons.EmptyResponse, error) {
@@ -213,6 +173,7 @@ func (s *ServiceOp) DeleteBlueprint(ctx context.Context, blueprintId string) (*c
 	}
 
 	r := client.NewRequest(http.MethodDelete, path)
+	r.Operation = "blueprint.DeleteBlueprint"
 	resp, err := client.RequireOK(s.Client.Do(ctx, r))
 	if err != nil {
 		return nil, err
@@ -225,45 +186,6 @@ func (s *ServiceOp) DeleteBlueprint(ctx context.Context, blueprintId string) (*c
 
 //endregion
 
-//region Private Methods
-
-func blueprintFromJSON(in []byte) (*Blueprint, error) {
-	b := new(Blueprint)
-	if err := json.Unmarshal(in, b); err != nil {
-		return nil, err
-	}
-	return b, nil
-}
-
-func blueprintsFromJSON(in []byte) ([]*Blueprint, error) {
-	var rw client.Response
-	if err := json.Unmarshal(in, &rw); err != nil {
-		return nil, err
-	}
-	out := make([]*Blueprint, len(rw.Response.Items))
-	if len(out) == 0 {
-		return out, nil
-	}
-	for i, rb := range rw.Response.Items {
-		b, err := blueprintFromJSON(rb)
-		if err != nil {
-			return nil, err
-		}
-		out[i] = b
-	}
-	return out, nil
-}
-
-func blueprintsFromHttpResponse(resp *http.Response) ([]*Blueprint, error) {
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return nil, err
-	}
-	return blueprintsFromJSON(body)
-}
-
-//endregion
-
 //region Setters
 
 func (o Blueprint) MarshalJSON() ([]byte, error) {
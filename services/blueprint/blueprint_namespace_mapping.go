@@ -2,8 +2,6 @@ package blueprint
 
 import (
 	"context"
-	"encoding/json"
-	"io"
 	"net/http"
 
 	"github.com/control-monkey/controlmonkey-sdk-go/controlmonkey/client"
@@ -29,47 +27,40 @@ type BlueprintNamespaceMapping struct {
 
 func (s *ServiceOp) CreateBlueprintNamespaceMapping(ctx context.Context, input *BlueprintNamespaceMapping) (*BlueprintNamespaceMapping, error) {
 	r := client.NewRequest(http.MethodPost, "/blueprint/blueprintNamespaceMapping")
+	r.Operation = "blueprint.CreateBlueprintNamespaceMapping"
 	r.Obj = input
 
-	resp, err := client.RequireOK(s.Client.Do(ctx, r))
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
+	return client.DoOne[BlueprintNamespaceMapping](ctx, s.Client, r)
+}
 
-	blueprintNamespaceMapping, err := blueprintNamespaceMappingsFromHttpResponse(resp)
+func (s *ServiceOp) ListBlueprintNamespaceMappings(ctx context.Context, blueprintId string) ([]*BlueprintNamespaceMapping, error) {
+	r := listBlueprintNamespaceMappingsRequest(blueprintId)
+
+	output, err := client.DoItems[BlueprintNamespaceMapping](ctx, s.Client, r)
 	if err != nil {
 		return nil, err
 	}
 
-	output := new(BlueprintNamespaceMapping)
-	if len(blueprintNamespaceMapping) > 0 {
-		output = blueprintNamespaceMapping[0]
-	}
-
 	return output, nil
 }
 
-func (s *ServiceOp) ListBlueprintNamespaceMappings(ctx context.Context, blueprintId string) ([]*BlueprintNamespaceMapping, error) {
+func (s *ServiceOp) ListBlueprintNamespaceMappingsPaginator(blueprintId string, opts *client.PageOptions) client.Pager[BlueprintNamespaceMapping] {
+	return client.NewPaginator[BlueprintNamespaceMapping](s.Client, func() *client.Request {
+		return listBlueprintNamespaceMappingsRequest(blueprintId)
+	}, opts)
+}
+
+func listBlueprintNamespaceMappingsRequest(blueprintId string) *client.Request {
 	r := client.NewRequest(http.MethodGet, "/blueprint/blueprintNamespaceMapping")
+	r.Operation = "blueprint.ListBlueprintNamespaceMappings"
 	r.Params.Set("blueprintId", blueprintId)
 
-	resp, err := client.RequireOK(s.Client.Do(ctx, r))
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	output, err := blueprintNamespaceMappingsFromHttpResponse(resp)
-	if err != nil {
-		return nil, err
-	}
-
-	return output, nil
+	return r
 }
 
 func (s *ServiceOp) DeleteBlueprintNamespaceMapping(ctx context.Context, input *BlueprintNamespaceMapping) (*commons.EmptyResponse, error) {
 	r := client.NewRequest(http.MethodDelete, "/blueprint/blueprintNamespaceMapping")
+	r.Operation = "blueprint.DeleteBlueprintNamespaceMapping"
 	r.Obj = input
 
 	resp, err := client.RequireOK(s.Client.Do(ctx, r))
@@ -84,45 +75,6 @@ func (s *ServiceOp) DeleteBlueprintNamespaceMapping(ctx context.Context, input *
 
 //endregion
 
-//region Private Methods
-
-func blueprintNamespaceMappingFromJSON(in []byte) (*BlueprintNamespaceMapping, error) {
-	b := new(BlueprintNamespaceMapping)
-	if err := json.Unmarshal(in, b); err != nil {
-		return nil, err
-	}
-	return b, nil
-}
-
-func blueprintNamespaceMappingsFromJSON(in []byte) ([]*BlueprintNamespaceMapping, error) {
-	var rw client.Response
-	if err := json.Unmarshal(in, &rw); err != nil {
-		return nil, err
-	}
-	out := make([]*BlueprintNamespaceMapping, len(rw.Response.Items))
-	if len(out) == 0 {
-		return out, nil
-	}
-	for i, rb := range rw.Response.Items {
-		b, err := blueprintNamespaceMappingFromJSON(rb)
-		if err != nil {
-			return nil, err
-		}
-		out[i] = b
-	}
-	return out, nil
-}
-
-func blueprintNamespaceMappingsFromHttpResponse(resp *http.Response) ([]*BlueprintNamespaceMapping, error) {
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return nil, err
-	}
-	return blueprintNamespaceMappingsFromJSON(body)
-}
-
-//endregion
-
 //region Setters
 
 func (o BlueprintNamespaceMapping) MarshalJSON() ([]byte, error) {
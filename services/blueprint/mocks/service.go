@@ -0,0 +1,203 @@
+// Code generated by mockgen. DO NOT EDIT.
+
+// Package mocks provides a mock implementation of blueprint.Service.
+package mocks
+
+import (
+	"context"
+
+	"github.com/control-monkey/controlmonkey-sdk-go/controlmonkey/client"
+	"github.com/control-monkey/controlmonkey-sdk-go/controlmonkey/testing/mock"
+	"github.com/control-monkey/controlmonkey-sdk-go/services/blueprint"
+	"github.com/control-monkey/controlmonkey-sdk-go/services/commons"
+)
+
+// Service is a mock implementation of blueprint.Service. Every call is recorded, and
+// answered by the matching Func field if set, or with zero values and
+// mock.ErrNotStubbed otherwise. Use the On methods to stub canned responses.
+type Service struct {
+	mock.Recorder
+
+	CreateBlueprintFunc                         func(context.Context, *blueprint.Blueprint) (*blueprint.Blueprint, error)
+	ListBlueprintsFunc                          func(context.Context, *string, *string) ([]*blueprint.Blueprint, error)
+	ListBlueprintsPaginatorFunc                 func(*string, *string, *client.PageOptions) client.Pager[blueprint.Blueprint]
+	ReadBlueprintFunc                           func(context.Context, string) (*blueprint.Blueprint, error)
+	UpdateBlueprintFunc                         func(context.Context, string, *blueprint.Blueprint) (*blueprint.Blueprint, error)
+	DeleteBlueprintFunc                         func(context.Context, string) (*commons.EmptyResponse, error)
+	ListBlueprintNamespaceMappingsFunc          func(context.Context, string) ([]*blueprint.BlueprintNamespaceMapping, error)
+	ListBlueprintNamespaceMappingsPaginatorFunc func(string, *client.PageOptions) client.Pager[blueprint.BlueprintNamespaceMapping]
+	CreateBlueprintNamespaceMappingFunc         func(context.Context, *blueprint.BlueprintNamespaceMapping) (*blueprint.BlueprintNamespaceMapping, error)
+	DeleteBlueprintNamespaceMappingFunc         func(context.Context, *blueprint.BlueprintNamespaceMapping) (*commons.EmptyResponse, error)
+}
+
+var _ blueprint.Service = &Service{}
+
+// CreateBlueprint records the call and calls CreateBlueprintFunc.
+func (m *Service) CreateBlueprint(ctx context.Context, a0 *blueprint.Blueprint) (*blueprint.Blueprint, error) {
+	m.Record("CreateBlueprint", a0)
+	if m.CreateBlueprintFunc == nil {
+		return nil, mock.ErrNotStubbed
+	}
+	return m.CreateBlueprintFunc(ctx, a0)
+}
+
+// OnCreateBlueprint stubs CreateBlueprint to return the given values.
+func (m *Service) OnCreateBlueprint(r0 *blueprint.Blueprint, r1 error) *Service {
+	m.CreateBlueprintFunc = func(context.Context, *blueprint.Blueprint) (*blueprint.Blueprint, error) {
+		return r0, r1
+	}
+	return m
+}
+
+// ListBlueprints records the call and calls ListBlueprintsFunc.
+func (m *Service) ListBlueprints(ctx context.Context, a0 *string, a1 *string) ([]*blueprint.Blueprint, error) {
+	m.Record("ListBlueprints", a0, a1)
+	if m.ListBlueprintsFunc == nil {
+		return nil, mock.ErrNotStubbed
+	}
+	return m.ListBlueprintsFunc(ctx, a0, a1)
+}
+
+// OnListBlueprints stubs ListBlueprints to return the given values.
+func (m *Service) OnListBlueprints(r0 []*blueprint.Blueprint, r1 error) *Service {
+	m.ListBlueprintsFunc = func(context.Context, *string, *string) ([]*blueprint.Blueprint, error) {
+		return r0, r1
+	}
+	return m
+}
+
+// ListBlueprintsPaginator records the call and calls ListBlueprintsPaginatorFunc.
+func (m *Service) ListBlueprintsPaginator(a0 *string, a1 *string, a2 *client.PageOptions) client.Pager[blueprint.Blueprint] {
+	m.Record("ListBlueprintsPaginator", a0, a1, a2)
+	if m.ListBlueprintsPaginatorFunc == nil {
+		return client.NewSlicePager[blueprint.Blueprint](nil, mock.ErrNotStubbed)
+	}
+	return m.ListBlueprintsPaginatorFunc(a0, a1, a2)
+}
+
+// OnListBlueprintsPaginator stubs ListBlueprintsPaginator to return the given values.
+func (m *Service) OnListBlueprintsPaginator(r0 client.Pager[blueprint.Blueprint]) *Service {
+	m.ListBlueprintsPaginatorFunc = func(*string, *string, *client.PageOptions) client.Pager[blueprint.Blueprint] {
+		return r0
+	}
+	return m
+}
+
+// ReadBlueprint records the call and calls ReadBlueprintFunc.
+func (m *Service) ReadBlueprint(ctx context.Context, a0 string) (*blueprint.Blueprint, error) {
+	m.Record("ReadBlueprint", a0)
+	if m.ReadBlueprintFunc == nil {
+		return nil, mock.ErrNotStubbed
+	}
+	return m.ReadBlueprintFunc(ctx, a0)
+}
+
+// OnReadBlueprint stubs ReadBlueprint to return the given values.
+func (m *Service) OnReadBlueprint(r0 *blueprint.Blueprint, r1 error) *Service {
+	m.ReadBlueprintFunc = func(context.Context, string) (*blueprint.Blueprint, error) {
+		return r0, r1
+	}
+	return m
+}
+
+// UpdateBlueprint records the call and calls UpdateBlueprintFunc.
+func (m *Service) UpdateBlueprint(ctx context.Context, a0 string, a1 *blueprint.Blueprint) (*blueprint.Blueprint, error) {
+	m.Record("UpdateBlueprint", a0, a1)
+	if m.UpdateBlueprintFunc == nil {
+		return nil, mock.ErrNotStubbed
+	}
+	return m.UpdateBlueprintFunc(ctx, a0, a1)
+}
+
+// OnUpdateBlueprint stubs UpdateBlueprint to return the given values.
+func (m *Service) OnUpdateBlueprint(r0 *blueprint.Blueprint, r1 error) *Service {
+	m.UpdateBlueprintFunc = func(context.Context, string, *blueprint.Blueprint) (*blueprint.Blueprint, error) {
+		return r0, r1
+	}
+	return m
+}
+
+// DeleteBlueprint records the call and calls DeleteBlueprintFunc.
+func (m *Service) DeleteBlueprint(ctx context.Context, a0 string) (*commons.EmptyResponse, error) {
+	m.Record("DeleteBlueprint", a0)
+	if m.DeleteBlueprintFunc == nil {
+		return nil, mock.ErrNotStubbed
+	}
+	return m.DeleteBlueprintFunc(ctx, a0)
+}
+
+// OnDeleteBlueprint stubs DeleteBlueprint to return the given values.
+func (m *Service) OnDeleteBlueprint(r0 *commons.EmptyResponse, r1 error) *Service {
+	m.DeleteBlueprintFunc = func(context.Context, string) (*commons.EmptyResponse, error) {
+		return r0, r1
+	}
+	return m
+}
+
+// ListBlueprintNamespaceMappings records the call and calls ListBlueprintNamespaceMappingsFunc.
+func (m *Service) ListBlueprintNamespaceMappings(ctx context.Context, a0 string) ([]*blueprint.BlueprintNamespaceMapping, error) {
+	m.Record("ListBlueprintNamespaceMappings", a0)
+	if m.ListBlueprintNamespaceMappingsFunc == nil {
+		return nil, mock.ErrNotStubbed
+	}
+	return m.ListBlueprintNamespaceMappingsFunc(ctx, a0)
+}
+
+// OnListBlueprintNamespaceMappings stubs ListBlueprintNamespaceMappings to return the given values.
+func (m *Service) OnListBlueprintNamespaceMappings(r0 []*blueprint.BlueprintNamespaceMapping, r1 error) *Service {
+	m.ListBlueprintNamespaceMappingsFunc = func(context.Context, string) ([]*blueprint.BlueprintNamespaceMapping, error) {
+		return r0, r1
+	}
+	return m
+}
+
+// ListBlueprintNamespaceMappingsPaginator records the call and calls ListBlueprintNamespaceMappingsPaginatorFunc.
+func (m *Service) ListBlueprintNamespaceMappingsPaginator(a0 string, a1 *client.PageOptions) client.Pager[blueprint.BlueprintNamespaceMapping] {
+	m.Record("ListBlueprintNamespaceMappingsPaginator", a0, a1)
+	if m.ListBlueprintNamespaceMappingsPaginatorFunc == nil {
+		return client.NewSlicePager[blueprint.BlueprintNamespaceMapping](nil, mock.ErrNotStubbed)
+	}
+	return m.ListBlueprintNamespaceMappingsPaginatorFunc(a0, a1)
+}
+
+// OnListBlueprintNamespaceMappingsPaginator stubs ListBlueprintNamespaceMappingsPaginator to return the given values.
+func (m *Service) OnListBlueprintNamespaceMappingsPaginator(r0 client.Pager[blueprint.BlueprintNamespaceMapping]) *Service {
+	m.ListBlueprintNamespaceMappingsPaginatorFunc = func(string, *client.PageOptions) client.Pager[blueprint.BlueprintNamespaceMapping] {
+		return r0
+	}
+	return m
+}
+
+// CreateBlueprintNamespaceMapping records the call and calls CreateBlueprintNamespaceMappingFunc.
+func (m *Service) CreateBlueprintNamespaceMapping(ctx context.Context, a0 *blueprint.BlueprintNamespaceMapping) (*blueprint.BlueprintNamespaceMapping, error) {
+	m.Record("CreateBlueprintNamespaceMapping", a0)
+	if m.CreateBlueprintNamespaceMappingFunc == nil {
+		return nil, mock.ErrNotStubbed
+	}
+	return m.CreateBlueprintNamespaceMappingFunc(ctx, a0)
+}
+
+// OnCreateBlueprintNamespaceMapping stubs CreateBlueprintNamespaceMapping to return the given values.
+func (m *Service) OnCreateBlueprintNamespaceMapping(r0 *blueprint.BlueprintNamespaceMapping, r1 error) *Service {
+	m.CreateBlueprintNamespaceMappingFunc = func(context.Context, *blueprint.BlueprintNamespaceMapping) (*blueprint.BlueprintNamespaceMapping, error) {
+		return r0, r1
+	}
+	return m
+}
+
+// DeleteBlueprintNamespaceMapping records the call and calls DeleteBlueprintNamespaceMappingFunc.
+func (m *Service) DeleteBlueprintNamespaceMapping(ctx context.Context, a0 *blueprint.BlueprintNamespaceMapping) (*commons.EmptyResponse, error) {
+	m.Record("DeleteBlueprintNamespaceMapping", a0)
+	if m.DeleteBlueprintNamespaceMappingFunc == nil {
+		return nil, mock.ErrNotStubbed
+	}
+	return m.DeleteBlueprintNamespaceMappingFunc(ctx, a0)
+}
+
+// OnDeleteBlueprintNamespaceMapping stubs DeleteBlueprintNamespaceMapping to return the given values.
+func (m *Service) OnDeleteBlueprintNamespaceMapping(r0 *commons.EmptyResponse, r1 error) *Service {
+	m.DeleteBlueprintNamespaceMappingFunc = func(context.Context, *blueprint.BlueprintNamespaceMapping) (*commons.EmptyResponse, error) {
+		return r0, r1
+	}
+	return m
+}
@@ -9,17 +9,21 @@ import (
 	"github.com/control-monkey/controlmonkey-sdk-go/services/commons"
 )
 
+//go:generate go run ../../internal/mockgen
+
 // Service provides the API operation methods for making requests to endpoints
 // of the ControlMonkey API. See this package's package overview docs for details on
 // the service.
 type Service interface {
 	CreateBlueprint(context.Context, *Blueprint) (*Blueprint, error)
 	ListBlueprints(context.Context, *string, *string) ([]*Blueprint, error)
+	ListBlueprintsPaginator(*string, *string, *client.PageOptions) client.Pager[Blueprint]
 	ReadBlueprint(context.Context, string) (*Blueprint, error)
 	UpdateBlueprint(context.Context, string, *Blueprint) (*Blueprint, error)
 	DeleteBlueprint(context.Context, string) (*commons.EmptyResponse, error)
 
 	ListBlueprintNamespaceMappings(context.Context, string) ([]*BlueprintNamespaceMapping, error)
+	ListBlueprintNamespaceMappingsPaginator(string, *client.PageOptions) client.Pager[BlueprintNamespaceMapping]
 	CreateBlueprintNamespaceMapping(context.Context, *BlueprintNamespaceMapping) (*BlueprintNamespaceMapping, error)
 	DeleteBlueprintNamespaceMapping(context.Context, *BlueprintNamespaceMapping) (*commons.EmptyResponse, error)
 }
@@ -36,6 +40,6 @@ func New(sess *session.Session, cfgs ...*controlmonkey.Config) Service {
 	cfg.Merge(cfgs...)
 
 	return &ServiceOp{
-		Client: client.New(sess.Config),
+		Client: client.New(cfg),
 	}
 }
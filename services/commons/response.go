@@ -1,10 +1,15 @@
 package commons
 
+import "github.com/control-monkey/controlmonkey-sdk-go/controlmonkey/client"
+
 type EmptyResponse struct{}
 
 // Error codes
+//
+// See client.IsNotFound, client.IsAlreadyExists and client.IsValidationError
+// to check whether an error returned by a service carries one of them.
 const (
-	ErrorCodeNotFound        = "not_found"
-	ErrorCodeAlreadyExist    = "already_exist"
-	ErrorCodeValidationError = "validation_error"
+	ErrorCodeNotFound        = client.ErrorCodeNotFound
+	ErrorCodeAlreadyExist    = client.ErrorCodeAlreadyExist
+	ErrorCodeValidationError = client.ErrorCodeValidationError
 )
@@ -2,8 +2,6 @@ package control_policy
 
 import (
 	"context"
-	"encoding/json"
-	"io"
 	"net/http"
 	"strconv"
 
@@ -35,29 +33,32 @@ type ControlPolicy struct {
 
 func (s *ServiceOp) CreateControlPolicy(ctx context.Context, input *ControlPolicy) (*ControlPolicy, error) {
 	r := client.NewRequest(http.MethodPost, "/controlPolicy")
+	r.Operation = "control_policy.CreateControlPolicy"
 	r.Obj = input
 
-	resp, err := client.RequireOK(s.Client.Do(ctx, r))
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
+	return client.DoOne[ControlPolicy](ctx, s.Client, r)
+}
+
+func (s *ServiceOp) ListControlPolicies(ctx context.Context, controlPolicyId *string, controlPolicyName *string, includeManaged *bool) ([]*ControlPolicy, error) {
+	r := listControlPoliciesRequest(controlPolicyId, controlPolicyName, includeManaged)
 
-	controlPolicy, err := controlPoliciesFromHttpResponse(resp)
+	output, err := client.DoItems[ControlPolicy](ctx, s.Client, r)
 	if err != nil {
 		return nil, err
 	}
 
-	output := new(ControlPolicy)
-	if len(controlPolicy) > 0 {
-		output = controlPolicy[0]
-	}
-
 	return output, nil
 }
 
-func (s *ServiceOp) ListControlPolicies(ctx context.Context, controlPolicyId *string, controlPolicyName *string, includeManaged *bool) ([]*ControlPolicy, error) {
+func (s *ServiceOp) ListControlPoliciesPaginator(controlPolicyId *string, controlPolicyName *string, includeManaged *bool, opts *client.PageOptions) client.Pager[ControlPolicy] {
+	return client.NewPaginator[ControlPolicy](s.Client, func() *client.Request {
+		return listControlPoliciesRequest(controlPolicyId, controlPolicyName, includeManaged)
+	}, opts)
+}
+
+func listControlPoliciesRequest(controlPolicyId *string, controlPolicyName *string, includeManaged *bool) *client.Request {
 	r := client.NewRequest(http.MethodGet, "/controlPolicy")
+	r.Operation = "control_policy.ListControlPolicies"
 
 	if controlPolicyId != nil {
 		r.Params.Set("controlPolicyId", *controlPolicyId)
@@ -69,18 +70,7 @@ func (s *ServiceOp) ListControlPolicies(ctx context.Context, controlPolicyId *st
 		r.Params.Set("includeManaged", strconv.FormatBool(*includeManaged))
 	}
 
-	resp, err := client.RequireOK(s.Client.Do(ctx, r))
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	output, err := controlPoliciesFromHttpResponse(resp)
-	if err != nil {
-		return nil, err
-	}
-
-	return output, nil
+	return r
 }
 
 func (s *ServiceOp) ReadControlPolicy(ctx context.Context, controlPolicyId string) (*ControlPolicy, error) {
@@ -90,23 +80,8 @@ func (s *ServiceOp) ReadControlPolicy(ctx context.Context, controlPolicyId strin
 	}
 
 	r := client.NewRequest(http.MethodGet, path)
-	resp, err := client.RequireOK(s.Client.Do(ctx, r))
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	controlPolicy, err := controlPoliciesFromHttpResponse(resp)
-	if err != nil {
-		return nil, err
-	}
-
-	output := new(ControlPolicy)
-	if len(controlPolicy) > 0 {
-		output = controlPolicy[0]
-	}
-
-	return output, nil
+	r.Operation = "control_policy.ReadControlPolicy"
+	return client.DoOne[ControlPolicy](ctx, s.Client, r)
 }
 
 func (s *ServiceOp) UpdateControlPolicy(ctx context.Context, id string, input *ControlPolicy) (*ControlPolicy, error) {
@@ -116,26 +91,10 @@ func (s *ServiceOp) UpdateControlPolicy(ctx context.Context, id string, input *C
 	}
 
 	r := client.NewRequest(http.MethodPut, path)
+	r.Operation = "control_policy.UpdateControlPolicy"
 	r.Obj = input
 
-	resp, err := client.RequireOK(s.Client.Do(ctx, r))
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	controlPolicy, err := controlPoliciesFromHttpResponse(resp)
-	if err != nil {
-		return nil, err
-	}
-
-	output := new(ControlPolicy)
-
-	if len(controlPolicy) > 0 {
-		output = controlPolicy[0]
-	}
-
-	return output, nil
+	return client.DoOne[ControlPolicy](ctx, s.Client, r)
 }
 
 func (s *ServiceOp) DeleteControlPolicy(ctx context.Context, id string) (*commons.EmptyResponse, error) {
@@ -145,6 +104,7 @@ func (s *ServiceOp) DeleteControlPolicy(ctx context.Context, id string) (*common
 	}
 
 	r := client.NewRequest(http.MethodDelete, path)
+	r.Operation = "control_policy.DeleteControlPolicy"
 
 	resp, err := client.RequireOK(s.Client.Do(ctx, r))
 	if err != nil {
@@ -158,45 +118,6 @@ func (s *ServiceOp) DeleteControlPolicy(ctx context.Context, id string) (*common
 
 //endregion
 
-//region Private Methods
-
-func controlPolicyFromJSON(in []byte) (*ControlPolicy, error) {
-	b := new(ControlPolicy)
-	if err := json.Unmarshal(in, b); err != nil {
-		return nil, err
-	}
-	return b, nil
-}
-
-func controlPolicysFromJSON(in []byte) ([]*ControlPolicy, error) {
-	var rw client.Response
-	if err := json.Unmarshal(in, &rw); err != nil {
-		return nil, err
-	}
-	out := make([]*ControlPolicy, len(rw.Response.Items))
-	if len(out) == 0 {
-		return out, nil
-	}
-	for i, rb := range rw.Response.Items {
-		b, err := controlPolicyFromJSON(rb)
-		if err != nil {
-			return nil, err
-		}
-		out[i] = b
-	}
-	return out, nil
-}
-
-func controlPoliciesFromHttpResponse(resp *http.Response) ([]*ControlPolicy, error) {
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return nil, err
-	}
-	return controlPolicysFromJSON(body)
-}
-
-//endregion
-
 //region Setters
 
 func (o ControlPolicy) MarshalJSON() ([]byte, error) {
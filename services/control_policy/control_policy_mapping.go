@@ -2,8 +2,6 @@ package control_policy
 
 import (
 	"context"
-	"encoding/json"
-	"io"
 	"net/http"
 
 	"github.com/control-monkey/controlmonkey-sdk-go/controlmonkey/client"
@@ -31,71 +29,48 @@ type ControlPolicyMapping struct {
 
 func (s *ServiceOp) CreateControlPolicyMapping(ctx context.Context, input *ControlPolicyMapping) (*ControlPolicyMapping, error) {
 	r := client.NewRequest(http.MethodPost, "/controlPolicy/controlPolicyMapping")
+	r.Operation = "control_policy.CreateControlPolicyMapping"
 	r.Obj = input
 
-	resp, err := client.RequireOK(s.Client.Do(ctx, r))
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
+	return client.DoOne[ControlPolicyMapping](ctx, s.Client, r)
+}
+
+func (s *ServiceOp) ListControlPolicyMappings(ctx context.Context, controlPolicyId string) ([]*ControlPolicyMapping, error) {
+	r := listControlPolicyMappingsRequest(controlPolicyId)
 
-	controlPolicyMapping, err := controlPolicyMappingsFromHttpResponse(resp)
+	output, err := client.DoItems[ControlPolicyMapping](ctx, s.Client, r)
 	if err != nil {
 		return nil, err
 	}
 
-	output := new(ControlPolicyMapping)
-	if len(controlPolicyMapping) > 0 {
-		output = controlPolicyMapping[0]
-	}
-
 	return output, nil
 }
 
-func (s *ServiceOp) ListControlPolicyMappings(ctx context.Context, controlPolicyId string) ([]*ControlPolicyMapping, error) {
+func (s *ServiceOp) ListControlPolicyMappingsPaginator(controlPolicyId string, opts *client.PageOptions) client.Pager[ControlPolicyMapping] {
+	return client.NewPaginator[ControlPolicyMapping](s.Client, func() *client.Request {
+		return listControlPolicyMappingsRequest(controlPolicyId)
+	}, opts)
+}
+
+func listControlPolicyMappingsRequest(controlPolicyId string) *client.Request {
 	r := client.NewRequest(http.MethodGet, "/controlPolicy/controlPolicyMapping")
+	r.Operation = "control_policy.ListControlPolicyMappings"
 	r.Params.Set("controlPolicyId", controlPolicyId)
 
-	resp, err := client.RequireOK(s.Client.Do(ctx, r))
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	output, err := controlPolicyMappingsFromHttpResponse(resp)
-	if err != nil {
-		return nil, err
-	}
-
-	return output, nil
+	return r
 }
 
 func (s *ServiceOp) UpdateControlPolicyMapping(ctx context.Context, input *ControlPolicyMapping) (*ControlPolicyMapping, error) {
 	r := client.NewRequest(http.MethodPut, "/controlPolicy/controlPolicyMapping")
+	r.Operation = "control_policy.UpdateControlPolicyMapping"
 	r.Obj = input
 
-	resp, err := client.RequireOK(s.Client.Do(ctx, r))
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	controlPolicyMapping, err := controlPolicyMappingsFromHttpResponse(resp)
-	if err != nil {
-		return nil, err
-	}
-
-	output := new(ControlPolicyMapping)
-
-	if len(controlPolicyMapping) > 0 {
-		output = controlPolicyMapping[0]
-	}
-
-	return output, nil
+	return client.DoOne[ControlPolicyMapping](ctx, s.Client, r)
 }
 
 func (s *ServiceOp) DeleteControlPolicyMapping(ctx context.Context, input *ControlPolicyMapping) (*commons.EmptyResponse, error) {
 	r := client.NewRequest(http.MethodDelete, "/controlPolicy/controlPolicyMapping")
+	r.Operation = "control_policy.DeleteControlPolicyMapping"
 	r.Obj = input
 
 	resp, err := client.RequireOK(s.Client.Do(ctx, r))
@@ -110,45 +85,6 @@ func (s *ServiceOp) DeleteControlPolicyMapping(ctx context.Context, input *Contr
 
 //endregion
 
-//region Private Methods
-
-func controlPolicyMappingFromJSON(in []byte) (*ControlPolicyMapping, error) {
-	b := new(ControlPolicyMapping)
-	if err := json.Unmarshal(in, b); err != nil {
-		return nil, err
-	}
-	return b, nil
-}
-
-func controlPolicyMappingsFromJSON(in []byte) ([]*ControlPolicyMapping, error) {
-	var rw client.Response
-	if err := json.Unmarshal(in, &rw); err != nil {
-		return nil, err
-	}
-	out := make([]*ControlPolicyMapping, len(rw.Response.Items))
-	if len(out) == 0 {
-		return out, nil
-	}
-	for i, rb := range rw.Response.Items {
-		b, err := controlPolicyMappingFromJSON(rb)
-		if err != nil {
-			return nil, err
-		}
-		out[i] = b
-	}
-	return out, nil
-}
-
-func controlPolicyMappingsFromHttpResponse(resp *http.Response) ([]*ControlPolicyMapping, error) {
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return nil, err
-	}
-	return controlPolicyMappingsFromJSON(body)
-}
-
-//endregion
-
 //region Setters
 
 func (o ControlPolicyMapping) MarshalJSON() ([]byte, error) {
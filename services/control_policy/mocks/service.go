@@ -0,0 +1,221 @@
+// Code generated by mockgen. DO NOT EDIT.
+
+// Package mocks provides a mock implementation of control_policy.Service.
+package mocks
+
+import (
+	"context"
+
+	"github.com/control-monkey/controlmonkey-sdk-go/controlmonkey/client"
+	"github.com/control-monkey/controlmonkey-sdk-go/controlmonkey/testing/mock"
+	"github.com/control-monkey/controlmonkey-sdk-go/services/commons"
+	"github.com/control-monkey/controlmonkey-sdk-go/services/control_policy"
+)
+
+// Service is a mock implementation of control_policy.Service. Every call is recorded, and
+// answered by the matching Func field if set, or with zero values and
+// mock.ErrNotStubbed otherwise. Use the On methods to stub canned responses.
+type Service struct {
+	mock.Recorder
+
+	CreateControlPolicyFunc                func(context.Context, *control_policy.ControlPolicy) (*control_policy.ControlPolicy, error)
+	ListControlPoliciesFunc                func(context.Context, *string, *string, *bool) ([]*control_policy.ControlPolicy, error)
+	ListControlPoliciesPaginatorFunc       func(*string, *string, *bool, *client.PageOptions) client.Pager[control_policy.ControlPolicy]
+	ReadControlPolicyFunc                  func(context.Context, string) (*control_policy.ControlPolicy, error)
+	UpdateControlPolicyFunc                func(context.Context, string, *control_policy.ControlPolicy) (*control_policy.ControlPolicy, error)
+	DeleteControlPolicyFunc                func(context.Context, string) (*commons.EmptyResponse, error)
+	CreateControlPolicyMappingFunc         func(context.Context, *control_policy.ControlPolicyMapping) (*control_policy.ControlPolicyMapping, error)
+	ListControlPolicyMappingsFunc          func(context.Context, string) ([]*control_policy.ControlPolicyMapping, error)
+	ListControlPolicyMappingsPaginatorFunc func(string, *client.PageOptions) client.Pager[control_policy.ControlPolicyMapping]
+	UpdateControlPolicyMappingFunc         func(context.Context, *control_policy.ControlPolicyMapping) (*control_policy.ControlPolicyMapping, error)
+	DeleteControlPolicyMappingFunc         func(context.Context, *control_policy.ControlPolicyMapping) (*commons.EmptyResponse, error)
+}
+
+var _ control_policy.Service = &Service{}
+
+// CreateControlPolicy records the call and calls CreateControlPolicyFunc.
+func (m *Service) CreateControlPolicy(ctx context.Context, a0 *control_policy.ControlPolicy) (*control_policy.ControlPolicy, error) {
+	m.Record("CreateControlPolicy", a0)
+	if m.CreateControlPolicyFunc == nil {
+		return nil, mock.ErrNotStubbed
+	}
+	return m.CreateControlPolicyFunc(ctx, a0)
+}
+
+// OnCreateControlPolicy stubs CreateControlPolicy to return the given values.
+func (m *Service) OnCreateControlPolicy(r0 *control_policy.ControlPolicy, r1 error) *Service {
+	m.CreateControlPolicyFunc = func(context.Context, *control_policy.ControlPolicy) (*control_policy.ControlPolicy, error) {
+		return r0, r1
+	}
+	return m
+}
+
+// ListControlPolicies records the call and calls ListControlPoliciesFunc.
+func (m *Service) ListControlPolicies(ctx context.Context, a0 *string, a1 *string, a2 *bool) ([]*control_policy.ControlPolicy, error) {
+	m.Record("ListControlPolicies", a0, a1, a2)
+	if m.ListControlPoliciesFunc == nil {
+		return nil, mock.ErrNotStubbed
+	}
+	return m.ListControlPoliciesFunc(ctx, a0, a1, a2)
+}
+
+// OnListControlPolicies stubs ListControlPolicies to return the given values.
+func (m *Service) OnListControlPolicies(r0 []*control_policy.ControlPolicy, r1 error) *Service {
+	m.ListControlPoliciesFunc = func(context.Context, *string, *string, *bool) ([]*control_policy.ControlPolicy, error) {
+		return r0, r1
+	}
+	return m
+}
+
+// ListControlPoliciesPaginator records the call and calls ListControlPoliciesPaginatorFunc.
+func (m *Service) ListControlPoliciesPaginator(a0 *string, a1 *string, a2 *bool, a3 *client.PageOptions) client.Pager[control_policy.ControlPolicy] {
+	m.Record("ListControlPoliciesPaginator", a0, a1, a2, a3)
+	if m.ListControlPoliciesPaginatorFunc == nil {
+		return client.NewSlicePager[control_policy.ControlPolicy](nil, mock.ErrNotStubbed)
+	}
+	return m.ListControlPoliciesPaginatorFunc(a0, a1, a2, a3)
+}
+
+// OnListControlPoliciesPaginator stubs ListControlPoliciesPaginator to return the given values.
+func (m *Service) OnListControlPoliciesPaginator(r0 client.Pager[control_policy.ControlPolicy]) *Service {
+	m.ListControlPoliciesPaginatorFunc = func(*string, *string, *bool, *client.PageOptions) client.Pager[control_policy.ControlPolicy] {
+		return r0
+	}
+	return m
+}
+
+// ReadControlPolicy records the call and calls ReadControlPolicyFunc.
+func (m *Service) ReadControlPolicy(ctx context.Context, a0 string) (*control_policy.ControlPolicy, error) {
+	m.Record("ReadControlPolicy", a0)
+	if m.ReadControlPolicyFunc == nil {
+		return nil, mock.ErrNotStubbed
+	}
+	return m.ReadControlPolicyFunc(ctx, a0)
+}
+
+// OnReadControlPolicy stubs ReadControlPolicy to return the given values.
+func (m *Service) OnReadControlPolicy(r0 *control_policy.ControlPolicy, r1 error) *Service {
+	m.ReadControlPolicyFunc = func(context.Context, string) (*control_policy.ControlPolicy, error) {
+		return r0, r1
+	}
+	return m
+}
+
+// UpdateControlPolicy records the call and calls UpdateControlPolicyFunc.
+func (m *Service) UpdateControlPolicy(ctx context.Context, a0 string, a1 *control_policy.ControlPolicy) (*control_policy.ControlPolicy, error) {
+	m.Record("UpdateControlPolicy", a0, a1)
+	if m.UpdateControlPolicyFunc == nil {
+		return nil, mock.ErrNotStubbed
+	}
+	return m.UpdateControlPolicyFunc(ctx, a0, a1)
+}
+
+// OnUpdateControlPolicy stubs UpdateControlPolicy to return the given values.
+func (m *Service) OnUpdateControlPolicy(r0 *control_policy.ControlPolicy, r1 error) *Service {
+	m.UpdateControlPolicyFunc = func(context.Context, string, *control_policy.ControlPolicy) (*control_policy.ControlPolicy, error) {
+		return r0, r1
+	}
+	return m
+}
+
+// DeleteControlPolicy records the call and calls DeleteControlPolicyFunc.
+func (m *Service) DeleteControlPolicy(ctx context.Context, a0 string) (*commons.EmptyResponse, error) {
+	m.Record("DeleteControlPolicy", a0)
+	if m.DeleteControlPolicyFunc == nil {
+		return nil, mock.ErrNotStubbed
+	}
+	return m.DeleteControlPolicyFunc(ctx, a0)
+}
+
+// OnDeleteControlPolicy stubs DeleteControlPolicy to return the given values.
+func (m *Service) OnDeleteControlPolicy(r0 *commons.EmptyResponse, r1 error) *Service {
+	m.DeleteControlPolicyFunc = func(context.Context, string) (*commons.EmptyResponse, error) {
+		return r0, r1
+	}
+	return m
+}
+
+// CreateControlPolicyMapping records the call and calls CreateControlPolicyMappingFunc.
+func (m *Service) CreateControlPolicyMapping(ctx context.Context, a0 *control_policy.ControlPolicyMapping) (*control_policy.ControlPolicyMapping, error) {
+	m.Record("CreateControlPolicyMapping", a0)
+	if m.CreateControlPolicyMappingFunc == nil {
+		return nil, mock.ErrNotStubbed
+	}
+	return m.CreateControlPolicyMappingFunc(ctx, a0)
+}
+
+// OnCreateControlPolicyMapping stubs CreateControlPolicyMapping to return the given values.
+func (m *Service) OnCreateControlPolicyMapping(r0 *control_policy.ControlPolicyMapping, r1 error) *Service {
+	m.CreateControlPolicyMappingFunc = func(context.Context, *control_policy.ControlPolicyMapping) (*control_policy.ControlPolicyMapping, error) {
+		return r0, r1
+	}
+	return m
+}
+
+// ListControlPolicyMappings records the call and calls ListControlPolicyMappingsFunc.
+func (m *Service) ListControlPolicyMappings(ctx context.Context, a0 string) ([]*control_policy.ControlPolicyMapping, error) {
+	m.Record("ListControlPolicyMappings", a0)
+	if m.ListControlPolicyMappingsFunc == nil {
+		return nil, mock.ErrNotStubbed
+	}
+	return m.ListControlPolicyMappingsFunc(ctx, a0)
+}
+
+// OnListControlPolicyMappings stubs ListControlPolicyMappings to return the given values.
+func (m *Service) OnListControlPolicyMappings(r0 []*control_policy.ControlPolicyMapping, r1 error) *Service {
+	m.ListControlPolicyMappingsFunc = func(context.Context, string) ([]*control_policy.ControlPolicyMapping, error) {
+		return r0, r1
+	}
+	return m
+}
+
+// ListControlPolicyMappingsPaginator records the call and calls ListControlPolicyMappingsPaginatorFunc.
+func (m *Service) ListControlPolicyMappingsPaginator(a0 string, a1 *client.PageOptions) client.Pager[control_policy.ControlPolicyMapping] {
+	m.Record("ListControlPolicyMappingsPaginator", a0, a1)
+	if m.ListControlPolicyMappingsPaginatorFunc == nil {
+		return client.NewSlicePager[control_policy.ControlPolicyMapping](nil, mock.ErrNotStubbed)
+	}
+	return m.ListControlPolicyMappingsPaginatorFunc(a0, a1)
+}
+
+// OnListControlPolicyMappingsPaginator stubs ListControlPolicyMappingsPaginator to return the given values.
+func (m *Service) OnListControlPolicyMappingsPaginator(r0 client.Pager[control_policy.ControlPolicyMapping]) *Service {
+	m.ListControlPolicyMappingsPaginatorFunc = func(string, *client.PageOptions) client.Pager[control_policy.ControlPolicyMapping] {
+		return r0
+	}
+	return m
+}
+
+// UpdateControlPolicyMapping records the call and calls UpdateControlPolicyMappingFunc.
+func (m *Service) UpdateControlPolicyMapping(ctx context.Context, a0 *control_policy.ControlPolicyMapping) (*control_policy.ControlPolicyMapping, error) {
+	m.Record("UpdateControlPolicyMapping", a0)
+	if m.UpdateControlPolicyMappingFunc == nil {
+		return nil, mock.ErrNotStubbed
+	}
+	return m.UpdateControlPolicyMappingFunc(ctx, a0)
+}
+
+// OnUpdateControlPolicyMapping stubs UpdateControlPolicyMapping to return the given values.
+func (m *Service) OnUpdateControlPolicyMapping(r0 *control_policy.ControlPolicyMapping, r1 error) *Service {
+	m.UpdateControlPolicyMappingFunc = func(context.Context, *control_policy.ControlPolicyMapping) (*control_policy.ControlPolicyMapping, error) {
+		return r0, r1
+	}
+	return m
+}
+
+// DeleteControlPolicyMapping records the call and calls DeleteControlPolicyMappingFunc.
+func (m *Service) DeleteControlPolicyMapping(ctx context.Context, a0 *control_policy.ControlPolicyMapping) (*commons.EmptyResponse, error) {
+	m.Record("DeleteControlPolicyMapping", a0)
+	if m.DeleteControlPolicyMappingFunc == nil {
+		return nil, mock.ErrNotStubbed
+	}
+	return m.DeleteControlPolicyMappingFunc(ctx, a0)
+}
+
+// OnDeleteControlPolicyMapping stubs DeleteControlPolicyMapping to return the given values.
+func (m *Service) OnDeleteControlPolicyMapping(r0 *commons.EmptyResponse, r1 error) *Service {
+	m.DeleteControlPolicyMappingFunc = func(context.Context, *control_policy.ControlPolicyMapping) (*commons.EmptyResponse, error) {
+		return r0, r1
+	}
+	return m
+}
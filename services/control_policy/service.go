@@ -9,18 +9,22 @@ import (
 	"github.com/control-monkey/controlmonkey-sdk-go/services/commons"
 )
 
+//go:generate go run ../../internal/mockgen
+
 // Service provides the API operation methods for making requests to endpoints
 // of the ControlMonkey API. See this package's package overview docs for details on
 // the service.
 type Service interface {
 	CreateControlPolicy(context.Context, *ControlPolicy) (*ControlPolicy, error)
 	ListControlPolicies(context.Context, *string, *string, *bool) ([]*ControlPolicy, error)
+	ListControlPoliciesPaginator(*string, *string, *bool, *client.PageOptions) client.Pager[ControlPolicy]
 	ReadControlPolicy(context.Context, string) (*ControlPolicy, error)
 	UpdateControlPolicy(context.Context, string, *ControlPolicy) (*ControlPolicy, error)
 	DeleteControlPolicy(context.Context, string) (*commons.EmptyResponse, error)
 
 	CreateControlPolicyMapping(context.Context, *ControlPolicyMapping) (*ControlPolicyMapping, error)
 	ListControlPolicyMappings(context.Context, string) ([]*ControlPolicyMapping, error)
+	ListControlPolicyMappingsPaginator(string, *client.PageOptions) client.Pager[ControlPolicyMapping]
 	UpdateControlPolicyMapping(context.Context, *ControlPolicyMapping) (*ControlPolicyMapping, error)
 	DeleteControlPolicyMapping(context.Context, *ControlPolicyMapping) (*commons.EmptyResponse, error)
 }
@@ -37,6 +41,6 @@ func New(sess *session.Session, cfgs ...*controlmonkey.Config) Service {
 	cfg.Merge(cfgs...)
 
 	return &ServiceOp{
-		Client: client.New(sess.Config),
+		Client: client.New(cfg),
 	}
 }
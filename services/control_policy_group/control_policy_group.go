@@ -2,8 +2,6 @@ package control_policy_group
 
 import (
 	"context"
-	"encoding/json"
-	"io"
 	"net/http"
 	"strconv"
 
@@ -42,29 +40,32 @@ type ControlPolicy struct {
 
 func (s *ServiceOp) CreateControlPolicyGroup(ctx context.Context, input *ControlPolicyGroup) (*ControlPolicyGroup, error) {
 	r := client.NewRequest(http.MethodPost, "/controlPolicyGroup")
+	r.Operation = "control_policy_group.CreateControlPolicyGroup"
 	r.Obj = input
 
-	resp, err := client.RequireOK(s.Client.Do(ctx, r))
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
+	return client.DoOne[ControlPolicyGroup](ctx, s.Client, r)
+}
+
+func (s *ServiceOp) ListControlPolicyGroups(ctx context.Context, controlPolicyGroupId *string, controlPolicyGroupName *string, includeManaged *bool) ([]*ControlPolicyGroup, error) {
+	r := listControlPolicyGroupsRequest(controlPolicyGroupId, controlPolicyGroupName, includeManaged)
 
-	controlPolicyGroup, err := controlPolicyGroupsFromHttpResponse(resp)
+	output, err := client.DoItems[ControlPolicyGroup](ctx, s.Client, r)
 	if err != nil {
 		return nil, err
 	}
 
-	output := new(ControlPolicyGroup)
-	if len(controlPolicyGroup) > 0 {
-		output = controlPolicyGroup[0]
-	}
-
 	return output, nil
 }
 
-func (s *ServiceOp) ListControlPolicyGroups(ctx context.Context, controlPolicyGroupId *string, controlPolicyGroupName *string, includeManaged *bool) ([]*ControlPolicyGroup, error) {
+func (s *ServiceOp) ListControlPolicyGroupsPaginator(controlPolicyGroupId *string, controlPolicyGroupName *string, includeManaged *bool, opts *client.PageOptions) client.Pager[ControlPolicyGroup] {
+	return client.NewPaginator[ControlPolicyGroup](s.Client, func() *client.Request {
+		return listControlPolicyGroupsRequest(controlPolicyGroupId, controlPolicyGroupName, includeManaged)
+	}, opts)
+}
+
+func listControlPolicyGroupsRequest(controlPolicyGroupId *string, controlPolicyGroupName *string, includeManaged *bool) *client.Request {
 	r := client.NewRequest(http.MethodGet, "/controlPolicyGroup")
+	r.Operation = "control_policy_group.ListControlPolicyGroups"
 
 	if controlPolicyGroupId != nil {
 		r.Params.Set("controlPolicyGroupId", *controlPolicyGroupId)
@@ -76,18 +77,7 @@ func (s *ServiceOp) ListControlPolicyGroups(ctx context.Context, controlPolicyGr
 		r.Params.Set("includeManaged", strconv.FormatBool(*includeManaged))
 	}
 
-	resp, err := client.RequireOK(s.Client.Do(ctx, r))
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	output, err := controlPolicyGroupsFromHttpResponse(resp)
-	if err != nil {
-		return nil, err
-	}
-
-	return output, nil
+	return r
 }
 func (s *ServiceOp) ReadControlPolicyGroup(ctx context.Context, controlPolicyGroupId string) (*ControlPolicyGroup, error) {
 	path, err := uritemplates.Expand("/controlPolicyGroup/{controlPolicyGroupId}", uritemplates.Values{"controlPolicyGroupId": controlPolicyGroupId})
@@ -96,23 +86,8 @@ func (s *ServiceOp) ReadControlPolicyGroup(ctx context.Context, controlPolicyGro
 	}
 
 	r := client.NewRequest(http.MethodGet, path)
-	resp, err := client.RequireOK(s.Client.Do(ctx, r))
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	controlPolicyGroup, err := controlPolicyGroupsFromHttpResponse(resp)
-	if err != nil {
-		return nil, err
-	}
-
-	output := new(ControlPolicyGroup)
-	if len(controlPolicyGroup) > 0 {
-		output = controlPolicyGroup[0]
-	}
-
-	return output, nil
+	r.Operation = "control_policy_group.ReadControlPolicyGroup"
+	return client.DoOne[ControlPolicyGroup](ctx, s.Client, r)
 }
 
 func (s *ServiceOp) UpdateControlPolicyGroup(ctx context.Context, id string, input *ControlPolicyGroup) (*ControlPolicyGroup, error) {
@@ -122,26 +97,10 @@ func (s *ServiceOp) UpdateControlPolicyGroup(ctx context.Context, id string, inp
 	}
 
 	r := client.NewRequest(http.MethodPut, path)
+	r.Operation = "control_policy_group.UpdateControlPolicyGroup"
 	r.Obj = input
 
-	resp, err := client.RequireOK(s.Client.Do(ctx, r))
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	controlPolicyGroup, err := controlPolicyGroupsFromHttpResponse(resp)
-	if err != nil {
-		return nil, err
-	}
-
-	output := new(ControlPolicyGroup)
-
-	if len(controlPolicyGroup) > 0 {
-		output = controlPolicyGroup[0]
-	}
-
-	return output, nil
+	return client.DoOne[ControlPolicyGroup](ctx, s.Client, r)
 }
 
 func (s *ServiceOp) DeleteControlPolicyGroup(ctx context.Context, id string) (*commons.EmptyResponse, error) {
@@ -151,6 +110,7 @@ func (s *ServiceOp) DeleteControlPolicyGroup(ctx context.Context, id string) (*c
 	}
 
 	r := client.NewRequest(http.MethodDelete, path)
+	r.Operation = "control_policy_group.DeleteControlPolicyGroup"
 
 	resp, err := client.RequireOK(s.Client.Do(ctx, r))
 	if err != nil {
@@ -164,45 +124,6 @@ func (s *ServiceOp) DeleteControlPolicyGroup(ctx context.Context, id string) (*c
 
 //endregion
 
-//region Private Methods
-
-func controlPolicyGroupFromJSON(in []byte) (*ControlPolicyGroup, error) {
-	b := new(ControlPolicyGroup)
-	if err := json.Unmarshal(in, b); err != nil {
-		return nil, err
-	}
-	return b, nil
-}
-
-func controlPolicyGroupsFromJSON(in []byte) ([]*ControlPolicyGroup, error) {
-	var rw client.Response
-	if err := json.Unmarshal(in, &rw); err != nil {
-		return nil, err
-	}
-	out := make([]*ControlPolicyGroup, len(rw.Response.Items))
-	if len(out) == 0 {
-		return out, nil
-	}
-	for i, rb := range rw.Response.Items {
-		b, err := controlPolicyGroupFromJSON(rb)
-		if err != nil {
-			return nil, err
-		}
-		out[i] = b
-	}
-	return out, nil
-}
-
-func controlPolicyGroupsFromHttpResponse(resp *http.Response) ([]*ControlPolicyGroup, error) {
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return nil, err
-	}
-	return controlPolicyGroupsFromJSON(body)
-}
-
-//endregion
-
 //region Setters
 
 func (o ControlPolicyGroup) MarshalJSON() ([]byte, error) {
@@ -2,8 +2,6 @@ package control_policy_group
 
 import (
 	"context"
-	"encoding/json"
-	"io"
 	"net/http"
 
 	"github.com/control-monkey/controlmonkey-sdk-go/controlmonkey/client"
@@ -41,71 +39,48 @@ type OverrideEnforcement struct {
 
 func (s *ServiceOp) CreateControlPolicyGroupMapping(ctx context.Context, input *ControlPolicyGroupMapping) (*ControlPolicyGroupMapping, error) {
 	r := client.NewRequest(http.MethodPost, "/controlPolicyGroup/controlPolicyGroupMapping")
+	r.Operation = "control_policy_group.CreateControlPolicyGroupMapping"
 	r.Obj = input
 
-	resp, err := client.RequireOK(s.Client.Do(ctx, r))
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
+	return client.DoOne[ControlPolicyGroupMapping](ctx, s.Client, r)
+}
+
+func (s *ServiceOp) ListControlPolicyGroupMappings(ctx context.Context, controlPolicyGroupId string) ([]*ControlPolicyGroupMapping, error) {
+	r := listControlPolicyGroupMappingsRequest(controlPolicyGroupId)
 
-	controlPolicyGroupMapping, err := controlPolicyGroupMappingsFromHttpResponse(resp)
+	output, err := client.DoItems[ControlPolicyGroupMapping](ctx, s.Client, r)
 	if err != nil {
 		return nil, err
 	}
 
-	output := new(ControlPolicyGroupMapping)
-	if len(controlPolicyGroupMapping) > 0 {
-		output = controlPolicyGroupMapping[0]
-	}
-
 	return output, nil
 }
 
-func (s *ServiceOp) ListControlPolicyGroupMappings(ctx context.Context, controlPolicyGroupId string) ([]*ControlPolicyGroupMapping, error) {
+func (s *ServiceOp) ListControlPolicyGroupMappingsPaginator(controlPolicyGroupId string, opts *client.PageOptions) client.Pager[ControlPolicyGroupMapping] {
+	return client.NewPaginator[ControlPolicyGroupMapping](s.Client, func() *client.Request {
+		return listControlPolicyGroupMappingsRequest(controlPolicyGroupId)
+	}, opts)
+}
+
+func listControlPolicyGroupMappingsRequest(controlPolicyGroupId string) *client.Request {
 	r := client.NewRequest(http.MethodGet, "/controlPolicyGroup/controlPolicyGroupMapping")
+	r.Operation = "control_policy_group.ListControlPolicyGroupMappings"
 	r.Params.Set("controlPolicyGroupId", controlPolicyGroupId)
 
-	resp, err := client.RequireOK(s.Client.Do(ctx, r))
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	output, err := controlPolicyGroupMappingsFromHttpResponse(resp)
-	if err != nil {
-		return nil, err
-	}
-
-	return output, nil
+	return r
 }
 
 func (s *ServiceOp) UpdateControlPolicyGroupMapping(ctx context.Context, input *ControlPolicyGroupMapping) (*ControlPolicyGroupMapping, error) {
 	r := client.NewRequest(http.MethodPut, "/controlPolicyGroup/controlPolicyGroupMapping")
+	r.Operation = "control_policy_group.UpdateControlPolicyGroupMapping"
 	r.Obj = input
 
-	resp, err := client.RequireOK(s.Client.Do(ctx, r))
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	controlPolicyGroupMapping, err := controlPolicyGroupMappingsFromHttpResponse(resp)
-	if err != nil {
-		return nil, err
-	}
-
-	output := new(ControlPolicyGroupMapping)
-
-	if len(controlPolicyGroupMapping) > 0 {
-		output = controlPolicyGroupMapping[0]
-	}
-
-	return output, nil
+	return client.DoOne[ControlPolicyGroupMapping](ctx, s.Client, r)
 }
 
 func (s *ServiceOp) DeleteControlPolicyGroupMapping(ctx context.Context, input *ControlPolicyGroupMapping) (*commons.EmptyResponse, error) {
 	r := client.NewRequest(http.MethodDelete, "/controlPolicyGroup/controlPolicyGroupMapping")
+	r.Operation = "control_policy_group.DeleteControlPolicyGroupMapping"
 	r.Obj = input
 
 	resp, err := client.RequireOK(s.Client.Do(ctx, r))
@@ -120,45 +95,6 @@ func (s *ServiceOp) DeleteControlPolicyGroupMapping(ctx context.Context, input *
 
 //endregion
 
-//region Private Methods
-
-func controlPolicyGroupMappingFromJSON(in []byte) (*ControlPolicyGroupMapping, error) {
-	b := new(ControlPolicyGroupMapping)
-	if err := json.Unmarshal(in, b); err != nil {
-		return nil, err
-	}
-	return b, nil
-}
-
-func controlPolicyGroupMappingsFromJSON(in []byte) ([]*ControlPolicyGroupMapping, error) {
-	var rw client.Response
-	if err := json.Unmarshal(in, &rw); err != nil {
-		return nil, err
-	}
-	out := make([]*ControlPolicyGroupMapping, len(rw.Response.Items))
-	if len(out) == 0 {
-		return out, nil
-	}
-	for i, rb := range rw.Response.Items {
-		b, err := controlPolicyGroupMappingFromJSON(rb)
-		if err != nil {
-			return nil, err
-		}
-		out[i] = b
-	}
-	return out, nil
-}
-
-func controlPolicyGroupMappingsFromHttpResponse(resp *http.Response) ([]*ControlPolicyGroupMapping, error) {
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return nil, err
-	}
-	return controlPolicyGroupMappingsFromJSON(body)
-}
-
-//endregion
-
 //region Setters
 
 func (o ControlPolicyGroupMapping) MarshalJSON() ([]byte, error) {
@@ -0,0 +1,221 @@
+// Code generated by mockgen. DO NOT EDIT.
+
+// Package mocks provides a mock implementation of control_policy_group.Service.
+package mocks
+
+import (
+	"context"
+
+	"github.com/control-monkey/controlmonkey-sdk-go/controlmonkey/client"
+	"github.com/control-monkey/controlmonkey-sdk-go/controlmonkey/testing/mock"
+	"github.com/control-monkey/controlmonkey-sdk-go/services/commons"
+	"github.com/control-monkey/controlmonkey-sdk-go/services/control_policy_group"
+)
+
+// Service is a mock implementation of control_policy_group.Service. Every call is recorded, and
+// answered by the matching Func field if set, or with zero values and
+// mock.ErrNotStubbed otherwise. Use the On methods to stub canned responses.
+type Service struct {
+	mock.Recorder
+
+	CreateControlPolicyGroupFunc                func(context.Context, *control_policy_group.ControlPolicyGroup) (*control_policy_group.ControlPolicyGroup, error)
+	ListControlPolicyGroupsFunc                 func(context.Context, *string, *string, *bool) ([]*control_policy_group.ControlPolicyGroup, error)
+	ListControlPolicyGroupsPaginatorFunc        func(*string, *string, *bool, *client.PageOptions) client.Pager[control_policy_group.ControlPolicyGroup]
+	ReadControlPolicyGroupFunc                  func(context.Context, string) (*control_policy_group.ControlPolicyGroup, error)
+	UpdateControlPolicyGroupFunc                func(context.Context, string, *control_policy_group.ControlPolicyGroup) (*control_policy_group.ControlPolicyGroup, error)
+	DeleteControlPolicyGroupFunc                func(context.Context, string) (*commons.EmptyResponse, error)
+	CreateControlPolicyGroupMappingFunc         func(context.Context, *control_policy_group.ControlPolicyGroupMapping) (*control_policy_group.ControlPolicyGroupMapping, error)
+	ListControlPolicyGroupMappingsFunc          func(context.Context, string) ([]*control_policy_group.ControlPolicyGroupMapping, error)
+	ListControlPolicyGroupMappingsPaginatorFunc func(string, *client.PageOptions) client.Pager[control_policy_group.ControlPolicyGroupMapping]
+	UpdateControlPolicyGroupMappingFunc         func(context.Context, *control_policy_group.ControlPolicyGroupMapping) (*control_policy_group.ControlPolicyGroupMapping, error)
+	DeleteControlPolicyGroupMappingFunc         func(context.Context, *control_policy_group.ControlPolicyGroupMapping) (*commons.EmptyResponse, error)
+}
+
+var _ control_policy_group.Service = &Service{}
+
+// CreateControlPolicyGroup records the call and calls CreateControlPolicyGroupFunc.
+func (m *Service) CreateControlPolicyGroup(ctx context.Context, a0 *control_policy_group.ControlPolicyGroup) (*control_policy_group.ControlPolicyGroup, error) {
+	m.Record("CreateControlPolicyGroup", a0)
+	if m.CreateControlPolicyGroupFunc == nil {
+		return nil, mock.ErrNotStubbed
+	}
+	return m.CreateControlPolicyGroupFunc(ctx, a0)
+}
+
+// OnCreateControlPolicyGroup stubs CreateControlPolicyGroup to return the given values.
+func (m *Service) OnCreateControlPolicyGroup(r0 *control_policy_group.ControlPolicyGroup, r1 error) *Service {
+	m.CreateControlPolicyGroupFunc = func(context.Context, *control_policy_group.ControlPolicyGroup) (*control_policy_group.ControlPolicyGroup, error) {
+		return r0, r1
+	}
+	return m
+}
+
+// ListControlPolicyGroups records the call and calls ListControlPolicyGroupsFunc.
+func (m *Service) ListControlPolicyGroups(ctx context.Context, a0 *string, a1 *string, a2 *bool) ([]*control_policy_group.ControlPolicyGroup, error) {
+	m.Record("ListControlPolicyGroups", a0, a1, a2)
+	if m.ListControlPolicyGroupsFunc == nil {
+		return nil, mock.ErrNotStubbed
+	}
+	return m.ListControlPolicyGroupsFunc(ctx, a0, a1, a2)
+}
+
+// OnListControlPolicyGroups stubs ListControlPolicyGroups to return the given values.
+func (m *Service) OnListControlPolicyGroups(r0 []*control_policy_group.ControlPolicyGroup, r1 error) *Service {
+	m.ListControlPolicyGroupsFunc = func(context.Context, *string, *string, *bool) ([]*control_policy_group.ControlPolicyGroup, error) {
+		return r0, r1
+	}
+	return m
+}
+
+// ListControlPolicyGroupsPaginator records the call and calls ListControlPolicyGroupsPaginatorFunc.
+func (m *Service) ListControlPolicyGroupsPaginator(a0 *string, a1 *string, a2 *bool, a3 *client.PageOptions) client.Pager[control_policy_group.ControlPolicyGroup] {
+	m.Record("ListControlPolicyGroupsPaginator", a0, a1, a2, a3)
+	if m.ListControlPolicyGroupsPaginatorFunc == nil {
+		return client.NewSlicePager[control_policy_group.ControlPolicyGroup](nil, mock.ErrNotStubbed)
+	}
+	return m.ListControlPolicyGroupsPaginatorFunc(a0, a1, a2, a3)
+}
+
+// OnListControlPolicyGroupsPaginator stubs ListControlPolicyGroupsPaginator to return the given values.
+func (m *Service) OnListControlPolicyGroupsPaginator(r0 client.Pager[control_policy_group.ControlPolicyGroup]) *Service {
+	m.ListControlPolicyGroupsPaginatorFunc = func(*string, *string, *bool, *client.PageOptions) client.Pager[control_policy_group.ControlPolicyGroup] {
+		return r0
+	}
+	return m
+}
+
+// ReadControlPolicyGroup records the call and calls ReadControlPolicyGroupFunc.
+func (m *Service) ReadControlPolicyGroup(ctx context.Context, a0 string) (*control_policy_group.ControlPolicyGroup, error) {
+	m.Record("ReadControlPolicyGroup", a0)
+	if m.ReadControlPolicyGroupFunc == nil {
+		return nil, mock.ErrNotStubbed
+	}
+	return m.ReadControlPolicyGroupFunc(ctx, a0)
+}
+
+// OnReadControlPolicyGroup stubs ReadControlPolicyGroup to return the given values.
+func (m *Service) OnReadControlPolicyGroup(r0 *control_policy_group.ControlPolicyGroup, r1 error) *Service {
+	m.ReadControlPolicyGroupFunc = func(context.Context, string) (*control_policy_group.ControlPolicyGroup, error) {
+		return r0, r1
+	}
+	return m
+}
+
+// UpdateControlPolicyGroup records the call and calls UpdateControlPolicyGroupFunc.
+func (m *Service) UpdateControlPolicyGroup(ctx context.Context, a0 string, a1 *control_policy_group.ControlPolicyGroup) (*control_policy_group.ControlPolicyGroup, error) {
+	m.Record("UpdateControlPolicyGroup", a0, a1)
+	if m.UpdateControlPolicyGroupFunc == nil {
+		return nil, mock.ErrNotStubbed
+	}
+	return m.UpdateControlPolicyGroupFunc(ctx, a0, a1)
+}
+
+// OnUpdateControlPolicyGroup stubs UpdateControlPolicyGroup to return the given values.
+func (m *Service) OnUpdateControlPolicyGroup(r0 *control_policy_group.ControlPolicyGroup, r1 error) *Service {
+	m.UpdateControlPolicyGroupFunc = func(context.Context, string, *control_policy_group.ControlPolicyGroup) (*control_policy_group.ControlPolicyGroup, error) {
+		return r0, r1
+	}
+	return m
+}
+
+// DeleteControlPolicyGroup records the call and calls DeleteControlPolicyGroupFunc.
+func (m *Service) DeleteControlPolicyGroup(ctx context.Context, a0 string) (*commons.EmptyResponse, error) {
+	m.Record("DeleteControlPolicyGroup", a0)
+	if m.DeleteControlPolicyGroupFunc == nil {
+		return nil, mock.ErrNotStubbed
+	}
+	return m.DeleteControlPolicyGroupFunc(ctx, a0)
+}
+
+// OnDeleteControlPolicyGroup stubs DeleteControlPolicyGroup to return the given values.
+func (m *Service) OnDeleteControlPolicyGroup(r0 *commons.EmptyResponse, r1 error) *Service {
+	m.DeleteControlPolicyGroupFunc = func(context.Context, string) (*commons.EmptyResponse, error) {
+		return r0, r1
+	}
+	return m
+}
+
+// CreateControlPolicyGroupMapping records the call and calls CreateControlPolicyGroupMappingFunc.
+func (m *Service) CreateControlPolicyGroupMapping(ctx context.Context, a0 *control_policy_group.ControlPolicyGroupMapping) (*control_policy_group.ControlPolicyGroupMapping, error) {
+	m.Record("CreateControlPolicyGroupMapping", a0)
+	if m.CreateControlPolicyGroupMappingFunc == nil {
+		return nil, mock.ErrNotStubbed
+	}
+	return m.CreateControlPolicyGroupMappingFunc(ctx, a0)
+}
+
+// OnCreateControlPolicyGroupMapping stubs CreateControlPolicyGroupMapping to return the given values.
+func (m *Service) OnCreateControlPolicyGroupMapping(r0 *control_policy_group.ControlPolicyGroupMapping, r1 error) *Service {
+	m.CreateControlPolicyGroupMappingFunc = func(context.Context, *control_policy_group.ControlPolicyGroupMapping) (*control_policy_group.ControlPolicyGroupMapping, error) {
+		return r0, r1
+	}
+	return m
+}
+
+// ListControlPolicyGroupMappings records the call and calls ListControlPolicyGroupMappingsFunc.
+func (m *Service) ListControlPolicyGroupMappings(ctx context.Context, a0 string) ([]*control_policy_group.ControlPolicyGroupMapping, error) {
+	m.Record("ListControlPolicyGroupMappings", a0)
+	if m.ListControlPolicyGroupMappingsFunc == nil {
+		return nil, mock.ErrNotStubbed
+	}
+	return m.ListControlPolicyGroupMappingsFunc(ctx, a0)
+}
+
+// OnListControlPolicyGroupMappings stubs ListControlPolicyGroupMappings to return the given values.
+func (m *Service) OnListControlPolicyGroupMappings(r0 []*control_policy_group.ControlPolicyGroupMapping, r1 error) *Service {
+	m.ListControlPolicyGroupMappingsFunc = func(context.Context, string) ([]*control_policy_group.ControlPolicyGroupMapping, error) {
+		return r0, r1
+	}
+	return m
+}
+
+// ListControlPolicyGroupMappingsPaginator records the call and calls ListControlPolicyGroupMappingsPaginatorFunc.
+func (m *Service) ListControlPolicyGroupMappingsPaginator(a0 string, a1 *client.PageOptions) client.Pager[control_policy_group.ControlPolicyGroupMapping] {
+	m.Record("ListControlPolicyGroupMappingsPaginator", a0, a1)
+	if m.ListControlPolicyGroupMappingsPaginatorFunc == nil {
+		return client.NewSlicePager[control_policy_group.ControlPolicyGroupMapping](nil, mock.ErrNotStubbed)
+	}
+	return m.ListControlPolicyGroupMappingsPaginatorFunc(a0, a1)
+}
+
+// OnListControlPolicyGroupMappingsPaginator stubs ListControlPolicyGroupMappingsPaginator to return the given values.
+func (m *Service) OnListControlPolicyGroupMappingsPaginator(r0 client.Pager[control_policy_group.ControlPolicyGroupMapping]) *Service {
+	m.ListControlPolicyGroupMappingsPaginatorFunc = func(string, *client.PageOptions) client.Pager[control_policy_group.ControlPolicyGroupMapping] {
+		return r0
+	}
+	return m
+}
+
+// UpdateControlPolicyGroupMapping records the call and calls UpdateControlPolicyGroupMappingFunc.
+func (m *Service) UpdateControlPolicyGroupMapping(ctx context.Context, a0 *control_policy_group.ControlPolicyGroupMapping) (*control_policy_group.ControlPolicyGroupMapping, error) {
+	m.Record("UpdateControlPolicyGroupMapping", a0)
+	if m.UpdateControlPolicyGroupMappingFunc == nil {
+		return nil, mock.ErrNotStubbed
+	}
+	return m.UpdateControlPolicyGroupMappingFunc(ctx, a0)
+}
+
+// OnUpdateControlPolicyGroupMapping stubs UpdateControlPolicyGroupMapping to return the given values.
+func (m *Service) OnUpdateControlPolicyGroupMapping(r0 *control_policy_group.ControlPolicyGroupMapping, r1 error) *Service {
+	m.UpdateControlPolicyGroupMappingFunc = func(context.Context, *control_policy_group.ControlPolicyGroupMapping) (*control_policy_group.ControlPolicyGroupMapping, error) {
+		return r0, r1
+	}
+	return m
+}
+
+// DeleteControlPolicyGroupMapping records the call and calls DeleteControlPolicyGroupMappingFunc.
+func (m *Service) DeleteControlPolicyGroupMapping(ctx context.Context, a0 *control_policy_group.ControlPolicyGroupMapping) (*commons.EmptyResponse, error) {
+	m.Record("DeleteControlPolicyGroupMapping", a0)
+	if m.DeleteControlPolicyGroupMappingFunc == nil {
+		return nil, mock.ErrNotStubbed
+	}
+	return m.DeleteControlPolicyGroupMappingFunc(ctx, a0)
+}
+
+// OnDeleteControlPolicyGroupMapping stubs DeleteControlPolicyGroupMapping to return the given values.
+func (m *Service) OnDeleteControlPolicyGroupMapping(r0 *commons.EmptyResponse, r1 error) *Service {
+	m.DeleteControlPolicyGroupMappingFunc = func(context.Context, *control_policy_group.ControlPolicyGroupMapping) (*commons.EmptyResponse, error) {
+		return r0, r1
+	}
+	return m
+}
@@ -9,18 +9,22 @@ import (
 	"github.com/control-monkey/controlmonkey-sdk-go/services/commons"
 )
 
+//go:generate go run ../../internal/mockgen
+
 // Service provides the API operation methods for making requests to endpoints
 // of the ControlMonkey API. See this package's package overview docs for details on
 // the service.
 type Service interface {
 	CreateControlPolicyGroup(context.Context, *ControlPolicyGroup) (*ControlPolicyGroup, error)
 	ListControlPolicyGroups(context.Context, *string, *string, *bool) ([]*ControlPolicyGroup, error)
+	ListControlPolicyGroupsPaginator(*string, *string, *bool, *client.PageOptions) client.Pager[ControlPolicyGroup]
 	ReadControlPolicyGroup(context.Context, string) (*ControlPolicyGroup, error)
 	UpdateControlPolicyGroup(context.Context, string, *ControlPolicyGroup) (*ControlPolicyGroup, error)
 	DeleteControlPolicyGroup(context.Context, string) (*commons.EmptyResponse, error)
 
 	CreateControlPolicyGroupMapping(context.Context, *ControlPolicyGroupMapping) (*ControlPolicyGroupMapping, error)
 	ListControlPolicyGroupMappings(context.Context, string) ([]*ControlPolicyGroupMapping, error)
+	ListControlPolicyGroupMappingsPaginator(string, *client.PageOptions) client.Pager[ControlPolicyGroupMapping]
 	UpdateControlPolicyGroupMapping(context.Context, *ControlPolicyGroupMapping) (*ControlPolicyGroupMapping, error)
 	DeleteControlPolicyGroupMapping(context.Context, *ControlPolicyGroupMapping) (*commons.EmptyResponse, error)
 }
@@ -37,6 +41,6 @@ func New(sess *session.Session, cfgs ...*controlmonkey.Config) Service {
 	cfg.Merge(cfgs...)
 
 	return &ServiceOp{
-		Client: client.New(sess.Config),
+		Client: client.New(cfg),
 	}
 }
@@ -2,8 +2,6 @@ package custom_abac_configuration
 
 import (
 	"context"
-	"encoding/json"
-	"io"
 	"net/http"
 
 	"github.com/control-monkey/controlmonkey-sdk-go/controlmonkey/util/uritemplates"
@@ -47,29 +45,32 @@ type Role struct {
 
 func (s *ServiceOp) CreateCustomAbacConfiguration(ctx context.Context, input *CustomAbacConfiguration) (*CustomAbacConfiguration, error) {
 	r := client.NewRequest(http.MethodPost, baseUrl+endpointUrl)
+	r.Operation = "custom_abac_configuration.CreateCustomAbacConfiguration"
 	r.Obj = input
 
-	resp, err := client.RequireOK(s.Client.Do(ctx, r))
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
+	return client.DoOne[CustomAbacConfiguration](ctx, s.Client, r)
+}
+
+func (s *ServiceOp) ListCustomAbacConfigurations(ctx context.Context, customAbacConfigurationId *string, customAbacConfigurationName *string) ([]*CustomAbacConfiguration, error) {
+	r := listCustomAbacConfigurationsRequest(customAbacConfigurationId, customAbacConfigurationName)
 
-	customAbacConfiguration, err := customAbacConfigurationsFromHttpResponse(resp)
+	outputs, err := client.DoItems[CustomAbacConfiguration](ctx, s.Client, r)
 	if err != nil {
 		return nil, err
 	}
 
-	output := new(CustomAbacConfiguration)
-	if len(customAbacConfiguration) > 0 {
-		output = customAbacConfiguration[0]
-	}
+	return outputs, nil
+}
 
-	return output, nil
+func (s *ServiceOp) ListCustomAbacConfigurationsPaginator(customAbacConfigurationId *string, customAbacConfigurationName *string, opts *client.PageOptions) client.Pager[CustomAbacConfiguration] {
+	return client.NewPaginator[CustomAbacConfiguration](s.Client, func() *client.Request {
+		return listCustomAbacConfigurationsRequest(customAbacConfigurationId, customAbacConfigurationName)
+	}, opts)
 }
 
-func (s *ServiceOp) ListCustomAbacConfigurations(ctx context.Context, customAbacConfigurationId *string, customAbacConfigurationName *string) ([]*CustomAbacConfiguration, error) {
+func listCustomAbacConfigurationsRequest(customAbacConfigurationId *string, customAbacConfigurationName *string) *client.Request {
 	r := client.NewRequest(http.MethodGet, baseUrl+endpointUrl)
+	r.Operation = "custom_abac_configuration.ListCustomAbacConfigurations"
 
 	if customAbacConfigurationId != nil {
 		r.Params.Set("customAbacConfigurationId", *customAbacConfigurationId)
@@ -78,18 +79,7 @@ func (s *ServiceOp) ListCustomAbacConfigurations(ctx context.Context, customAbac
 		r.Params.Set("customAbacConfigurationName", *customAbacConfigurationName)
 	}
 
-	resp, err := client.RequireOK(s.Client.Do(ctx, r))
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	outputs, err := customAbacConfigurationsFromHttpResponse(resp)
-	if err != nil {
-		return nil, err
-	}
-
-	return outputs, nil
+	return r
 }
 
 func (s *ServiceOp) ReadCustomAbacConfiguration(ctx context.Context, customAbacConfigurationId string) (*CustomAbacConfiguration, error) {
@@ -99,23 +89,8 @@ func (s *ServiceOp) ReadCustomAbacConfiguration(ctx context.Context, customAbacC
 	}
 
 	r := client.NewRequest(http.MethodGet, path)
-	resp, err := client.RequireOK(s.Client.Do(ctx, r))
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	customAbacConfiguration, err := customAbacConfigurationsFromHttpResponse(resp)
-	if err != nil {
-		return nil, err
-	}
-
-	output := new(CustomAbacConfiguration)
-	if len(customAbacConfiguration) > 0 {
-		output = customAbacConfiguration[0]
-	}
-
-	return output, nil
+	r.Operation = "custom_abac_configuration.ReadCustomAbacConfiguration"
+	return client.DoOne[CustomAbacConfiguration](ctx, s.Client, r)
 }
 
 func (s *ServiceOp) UpdateCustomAbacConfiguration(ctx context.Context, id string, input *CustomAbacConfiguration) (*CustomAbacConfiguration, error) {
@@ -125,26 +100,10 @@ func (s *ServiceOp) UpdateCustomAbacConfiguration(ctx context.Context, id string
 	}
 
 	r := client.NewRequest(http.MethodPut, path)
+	r.Operation = "custom_abac_configuration.UpdateCustomAbacConfiguration"
 	r.Obj = input
 
-	resp, err := client.RequireOK(s.Client.Do(ctx, r))
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	customAbacConfiguration, err := customAbacConfigurationsFromHttpResponse(resp)
-	if err != nil {
-		return nil, err
-	}
-
-	output := new(CustomAbacConfiguration)
-
-	if len(customAbacConfiguration) > 0 {
-		output = customAbacConfiguration[0]
-	}
-
-	return output, nil
+	return client.DoOne[CustomAbacConfiguration](ctx, s.Client, r)
 }
 
 func (s *ServiceOp) DeleteCustomAbacConfiguration(ctx context.Context, id string) (*commons.EmptyResponse, error) {
@@ -154,6 +113,7 @@ func (s *ServiceOp) DeleteCustomAbacConfiguration(ctx context.Context, id string
 	}
 
 	r := client.NewRequest(http.MethodDelete, path)
+	r.Operation = "custom_abac_configuration.DeleteCustomAbacConfiguration"
 
 	resp, err := client.RequireOK(s.Client.Do(ctx, r))
 	if err != nil {
@@ -167,45 +127,6 @@ func (s *ServiceOp) DeleteCustomAbacConfiguration(ctx context.Context, id string
 
 //endregion
 
-//region Private Methods
-
-func customAbacConfigurationFromJSON(in []byte) (*CustomAbacConfiguration, error) {
-	b := new(CustomAbacConfiguration)
-	if err := json.Unmarshal(in, b); err != nil {
-		return nil, err
-	}
-	return b, nil
-}
-
-func customAbacConfigurationsFromJSON(in []byte) ([]*CustomAbacConfiguration, error) {
-	var rw client.Response
-	if err := json.Unmarshal(in, &rw); err != nil {
-		return nil, err
-	}
-	out := make([]*CustomAbacConfiguration, len(rw.Response.Items))
-	if len(out) == 0 {
-		return out, nil
-	}
-	for i, rb := range rw.Response.Items {
-		b, err := customAbacConfigurationFromJSON(rb)
-		if err != nil {
-			return nil, err
-		}
-		out[i] = b
-	}
-	return out, nil
-}
-
-func customAbacConfigurationsFromHttpResponse(resp *http.Response) ([]*CustomAbacConfiguration, error) {
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return nil, err
-	}
-	return customAbacConfigurationsFromJSON(body)
-}
-
-//endregion
-
 //region Setters
 
 func (o CustomAbacConfiguration) MarshalJSON() ([]byte, error) {
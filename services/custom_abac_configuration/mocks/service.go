@@ -0,0 +1,131 @@
+// Code generated by mockgen. DO NOT EDIT.
+
+// Package mocks provides a mock implementation of custom_abac_configuration.Service.
+package mocks
+
+import (
+	"context"
+
+	"github.com/control-monkey/controlmonkey-sdk-go/controlmonkey/client"
+	"github.com/control-monkey/controlmonkey-sdk-go/controlmonkey/testing/mock"
+	"github.com/control-monkey/controlmonkey-sdk-go/services/commons"
+	"github.com/control-monkey/controlmonkey-sdk-go/services/custom_abac_configuration"
+)
+
+// Service is a mock implementation of custom_abac_configuration.Service. Every call is recorded, and
+// answered by the matching Func field if set, or with zero values and
+// mock.ErrNotStubbed otherwise. Use the On methods to stub canned responses.
+type Service struct {
+	mock.Recorder
+
+	CreateCustomAbacConfigurationFunc         func(context.Context, *custom_abac_configuration.CustomAbacConfiguration) (*custom_abac_configuration.CustomAbacConfiguration, error)
+	ListCustomAbacConfigurationsFunc          func(context.Context, *string, *string) ([]*custom_abac_configuration.CustomAbacConfiguration, error)
+	ListCustomAbacConfigurationsPaginatorFunc func(*string, *string, *client.PageOptions) client.Pager[custom_abac_configuration.CustomAbacConfiguration]
+	ReadCustomAbacConfigurationFunc           func(context.Context, string) (*custom_abac_configuration.CustomAbacConfiguration, error)
+	UpdateCustomAbacConfigurationFunc         func(context.Context, string, *custom_abac_configuration.CustomAbacConfiguration) (*custom_abac_configuration.CustomAbacConfiguration, error)
+	DeleteCustomAbacConfigurationFunc         func(context.Context, string) (*commons.EmptyResponse, error)
+}
+
+var _ custom_abac_configuration.Service = &Service{}
+
+// CreateCustomAbacConfiguration records the call and calls CreateCustomAbacConfigurationFunc.
+func (m *Service) CreateCustomAbacConfiguration(ctx context.Context, a0 *custom_abac_configuration.CustomAbacConfiguration) (*custom_abac_configuration.CustomAbacConfiguration, error) {
+	m.Record("CreateCustomAbacConfiguration", a0)
+	if m.CreateCustomAbacConfigurationFunc == nil {
+		return nil, mock.ErrNotStubbed
+	}
+	return m.CreateCustomAbacConfigurationFunc(ctx, a0)
+}
+
+// OnCreateCustomAbacConfiguration stubs CreateCustomAbacConfiguration to return the given values.
+func (m *Service) OnCreateCustomAbacConfiguration(r0 *custom_abac_configuration.CustomAbacConfiguration, r1 error) *Service {
+	m.CreateCustomAbacConfigurationFunc = func(context.Context, *custom_abac_configuration.CustomAbacConfiguration) (*custom_abac_configuration.CustomAbacConfiguration, error) {
+		return r0, r1
+	}
+	return m
+}
+
+// ListCustomAbacConfigurations records the call and calls ListCustomAbacConfigurationsFunc.
+func (m *Service) ListCustomAbacConfigurations(ctx context.Context, a0 *string, a1 *string) ([]*custom_abac_configuration.CustomAbacConfiguration, error) {
+	m.Record("ListCustomAbacConfigurations", a0, a1)
+	if m.ListCustomAbacConfigurationsFunc == nil {
+		return nil, mock.ErrNotStubbed
+	}
+	return m.ListCustomAbacConfigurationsFunc(ctx, a0, a1)
+}
+
+// OnListCustomAbacConfigurations stubs ListCustomAbacConfigurations to return the given values.
+func (m *Service) OnListCustomAbacConfigurations(r0 []*custom_abac_configuration.CustomAbacConfiguration, r1 error) *Service {
+	m.ListCustomAbacConfigurationsFunc = func(context.Context, *string, *string) ([]*custom_abac_configuration.CustomAbacConfiguration, error) {
+		return r0, r1
+	}
+	return m
+}
+
+// ListCustomAbacConfigurationsPaginator records the call and calls ListCustomAbacConfigurationsPaginatorFunc.
+func (m *Service) ListCustomAbacConfigurationsPaginator(a0 *string, a1 *string, a2 *client.PageOptions) client.Pager[custom_abac_configuration.CustomAbacConfiguration] {
+	m.Record("ListCustomAbacConfigurationsPaginator", a0, a1, a2)
+	if m.ListCustomAbacConfigurationsPaginatorFunc == nil {
+		return client.NewSlicePager[custom_abac_configuration.CustomAbacConfiguration](nil, mock.ErrNotStubbed)
+	}
+	return m.ListCustomAbacConfigurationsPaginatorFunc(a0, a1, a2)
+}
+
+// OnListCustomAbacConfigurationsPaginator stubs ListCustomAbacConfigurationsPaginator to return the given values.
+func (m *Service) OnListCustomAbacConfigurationsPaginator(r0 client.Pager[custom_abac_configuration.CustomAbacConfiguration]) *Service {
+	m.ListCustomAbacConfigurationsPaginatorFunc = func(*string, *string, *client.PageOptions) client.Pager[custom_abac_configuration.CustomAbacConfiguration] {
+		return r0
+	}
+	return m
+}
+
+// ReadCustomAbacConfiguration records the call and calls ReadCustomAbacConfigurationFunc.
+func (m *Service) ReadCustomAbacConfiguration(ctx context.Context, a0 string) (*custom_abac_configuration.CustomAbacConfiguration, error) {
+	m.Record("ReadCustomAbacConfiguration", a0)
+	if m.ReadCustomAbacConfigurationFunc == nil {
+		return nil, mock.ErrNotStubbed
+	}
+	return m.ReadCustomAbacConfigurationFunc(ctx, a0)
+}
+
+// OnReadCustomAbacConfiguration stubs ReadCustomAbacConfiguration to return the given values.
+func (m *Service) OnReadCustomAbacConfiguration(r0 *custom_abac_configuration.CustomAbacConfiguration, r1 error) *Service {
+	m.ReadCustomAbacConfigurationFunc = func(context.Context, string) (*custom_abac_configuration.CustomAbacConfiguration, error) {
+		return r0, r1
+	}
+	return m
+}
+
+// UpdateCustomAbacConfiguration records the call and calls UpdateCustomAbacConfigurationFunc.
+func (m *Service) UpdateCustomAbacConfiguration(ctx context.Context, a0 string, a1 *custom_abac_configuration.CustomAbacConfiguration) (*custom_abac_configuration.CustomAbacConfiguration, error) {
+	m.Record("UpdateCustomAbacConfiguration", a0, a1)
+	if m.UpdateCustomAbacConfigurationFunc == nil {
+		return nil, mock.ErrNotStubbed
+	}
+	return m.UpdateCustomAbacConfigurationFunc(ctx, a0, a1)
+}
+
+// OnUpdateCustomAbacConfiguration stubs UpdateCustomAbacConfiguration to return the given values.
+func (m *Service) OnUpdateCustomAbacConfiguration(r0 *custom_abac_configuration.CustomAbacConfiguration, r1 error) *Service {
+	m.UpdateCustomAbacConfigurationFunc = func(context.Context, string, *custom_abac_configuration.CustomAbacConfiguration) (*custom_abac_configuration.CustomAbacConfiguration, error) {
+		return r0, r1
+	}
+	return m
+}
+
+// DeleteCustomAbacConfiguration records the call and calls DeleteCustomAbacConfigurationFunc.
+func (m *Service) DeleteCustomAbacConfiguration(ctx context.Context, a0 string) (*commons.EmptyResponse, error) {
+	m.Record("DeleteCustomAbacConfiguration", a0)
+	if m.DeleteCustomAbacConfigurationFunc == nil {
+		return nil, mock.ErrNotStubbed
+	}
+	return m.DeleteCustomAbacConfigurationFunc(ctx, a0)
+}
+
+// OnDeleteCustomAbacConfiguration stubs DeleteCustomAbacConfiguration to return the given values.
+func (m *Service) OnDeleteCustomAbacConfiguration(r0 *commons.EmptyResponse, r1 error) *Service {
+	m.DeleteCustomAbacConfigurationFunc = func(context.Context, string) (*commons.EmptyResponse, error) {
+		return r0, r1
+	}
+	return m
+}
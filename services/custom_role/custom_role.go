@@ -2,8 +2,6 @@ package custom_role
 
 import (
 	"context"
-	"encoding/json"
-	"io"
 	"net/http"
 
 	"github.com/control-monkey/controlmonkey-sdk-go/controlmonkey/util/uritemplates"
@@ -46,29 +44,32 @@ type Permission struct {
 
 func (s *ServiceOp) CreateCustomRole(ctx context.Context, input *CustomRole) (*CustomRole, error) {
 	r := client.NewRequest(http.MethodPost, baseUrl+endpointUrl)
+	r.Operation = "custom_role.CreateCustomRole"
 	r.Obj = input
 
-	resp, err := client.RequireOK(s.Client.Do(ctx, r))
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
+	return client.DoOne[CustomRole](ctx, s.Client, r)
+}
+
+func (s *ServiceOp) ListCustomRoles(ctx context.Context, customRoleId *string, customRoleName *string) ([]*CustomRole, error) {
+	r := listCustomRolesRequest(customRoleId, customRoleName)
 
-	customRole, err := customRolesFromHttpResponse(resp)
+	outputs, err := client.DoItems[CustomRole](ctx, s.Client, r)
 	if err != nil {
 		return nil, err
 	}
 
-	output := new(CustomRole)
-	if len(customRole) > 0 {
-		output = customRole[0]
-	}
+	return outputs, nil
+}
 
-	return output, nil
+func (s *ServiceOp) ListCustomRolesPaginator(customRoleId *string, customRoleName *string, opts *client.PageOptions) client.Pager[CustomRole] {
+	return client.NewPaginator[CustomRole](s.Client, func() *client.Request {
+		return listCustomRolesRequest(customRoleId, customRoleName)
+	}, opts)
 }
 
-func (s *ServiceOp) ListCustomRoles(ctx context.Context, customRoleId *string, customRoleName *string) ([]*CustomRole, error) {
+func listCustomRolesRequest(customRoleId *string, customRoleName *string) *client.Request {
 	r := client.NewRequest(http.MethodGet, baseUrl+endpointUrl)
+	r.Operation = "custom_role.ListCustomRoles"
 
 	if customRoleId != nil {
 		r.Params.Set("customRoleId", *customRoleId)
@@ -77,18 +78,7 @@ func (s *ServiceOp) ListCustomRoles(ctx context.Context, customRoleId *string, c
 		r.Params.Set("customRoleName", *customRoleName)
 	}
 
-	resp, err := client.RequireOK(s.Client.Do(ctx, r))
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	outputs, err := customRolesFromHttpResponse(resp)
-	if err != nil {
-		return nil, err
-	}
-
-	return outputs, nil
+	return r
 }
 
 func (s *ServiceOp) ReadCustomRole(ctx context.Context, customRoleId string) (*CustomRole, error) {
@@ -98,23 +88,8 @@ func (s *ServiceOp) ReadCustomRole(ctx context.Context, customRoleId string) (*C
 	}
 
 	r := client.NewRequest(http.MethodGet, path)
-	resp, err := client.RequireOK(s.Client.Do(ctx, r))
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	customRole, err := customRolesFromHttpResponse(resp)
-	if err != nil {
-		return nil, err
-	}
-
-	output := new(CustomRole)
-	if len(customRole) > 0 {
-		output = customRole[0]
-	}
-
-	return output, nil
+	r.Operation = "custom_role.ReadCustomRole"
+	return client.DoOne[CustomRole](ctx, s.Client, r)
 }
 
 func (s *ServiceOp) UpdateCustomRole(ctx context.Context, id string, input *CustomRole) (*CustomRole, error) {
@@ -124,26 +99,10 @@ func (s *ServiceOp) UpdateCustomRole(ctx context.Context, id string, input *Cust
 	}
 
 	r := client.NewRequest(http.MethodPut, path)
+	r.Operation = "custom_role.UpdateCustomRole"
 	r.Obj = input
 
-	resp, err := client.RequireOK(s.Client.Do(ctx, r))
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	customRole, err := customRolesFromHttpResponse(resp)
-	if err != nil {
-		return nil, err
-	}
-
-	output := new(CustomRole)
-
-	if len(customRole) > 0 {
-		output = customRole[0]
-	}
-
-	return output, nil
+	return client.DoOne[CustomRole](ctx, s.Client, r)
 }
 
 func (s *ServiceOp) DeleteCustomRole(ctx context.Context, id string) (*commons.EmptyResponse, error) {
@@ -153,6 +112,7 @@ func (s *ServiceOp) DeleteCustomRole(ctx context.Context, id string) (*commons.E
 	}
 
 	r := client.NewRequest(http.MethodDelete, path)
+	r.Operation = "custom_role.DeleteCustomRole"
 
 	resp, err := client.RequireOK(s.Client.Do(ctx, r))
 	if err != nil {
@@ -166,45 +126,6 @@ func (s *ServiceOp) DeleteCustomRole(ctx context.Context, id string) (*commons.E
 
 //endregion
 
-//region Private Methods
-
-func customRoleFromJSON(in []byte) (*CustomRole, error) {
-	b := new(CustomRole)
-	if err := json.Unmarshal(in, b); err != nil {
-		return nil, err
-	}
-	return b, nil
-}
-
-func customRolesFromJSON(in []byte) ([]*CustomRole, error) {
-	var rw client.Response
-	if err := json.Unmarshal(in, &rw); err != nil {
-		return nil, err
-	}
-	out := make([]*CustomRole, len(rw.Response.Items))
-	if len(out) == 0 {
-		return out, nil
-	}
-	for i, rb := range rw.Response.Items {
-		b, err := customRoleFromJSON(rb)
-		if err != nil {
-			return nil, err
-		}
-		out[i] = b
-	}
-	return out, nil
-}
-
-func customRolesFromHttpResponse(resp *http.Response) ([]*CustomRole, error) {
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return nil, err
-	}
-	return customRolesFromJSON(body)
-}
-
-//endregion
-
 //region Setters
 
 func (o CustomRole) MarshalJSON() ([]byte, error) {
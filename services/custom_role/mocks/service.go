@@ -0,0 +1,131 @@
+// Code generated by mockgen. DO NOT EDIT.
+
+// Package mocks provides a mock implementation of custom_role.Service.
+package mocks
+
+import (
+	"context"
+
+	"github.com/control-monkey/controlmonkey-sdk-go/controlmonkey/client"
+	"github.com/control-monkey/controlmonkey-sdk-go/controlmonkey/testing/mock"
+	"github.com/control-monkey/controlmonkey-sdk-go/services/commons"
+	"github.com/control-monkey/controlmonkey-sdk-go/services/custom_role"
+)
+
+// Service is a mock implementation of custom_role.Service. Every call is recorded, and
+// answered by the matching Func field if set, or with zero values and
+// mock.ErrNotStubbed otherwise. Use the On methods to stub canned responses.
+type Service struct {
+	mock.Recorder
+
+	CreateCustomRoleFunc         func(context.Context, *custom_role.CustomRole) (*custom_role.CustomRole, error)
+	ListCustomRolesFunc          func(context.Context, *string, *string) ([]*custom_role.CustomRole, error)
+	ListCustomRolesPaginatorFunc func(*string, *string, *client.PageOptions) client.Pager[custom_role.CustomRole]
+	ReadCustomRoleFunc           func(context.Context, string) (*custom_role.CustomRole, error)
+	UpdateCustomRoleFunc         func(context.Context, string, *custom_role.CustomRole) (*custom_role.CustomRole, error)
+	DeleteCustomRoleFunc         func(context.Context, string) (*commons.EmptyResponse, error)
+}
+
+var _ custom_role.Service = &Service{}
+
+// CreateCustomRole records the call and calls CreateCustomRoleFunc.
+func (m *Service) CreateCustomRole(ctx context.Context, a0 *custom_role.CustomRole) (*custom_role.CustomRole, error) {
+	m.Record("CreateCustomRole", a0)
+	if m.CreateCustomRoleFunc == nil {
+		return nil, mock.ErrNotStubbed
+	}
+	return m.CreateCustomRoleFunc(ctx, a0)
+}
+
+// OnCreateCustomRole stubs CreateCustomRole to return the given values.
+func (m *Service) OnCreateCustomRole(r0 *custom_role.CustomRole, r1 error) *Service {
+	m.CreateCustomRoleFunc = func(context.Context, *custom_role.CustomRole) (*custom_role.CustomRole, error) {
+		return r0, r1
+	}
+	return m
+}
+
+// ListCustomRoles records the call and calls ListCustomRolesFunc.
+func (m *Service) ListCustomRoles(ctx context.Context, a0 *string, a1 *string) ([]*custom_role.CustomRole, error) {
+	m.Record("ListCustomRoles", a0, a1)
+	if m.ListCustomRolesFunc == nil {
+		return nil, mock.ErrNotStubbed
+	}
+	return m.ListCustomRolesFunc(ctx, a0, a1)
+}
+
+// OnListCustomRoles stubs ListCustomRoles to return the given values.
+func (m *Service) OnListCustomRoles(r0 []*custom_role.CustomRole, r1 error) *Service {
+	m.ListCustomRolesFunc = func(context.Context, *string, *string) ([]*custom_role.CustomRole, error) {
+		return r0, r1
+	}
+	return m
+}
+
+// ListCustomRolesPaginator records the call and calls ListCustomRolesPaginatorFunc.
+func (m *Service) ListCustomRolesPaginator(a0 *string, a1 *string, a2 *client.PageOptions) client.Pager[custom_role.CustomRole] {
+	m.Record("ListCustomRolesPaginator", a0, a1, a2)
+	if m.ListCustomRolesPaginatorFunc == nil {
+		return client.NewSlicePager[custom_role.CustomRole](nil, mock.ErrNotStubbed)
+	}
+	return m.ListCustomRolesPaginatorFunc(a0, a1, a2)
+}
+
+// OnListCustomRolesPaginator stubs ListCustomRolesPaginator to return the given values.
+func (m *Service) OnListCustomRolesPaginator(r0 client.Pager[custom_role.CustomRole]) *Service {
+	m.ListCustomRolesPaginatorFunc = func(*string, *string, *client.PageOptions) client.Pager[custom_role.CustomRole] {
+		return r0
+	}
+	return m
+}
+
+// ReadCustomRole records the call and calls ReadCustomRoleFunc.
+func (m *Service) ReadCustomRole(ctx context.Context, a0 string) (*custom_role.CustomRole, error) {
+	m.Record("ReadCustomRole", a0)
+	if m.ReadCustomRoleFunc == nil {
+		return nil, mock.ErrNotStubbed
+	}
+	return m.ReadCustomRoleFunc(ctx, a0)
+}
+
+// OnReadCustomRole stubs ReadCustomRole to return the given values.
+func (m *Service) OnReadCustomRole(r0 *custom_role.CustomRole, r1 error) *Service {
+	m.ReadCustomRoleFunc = func(context.Context, string) (*custom_role.CustomRole, error) {
+		return r0, r1
+	}
+	return m
+}
+
+// UpdateCustomRole records the call and calls UpdateCustomRoleFunc.
+func (m *Service) UpdateCustomRole(ctx context.Context, a0 string, a1 *custom_role.CustomRole) (*custom_role.CustomRole, error) {
+	m.Record("UpdateCustomRole", a0, a1)
+	if m.UpdateCustomRoleFunc == nil {
+		return nil, mock.ErrNotStubbed
+	}
+	return m.UpdateCustomRoleFunc(ctx, a0, a1)
+}
+
+// OnUpdateCustomRole stubs UpdateCustomRole to return the given values.
+func (m *Service) OnUpdateCustomRole(r0 *custom_role.CustomRole, r1 error) *Service {
+	m.UpdateCustomRoleFunc = func(context.Context, string, *custom_role.CustomRole) (*custom_role.CustomRole, error) {
+		return r0, r1
+	}
+	return m
+}
+
+// DeleteCustomRole records the call and calls DeleteCustomRoleFunc.
+func (m *Service) DeleteCustomRole(ctx context.Context, a0 string) (*commons.EmptyResponse, error) {
+	m.Record("DeleteCustomRole", a0)
+	if m.DeleteCustomRoleFunc == nil {
+		return nil, mock.ErrNotStubbed
+	}
+	return m.DeleteCustomRoleFunc(ctx, a0)
+}
+
+// OnDeleteCustomRole stubs DeleteCustomRole to return the given values.
+func (m *Service) OnDeleteCustomRole(r0 *commons.EmptyResponse, r1 error) *Service {
+	m.DeleteCustomRoleFunc = func(context.Context, string) (*commons.EmptyResponse, error) {
+		return r0, r1
+	}
+	return m
+}
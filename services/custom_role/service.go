@@ -9,12 +9,15 @@ import (
 	"github.com/control-monkey/controlmonkey-sdk-go/services/commons"
 )
 
+//go:generate go run ../../internal/mockgen
+
 // Service provides the API operation methods for making requests to endpoints
 // of the ControlMonkey API. See this package's package overview docs for details on
 // the service.
 type Service interface {
 	CreateCustomRole(context.Context, *CustomRole) (*CustomRole, error)
 	ListCustomRoles(context.Context, *string, *string) ([]*CustomRole, error)
+	ListCustomRolesPaginator(*string, *string, *client.PageOptions) client.Pager[CustomRole]
 	ReadCustomRole(context.Context, string) (*CustomRole, error)
 	UpdateCustomRole(context.Context, string, *CustomRole) (*CustomRole, error)
 	DeleteCustomRole(context.Context, string) (*commons.EmptyResponse, error)
@@ -32,6 +35,6 @@ func New(sess *session.Session, cfgs ...*controlmonkey.Config) Service {
 	cfg.Merge(cfgs...)
 
 	return &ServiceOp{
-		Client: client.New(sess.Config),
+		Client: client.New(cfg),
 	}
 }
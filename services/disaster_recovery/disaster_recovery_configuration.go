@@ -2,8 +2,6 @@ package disaster_recovery
 
 import (
 	"context"
-	"encoding/json"
-	"io"
 	"net/http"
 
 	"github.com/control-monkey/controlmonkey-sdk-go/controlmonkey/util/uritemplates"
@@ -56,25 +54,10 @@ type VcsInfo struct {
 
 func (s *ServiceOp) CreateDisasterRecoveryConfiguration(ctx context.Context, input *DisasterRecoveryConfiguration) (*DisasterRecoveryConfiguration, error) {
 	r := client.NewRequest(http.MethodPost, configurationUrl)
+	r.Operation = "disaster_recovery.CreateDisasterRecoveryConfiguration"
 	r.Obj = input
 
-	resp, err := client.RequireOK(s.Client.Do(ctx, r))
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	disasterRecoveryConfiguration, err := disasterRecoveryConfigurationsFromHttpResponse(resp)
-	if err != nil {
-		return nil, err
-	}
-
-	output := new(DisasterRecoveryConfiguration)
-	if len(disasterRecoveryConfiguration) > 0 {
-		output = disasterRecoveryConfiguration[0]
-	}
-
-	return output, nil
+	return client.DoOne[DisasterRecoveryConfiguration](ctx, s.Client, r)
 }
 
 func (s *ServiceOp) ReadDisasterRecoveryConfiguration(ctx context.Context, disasterRecoveryConfigurationId string) (*DisasterRecoveryConfiguration, error) {
@@ -84,23 +67,8 @@ func (s *ServiceOp) ReadDisasterRecoveryConfiguration(ctx context.Context, disas
 	}
 
 	r := client.NewRequest(http.MethodGet, path)
-	resp, err := client.RequireOK(s.Client.Do(ctx, r))
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	disasterRecoveryConfiguration, err := disasterRecoveryConfigurationsFromHttpResponse(resp)
-	if err != nil {
-		return nil, err
-	}
-
-	output := new(DisasterRecoveryConfiguration)
-	if len(disasterRecoveryConfiguration) > 0 {
-		output = disasterRecoveryConfiguration[0]
-	}
-
-	return output, nil
+	r.Operation = "disaster_recovery.ReadDisasterRecoveryConfiguration"
+	return client.DoOne[DisasterRecoveryConfiguration](ctx, s.Client, r)
 }
 
 func (s *ServiceOp) UpdateDisasterRecoveryConfiguration(ctx context.Context, id string, input *DisasterRecoveryConfiguration) (*DisasterRecoveryConfiguration, error) {
@@ -110,26 +78,10 @@ func (s *ServiceOp) UpdateDisasterRecoveryConfiguration(ctx context.Context, id
 	}
 
 	r := client.NewRequest(http.MethodPut, path)
+	r.Operation = "disaster_recovery.UpdateDisasterRecoveryConfiguration"
 	r.Obj = input
 
-	resp, err := client.RequireOK(s.Client.Do(ctx, r))
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	disasterRecoveryConfiguration, err := disasterRecoveryConfigurationsFromHttpResponse(resp)
-	if err != nil {
-		return nil, err
-	}
-
-	output := new(DisasterRecoveryConfiguration)
-
-	if len(disasterRecoveryConfiguration) > 0 {
-		output = disasterRecoveryConfiguration[0]
-	}
-
-	return output, nil
+	return client.DoOne[DisasterRecoveryConfiguration](ctx, s.Client, r)
 }
 
 func (s *ServiceOp) DeleteDisasterRecoveryConfiguration(ctx context.Context, id string) (*commons.EmptyResponse, error) {
@@ -139,6 +91,7 @@ func (s *ServiceOp) DeleteDisasterRecoveryConfiguration(ctx context.Context, id
 	}
 
 	r := client.NewRequest(http.MethodDelete, path)
+	r.Operation = "disaster_recovery.DeleteDisasterRecoveryConfiguration"
 
 	resp, err := client.RequireOK(s.Client.Do(ctx, r))
 	if err != nil {
@@ -152,45 +105,6 @@ func (s *ServiceOp) DeleteDisasterRecoveryConfiguration(ctx context.Context, id
 
 //endregion
 
-//region Private Methods
-
-func disasterRecoveryConfigurationFromJSON(in []byte) (*DisasterRecoveryConfiguration, error) {
-	b := new(DisasterRecoveryConfiguration)
-	if err := json.Unmarshal(in, b); err != nil {
-		return nil, err
-	}
-	return b, nil
-}
-
-func disasterRecoveryConfigurationsFromJSON(in []byte) ([]*DisasterRecoveryConfiguration, error) {
-	var rw client.Response
-	if err := json.Unmarshal(in, &rw); err != nil {
-		return nil, err
-	}
-	out := make([]*DisasterRecoveryConfiguration, len(rw.Response.Items))
-	if len(out) == 0 {
-		return out, nil
-	}
-	for i, rb := range rw.Response.Items {
-		b, err := disasterRecoveryConfigurationFromJSON(rb)
-		if err != nil {
-			return nil, err
-		}
-		out[i] = b
-	}
-	return out, nil
-}
-
-func disasterRecoveryConfigurationsFromHttpResponse(resp *http.Response) ([]*DisasterRecoveryConfiguration, error) {
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return nil, err
-	}
-	return disasterRecoveryConfigurationsFromJSON(body)
-}
-
-//endregion
-
 //region Setters
 
 func (o DisasterRecoveryConfiguration) MarshalJSON() ([]byte, error) {
@@ -0,0 +1,94 @@
+// Code generated by mockgen. DO NOT EDIT.
+
+// Package mocks provides a mock implementation of disaster_recovery.Service.
+package mocks
+
+import (
+	"context"
+
+	"github.com/control-monkey/controlmonkey-sdk-go/controlmonkey/testing/mock"
+	"github.com/control-monkey/controlmonkey-sdk-go/services/commons"
+	"github.com/control-monkey/controlmonkey-sdk-go/services/disaster_recovery"
+)
+
+// Service is a mock implementation of disaster_recovery.Service. Every call is recorded, and
+// answered by the matching Func field if set, or with zero values and
+// mock.ErrNotStubbed otherwise. Use the On methods to stub canned responses.
+type Service struct {
+	mock.Recorder
+
+	CreateDisasterRecoveryConfigurationFunc func(context.Context, *disaster_recovery.DisasterRecoveryConfiguration) (*disaster_recovery.DisasterRecoveryConfiguration, error)
+	ReadDisasterRecoveryConfigurationFunc   func(context.Context, string) (*disaster_recovery.DisasterRecoveryConfiguration, error)
+	UpdateDisasterRecoveryConfigurationFunc func(context.Context, string, *disaster_recovery.DisasterRecoveryConfiguration) (*disaster_recovery.DisasterRecoveryConfiguration, error)
+	DeleteDisasterRecoveryConfigurationFunc func(context.Context, string) (*commons.EmptyResponse, error)
+}
+
+var _ disaster_recovery.Service = &Service{}
+
+// CreateDisasterRecoveryConfiguration records the call and calls CreateDisasterRecoveryConfigurationFunc.
+func (m *Service) CreateDisasterRecoveryConfiguration(ctx context.Context, a0 *disaster_recovery.DisasterRecoveryConfiguration) (*disaster_recovery.DisasterRecoveryConfiguration, error) {
+	m.Record("CreateDisasterRecoveryConfiguration", a0)
+	if m.CreateDisasterRecoveryConfigurationFunc == nil {
+		return nil, mock.ErrNotStubbed
+	}
+	return m.CreateDisasterRecoveryConfigurationFunc(ctx, a0)
+}
+
+// OnCreateDisasterRecoveryConfiguration stubs CreateDisasterRecoveryConfiguration to return the given values.
+func (m *Service) OnCreateDisasterRecoveryConfiguration(r0 *disaster_recovery.DisasterRecoveryConfiguration, r1 error) *Service {
+	m.CreateDisasterRecoveryConfigurationFunc = func(context.Context, *disaster_recovery.DisasterRecoveryConfiguration) (*disaster_recovery.DisasterRecoveryConfiguration, error) {
+		return r0, r1
+	}
+	return m
+}
+
+// ReadDisasterRecoveryConfiguration records the call and calls ReadDisasterRecoveryConfigurationFunc.
+func (m *Service) ReadDisasterRecoveryConfiguration(ctx context.Context, a0 string) (*disaster_recovery.DisasterRecoveryConfiguration, error) {
+	m.Record("ReadDisasterRecoveryConfiguration", a0)
+	if m.ReadDisasterRecoveryConfigurationFunc == nil {
+		return nil, mock.ErrNotStubbed
+	}
+	return m.ReadDisasterRecoveryConfigurationFunc(ctx, a0)
+}
+
+// OnReadDisasterRecoveryConfiguration stubs ReadDisasterRecoveryConfiguration to return the given values.
+func (m *Service) OnReadDisasterRecoveryConfiguration(r0 *disaster_recovery.DisasterRecoveryConfiguration, r1 error) *Service {
+	m.ReadDisasterRecoveryConfigurationFunc = func(context.Context, string) (*disaster_recovery.DisasterRecoveryConfiguration, error) {
+		return r0, r1
+	}
+	return m
+}
+
+// UpdateDisasterRecoveryConfiguration records the call and calls UpdateDisasterRecoveryConfigurationFunc.
+func (m *Service) UpdateDisasterRecoveryConfiguration(ctx context.Context, a0 string, a1 *disaster_recovery.DisasterRecoveryConfiguration) (*disaster_recovery.DisasterRecoveryConfiguration, error) {
+	m.Record("UpdateDisasterRecoveryConfiguration", a0, a1)
+	if m.UpdateDisasterRecoveryConfigurationFunc == nil {
+		return nil, mock.ErrNotStubbed
+	}
+	return m.UpdateDisasterRecoveryConfigurationFunc(ctx, a0, a1)
+}
+
+// OnUpdateDisasterRecoveryConfiguration stubs UpdateDisasterRecoveryConfiguration to return the given values.
+func (m *Service) OnUpdateDisasterRecoveryConfiguration(r0 *disaster_recovery.DisasterRecoveryConfiguration, r1 error) *Service {
+	m.UpdateDisasterRecoveryConfigurationFunc = func(context.Context, string, *disaster_recovery.DisasterRecoveryConfiguration) (*disaster_recovery.DisasterRecoveryConfiguration, error) {
+		return r0, r1
+	}
+	return m
+}
+
+// DeleteDisasterRecoveryConfiguration records the call and calls DeleteDisasterRecoveryConfigurationFunc.
+func (m *Service) DeleteDisasterRecoveryConfiguration(ctx context.Context, a0 string) (*commons.EmptyResponse, error) {
+	m.Record("DeleteDisasterRecoveryConfiguration", a0)
+	if m.DeleteDisasterRecoveryConfigurationFunc == nil {
+		return nil, mock.ErrNotStubbed
+	}
+	return m.DeleteDisasterRecoveryConfigurationFunc(ctx, a0)
+}
+
+// OnDeleteDisasterRecoveryConfiguration stubs DeleteDisasterRecoveryConfiguration to return the given values.
+func (m *Service) OnDeleteDisasterRecoveryConfiguration(r0 *commons.EmptyResponse, r1 error) *Service {
+	m.DeleteDisasterRecoveryConfigurationFunc = func(context.Context, string) (*commons.EmptyResponse, error) {
+		return r0, r1
+	}
+	return m
+}
@@ -2,8 +2,6 @@ package external_credentials
 
 import (
 	"context"
-	"encoding/json"
-	"io"
 	"net/http"
 
 	"github.com/control-monkey/controlmonkey-sdk-go/controlmonkey/client"
@@ -27,24 +25,9 @@ type ExternalCredentials struct {
 //region Methods
 
 func (s *ServiceOp) ListExternalCredentials(ctx context.Context, credentialsVendor string, credentialsId *string, credentialsName *string) ([]*ExternalCredentials, error) {
-	r := client.NewRequest(http.MethodGet, "/org/externalCredentials")
-
-	r.Params.Set("credentialsVendor", credentialsVendor)
-
-	if credentialsId != nil {
-		r.Params.Set("credentialsId", *credentialsId)
-	}
-	if credentialsName != nil {
-		r.Params.Set("credentialsName", *credentialsName)
-	}
-
-	resp, err := client.RequireOK(s.Client.Do(ctx, r))
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
+	r := listExternalCredentialsRequest(credentialsVendor, credentialsId, credentialsName)
 
-	output, err := externalCredentialsFromHttpResponse(resp)
+	output, err := client.DoItems[ExternalCredentials](ctx, s.Client, r)
 	if err != nil {
 		return nil, err
 	}
@@ -52,44 +35,26 @@ func (s *ServiceOp) ListExternalCredentials(ctx context.Context, credentialsVend
 	return output, nil
 }
 
-//endregion
+func (s *ServiceOp) ListExternalCredentialsPaginator(credentialsVendor string, credentialsId *string, credentialsName *string, opts *client.PageOptions) client.Pager[ExternalCredentials] {
+	return client.NewPaginator[ExternalCredentials](s.Client, func() *client.Request {
+		return listExternalCredentialsRequest(credentialsVendor, credentialsId, credentialsName)
+	}, opts)
+}
 
-//region Private Methods
+func listExternalCredentialsRequest(credentialsVendor string, credentialsId *string, credentialsName *string) *client.Request {
+	r := client.NewRequest(http.MethodGet, "/org/externalCredentials")
+	r.Operation = "external_credentials.ListExternalCredentials"
 
-func externalCredentialsFromJSON(in []byte) (*ExternalCredentials, error) {
-	b := new(ExternalCredentials)
-	if err := json.Unmarshal(in, b); err != nil {
-		return nil, err
-	}
-	return b, nil
-}
+	r.Params.Set("credentialsVendor", credentialsVendor)
 
-func externalCredentialsListFromJSON(in []byte) ([]*ExternalCredentials, error) {
-	var rw client.Response
-	if err := json.Unmarshal(in, &rw); err != nil {
-		return nil, err
-	}
-	out := make([]*ExternalCredentials, len(rw.Response.Items))
-	if len(out) == 0 {
-		return out, nil
+	if credentialsId != nil {
+		r.Params.Set("credentialsId", *credentialsId)
 	}
-	for i, rb := range rw.Response.Items {
-		b, err := externalCredentialsFromJSON(rb)
-		if err != nil {
-			return nil, err
-		}
-
-		out[i] = b
+	if credentialsName != nil {
+		r.Params.Set("credentialsName", *credentialsName)
 	}
-	return out, nil
-}
 
-func externalCredentialsFromHttpResponse(resp *http.Response) ([]*ExternalCredentials, error) {
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return nil, err
-	}
-	return externalCredentialsListFromJSON(body)
+	return r
 }
 
 //endregion
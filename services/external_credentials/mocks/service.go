@@ -0,0 +1,58 @@
+// Code generated by mockgen. DO NOT EDIT.
+
+// Package mocks provides a mock implementation of external_credentials.Service.
+package mocks
+
+import (
+	"context"
+
+	"github.com/control-monkey/controlmonkey-sdk-go/controlmonkey/client"
+	"github.com/control-monkey/controlmonkey-sdk-go/controlmonkey/testing/mock"
+	"github.com/control-monkey/controlmonkey-sdk-go/services/external_credentials"
+)
+
+// Service is a mock implementation of external_credentials.Service. Every call is recorded, and
+// answered by the matching Func field if set, or with zero values and
+// mock.ErrNotStubbed otherwise. Use the On methods to stub canned responses.
+type Service struct {
+	mock.Recorder
+
+	ListExternalCredentialsFunc          func(context.Context, string, *string, *string) ([]*external_credentials.ExternalCredentials, error)
+	ListExternalCredentialsPaginatorFunc func(string, *string, *string, *client.PageOptions) client.Pager[external_credentials.ExternalCredentials]
+}
+
+var _ external_credentials.Service = &Service{}
+
+// ListExternalCredentials records the call and calls ListExternalCredentialsFunc.
+func (m *Service) ListExternalCredentials(ctx context.Context, a0 string, a1 *string, a2 *string) ([]*external_credentials.ExternalCredentials, error) {
+	m.Record("ListExternalCredentials", a0, a1, a2)
+	if m.ListExternalCredentialsFunc == nil {
+		return nil, mock.ErrNotStubbed
+	}
+	return m.ListExternalCredentialsFunc(ctx, a0, a1, a2)
+}
+
+// OnListExternalCredentials stubs ListExternalCredentials to return the given values.
+func (m *Service) OnListExternalCredentials(r0 []*external_credentials.ExternalCredentials, r1 error) *Service {
+	m.ListExternalCredentialsFunc = func(context.Context, string, *string, *string) ([]*external_credentials.ExternalCredentials, error) {
+		return r0, r1
+	}
+	return m
+}
+
+// ListExternalCredentialsPaginator records the call and calls ListExternalCredentialsPaginatorFunc.
+func (m *Service) ListExternalCredentialsPaginator(a0 string, a1 *string, a2 *string, a3 *client.PageOptions) client.Pager[external_credentials.ExternalCredentials] {
+	m.Record("ListExternalCredentialsPaginator", a0, a1, a2, a3)
+	if m.ListExternalCredentialsPaginatorFunc == nil {
+		return client.NewSlicePager[external_credentials.ExternalCredentials](nil, mock.ErrNotStubbed)
+	}
+	return m.ListExternalCredentialsPaginatorFunc(a0, a1, a2, a3)
+}
+
+// OnListExternalCredentialsPaginator stubs ListExternalCredentialsPaginator to return the given values.
+func (m *Service) OnListExternalCredentialsPaginator(r0 client.Pager[external_credentials.ExternalCredentials]) *Service {
+	m.ListExternalCredentialsPaginatorFunc = func(string, *string, *string, *client.PageOptions) client.Pager[external_credentials.ExternalCredentials] {
+		return r0
+	}
+	return m
+}
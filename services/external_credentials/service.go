@@ -8,11 +8,14 @@ import (
 	"github.com/control-monkey/controlmonkey-sdk-go/controlmonkey/session"
 )
 
+//go:generate go run ../../internal/mockgen
+
 // Service provides the API operation methods for making requests to endpoints
 // of the ControlMonkey API. See this package's package overview docs for details on
 // the service.
 type Service interface {
 	ListExternalCredentials(context.Context, string, *string, *string) ([]*ExternalCredentials, error)
+	ListExternalCredentialsPaginator(string, *string, *string, *client.PageOptions) client.Pager[ExternalCredentials]
 }
 
 type ServiceOp struct {
@@ -27,6 +30,6 @@ func New(sess *session.Session, cfgs ...*controlmonkey.Config) Service {
 	cfg.Merge(cfgs...)
 
 	return &ServiceOp{
-		Client: client.New(sess.Config),
+		Client: client.New(cfg),
 	}
 }
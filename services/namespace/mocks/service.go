@@ -0,0 +1,131 @@
+// Code generated by mockgen. DO NOT EDIT.
+
+// Package mocks provides a mock implementation of namespace.Service.
+package mocks
+
+import (
+	"context"
+
+	"github.com/control-monkey/controlmonkey-sdk-go/controlmonkey/client"
+	"github.com/control-monkey/controlmonkey-sdk-go/controlmonkey/testing/mock"
+	"github.com/control-monkey/controlmonkey-sdk-go/services/commons"
+	"github.com/control-monkey/controlmonkey-sdk-go/services/namespace"
+)
+
+// Service is a mock implementation of namespace.Service. Every call is recorded, and
+// answered by the matching Func field if set, or with zero values and
+// mock.ErrNotStubbed otherwise. Use the On methods to stub canned responses.
+type Service struct {
+	mock.Recorder
+
+	CreateNamespaceFunc         func(context.Context, *namespace.Namespace) (*namespace.Namespace, error)
+	ListNamespacesFunc          func(context.Context, *string, *string) ([]*namespace.Namespace, error)
+	ListNamespacesPaginatorFunc func(*string, *string, *client.PageOptions) client.Pager[namespace.Namespace]
+	ReadNamespaceFunc           func(context.Context, string) (*namespace.Namespace, error)
+	UpdateNamespaceFunc         func(context.Context, string, *namespace.Namespace) (*namespace.Namespace, error)
+	DeleteNamespaceFunc         func(context.Context, string) (*commons.EmptyResponse, error)
+}
+
+var _ namespace.Service = &Service{}
+
+// CreateNamespace records the call and calls CreateNamespaceFunc.
+func (m *Service) CreateNamespace(ctx context.Context, a0 *namespace.Namespace) (*namespace.Namespace, error) {
+	m.Record("CreateNamespace", a0)
+	if m.CreateNamespaceFunc == nil {
+		return nil, mock.ErrNotStubbed
+	}
+	return m.CreateNamespaceFunc(ctx, a0)
+}
+
+// OnCreateNamespace stubs CreateNamespace to return the given values.
+func (m *Service) OnCreateNamespace(r0 *namespace.Namespace, r1 error) *Service {
+	m.CreateNamespaceFunc = func(context.Context, *namespace.Namespace) (*namespace.Namespace, error) {
+		return r0, r1
+	}
+	return m
+}
+
+// ListNamespaces records the call and calls ListNamespacesFunc.
+func (m *Service) ListNamespaces(ctx context.Context, a0 *string, a1 *string) ([]*namespace.Namespace, error) {
+	m.Record("ListNamespaces", a0, a1)
+	if m.ListNamespacesFunc == nil {
+		return nil, mock.ErrNotStubbed
+	}
+	return m.ListNamespacesFunc(ctx, a0, a1)
+}
+
+// OnListNamespaces stubs ListNamespaces to return the given values.
+func (m *Service) OnListNamespaces(r0 []*namespace.Namespace, r1 error) *Service {
+	m.ListNamespacesFunc = func(context.Context, *string, *string) ([]*namespace.Namespace, error) {
+		return r0, r1
+	}
+	return m
+}
+
+// ListNamespacesPaginator records the call and calls ListNamespacesPaginatorFunc.
+func (m *Service) ListNamespacesPaginator(a0 *string, a1 *string, a2 *client.PageOptions) client.Pager[namespace.Namespace] {
+	m.Record("ListNamespacesPaginator", a0, a1, a2)
+	if m.ListNamespacesPaginatorFunc == nil {
+		return client.NewSlicePager[namespace.Namespace](nil, mock.ErrNotStubbed)
+	}
+	return m.ListNamespacesPaginatorFunc(a0, a1, a2)
+}
+
+// OnListNamespacesPaginator stubs ListNamespacesPaginator to return the given values.
+func (m *Service) OnListNamespacesPaginator(r0 client.Pager[namespace.Namespace]) *Service {
+	m.ListNamespacesPaginatorFunc = func(*string, *string, *client.PageOptions) client.Pager[namespace.Namespace] {
+		return r0
+	}
+	return m
+}
+
+// ReadNamespace records the call and calls ReadNamespaceFunc.
+func (m *Service) ReadNamespace(ctx context.Context, a0 string) (*namespace.Namespace, error) {
+	m.Record("ReadNamespace", a0)
+	if m.ReadNamespaceFunc == nil {
+		return nil, mock.ErrNotStubbed
+	}
+	return m.ReadNamespaceFunc(ctx, a0)
+}
+
+// OnReadNamespace stubs ReadNamespace to return the given values.
+func (m *Service) OnReadNamespace(r0 *namespace.Namespace, r1 error) *Service {
+	m.ReadNamespaceFunc = func(context.Context, string) (*namespace.Namespace, error) {
+		return r0, r1
+	}
+	return m
+}
+
+// UpdateNamespace records the call and calls UpdateNamespaceFunc.
+func (m *Service) UpdateNamespace(ctx context.Context, a0 string, a1 *namespace.Namespace) (*namespace.Namespace, error) {
+	m.Record("UpdateNamespace", a0, a1)
+	if m.UpdateNamespaceFunc == nil {
+		return nil, mock.ErrNotStubbed
+	}
+	return m.UpdateNamespaceFunc(ctx, a0, a1)
+}
+
+// OnUpdateNamespace stubs UpdateNamespace to return the given values.
+func (m *Service) OnUpdateNamespace(r0 *namespace.Namespace, r1 error) *Service {
+	m.UpdateNamespaceFunc = func(context.Context, string, *namespace.Namespace) (*namespace.Namespace, error) {
+		return r0, r1
+	}
+	return m
+}
+
+// DeleteNamespace records the call and calls DeleteNamespaceFunc.
+func (m *Service) DeleteNamespace(ctx context.Context, a0 string) (*commons.EmptyResponse, error) {
+	m.Record("DeleteNamespace", a0)
+	if m.DeleteNamespaceFunc == nil {
+		return nil, mock.ErrNotStubbed
+	}
+	return m.DeleteNamespaceFunc(ctx, a0)
+}
+
+// OnDeleteNamespace stubs DeleteNamespace to return the given values.
+func (m *Service) OnDeleteNamespace(r0 *commons.EmptyResponse, r1 error) *Service {
+	m.DeleteNamespaceFunc = func(context.Context, string) (*commons.EmptyResponse, error) {
+		return r0, r1
+	}
+	return m
+}
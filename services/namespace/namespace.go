@@ -2,8 +2,6 @@ package namespace
 
 import (
 	"context"
-	"encoding/json"
-	"io"
 	"net/http"
 
 	"github.com/control-monkey/controlmonkey-sdk-go/controlmonkey/client"
@@ -89,29 +87,32 @@ type CapabilityConfig struct {
 
 func (s *ServiceOp) CreateNamespace(ctx context.Context, input *Namespace) (*Namespace, error) {
 	r := client.NewRequest(http.MethodPost, "/namespace")
+	r.Operation = "namespace.CreateNamespace"
 	r.Obj = input
 
-	resp, err := client.RequireOK(s.Client.Do(ctx, r))
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
+	return client.DoOne[Namespace](ctx, s.Client, r)
+}
+
+func (s *ServiceOp) ListNamespaces(ctx context.Context, namespaceId *string, namespaceName *string) ([]*Namespace, error) {
+	r := listNamespacesRequest(namespaceId, namespaceName)
 
-	namespace, err := namespacesFromHttpResponse(resp)
+	namespaces, err := client.DoItems[Namespace](ctx, s.Client, r)
 	if err != nil {
 		return nil, err
 	}
 
-	output := new(Namespace)
-	if len(namespace) > 0 {
-		output = namespace[0]
-	}
+	return namespaces, nil
+}
 
-	return output, nil
+func (s *ServiceOp) ListNamespacesPaginator(namespaceId *string, namespaceName *string, opts *client.PageOptions) client.Pager[Namespace] {
+	return client.NewPaginator[Namespace](s.Client, func() *client.Request {
+		return listNamespacesRequest(namespaceId, namespaceName)
+	}, opts)
 }
 
-func (s *ServiceOp) ListNamespaces(ctx context.Context, namespaceId *string, namespaceName *string) ([]*Namespace, error) {
+func listNamespacesRequest(namespaceId *string, namespaceName *string) *client.Request {
 	r := client.NewRequest(http.MethodGet, "/namespace")
+	r.Operation = "namespace.ListNamespaces"
 
 	if namespaceId != nil {
 		r.Params.Set("namespaceId", *namespaceId)
@@ -120,18 +121,7 @@ func (s *ServiceOp) ListNamespaces(ctx context.Context, namespaceId *string, nam
 		r.Params.Set("namespaceName", *namespaceName)
 	}
 
-	resp, err := client.RequireOK(s.Client.Do(ctx, r))
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	namespaces, err := namespacesFromHttpResponse(resp)
-	if err != nil {
-		return nil, err
-	}
-
-	return namespaces, nil
+	return r
 }
 
 func (s *ServiceOp) ReadNamespace(ctx context.Context, namespaceId string) (*Namespace, error) {
@@ -141,23 +131,8 @@ func (s *ServiceOp) ReadNamespace(ctx context.Context, namespaceId string) (*Nam
 	}
 
 	r := client.NewRequest(http.MethodGet, path)
-	resp, err := client.RequireOK(s.Client.Do(ctx, r))
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	namespace, err := namespacesFromHttpResponse(resp)
-	if err != nil {
-		return nil, err
-	}
-
-	output := new(Namespace)
-	if len(namespace) > 0 {
-		output = namespace[0]
-	}
-
-	return output, nil
+	r.Operation = "namespace.ReadNamespace"
+	return client.DoOne[Namespace](ctx, s.Client, r)
 }
 
 func (s *ServiceOp) UpdateNamespace(ctx context.Context, namespaceId string, input *Namespace) (*Namespace, error) {
@@ -167,25 +142,10 @@ func (s *ServiceOp) UpdateNamespace(ctx context.Context, namespaceId string, inp
 	}
 
 	r := client.NewRequest(http.MethodPut, path)
+	r.Operation = "namespace.UpdateNamespace"
 	r.Obj = input
 
-	resp, err := client.RequireOK(s.Client.Do(ctx, r))
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	namespace, err := namespacesFromHttpResponse(resp)
-	if err != nil {
-		return nil, err
-	}
-
-	output := new(Namespace)
-	if len(namespace) > 0 {
-		output = namespace[0]
-	}
-
-	return output, nil
+	return client.DoOne[Namespace](ctx, s.Client, r)
 }
 
 func (s *ServiceOp) DeleteNamespace(ctx context.Context, namespaceId string) (*commons.EmptyResponse, error) {
@@ -195,6 +155,7 @@ func (s *ServiceOp) DeleteNamespace(ctx context.Context, namespaceId string) (*c
 	}
 
 	r := client.NewRequest(http.MethodDelete, path)
+	r.Operation = "namespace.DeleteNamespace"
 	resp, err := client.RequireOK(s.Client.Do(ctx, r))
 	if err != nil {
 		return nil, err
@@ -207,45 +168,6 @@ func (s *ServiceOp) DeleteNamespace(ctx context.Context, namespaceId string) (*c
 
 //endregion
 
-//region Private Methods
-
-func namespaceFromJSON(in []byte) (*Namespace, error) {
-	b := new(Namespace)
-	if err := json.Unmarshal(in, b); err != nil {
-		return nil, err
-	}
-	return b, nil
-}
-
-func namespacesFromJSON(in []byte) ([]*Namespace, error) {
-	var rw client.Response
-	if err := json.Unmarshal(in, &rw); err != nil {
-		return nil, err
-	}
-	out := make([]*Namespace, len(rw.Response.Items))
-	if len(out) == 0 {
-		return out, nil
-	}
-	for i, rb := range rw.Response.Items {
-		b, err := namespaceFromJSON(rb)
-		if err != nil {
-			return nil, err
-		}
-		out[i] = b
-	}
-	return out, nil
-}
-
-func namespacesFromHttpResponse(resp *http.Response) ([]*Namespace, error) {
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return nil, err
-	}
-	return namespacesFromJSON(body)
-}
-
-//endregion
-
 //region Setters
 
 //region Namespace
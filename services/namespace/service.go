@@ -9,12 +9,15 @@ import (
 	"github.com/control-monkey/controlmonkey-sdk-go/services/commons"
 )
 
+//go:generate go run ../../internal/mockgen
+
 // Service provides the API operation methods for making requests to endpoints
 // of the ControlMonkey API. See this package's package overview docs for details on
 // the service.
 type Service interface {
 	CreateNamespace(context.Context, *Namespace) (*Namespace, error)
 	ListNamespaces(context.Context, *string, *string) ([]*Namespace, error)
+	ListNamespacesPaginator(*string, *string, *client.PageOptions) client.Pager[Namespace]
 	ReadNamespace(context.Context, string) (*Namespace, error)
 	UpdateNamespace(context.Context, string, *Namespace) (*Namespace, error)
 	DeleteNamespace(context.Context, string) (*commons.EmptyResponse, error)
@@ -32,6 +35,6 @@ func New(sess *session.Session, cfgs ...*controlmonkey.Config) Service {
 	cfg.Merge(cfgs...)
 
 	return &ServiceOp{
-		Client: client.New(sess.Config),
+		Client: client.New(cfg),
 	}
 }
@@ -0,0 +1,95 @@
+// Code generated by mockgen. DO NOT EDIT.
+
+// Package mocks provides a mock implementation of namespace_permissions.Service.
+package mocks
+
+import (
+	"context"
+
+	"github.com/control-monkey/controlmonkey-sdk-go/controlmonkey/client"
+	"github.com/control-monkey/controlmonkey-sdk-go/controlmonkey/testing/mock"
+	"github.com/control-monkey/controlmonkey-sdk-go/services/commons"
+	"github.com/control-monkey/controlmonkey-sdk-go/services/namespace_permissions"
+)
+
+// Service is a mock implementation of namespace_permissions.Service. Every call is recorded, and
+// answered by the matching Func field if set, or with zero values and
+// mock.ErrNotStubbed otherwise. Use the On methods to stub canned responses.
+type Service struct {
+	mock.Recorder
+
+	ListNamespacePermissionsFunc          func(context.Context, *string, *string) ([]*namespace_permissions.NamespacePermission, error)
+	ListNamespacePermissionsPaginatorFunc func(*string, *string, *client.PageOptions) client.Pager[namespace_permissions.NamespacePermission]
+	CreateNamespacePermissionFunc         func(context.Context, *namespace_permissions.NamespacePermission) (*commons.EmptyResponse, error)
+	DeleteNamespacePermissionFunc         func(context.Context, *namespace_permissions.NamespacePermission) (*commons.EmptyResponse, error)
+}
+
+var _ namespace_permissions.Service = &Service{}
+
+// ListNamespacePermissions records the call and calls ListNamespacePermissionsFunc.
+func (m *Service) ListNamespacePermissions(ctx context.Context, a0 *string, a1 *string) ([]*namespace_permissions.NamespacePermission, error) {
+	m.Record("ListNamespacePermissions", a0, a1)
+	if m.ListNamespacePermissionsFunc == nil {
+		return nil, mock.ErrNotStubbed
+	}
+	return m.ListNamespacePermissionsFunc(ctx, a0, a1)
+}
+
+// OnListNamespacePermissions stubs ListNamespacePermissions to return the given values.
+func (m *Service) OnListNamespacePermissions(r0 []*namespace_permissions.NamespacePermission, r1 error) *Service {
+	m.ListNamespacePermissionsFunc = func(context.Context, *string, *string) ([]*namespace_permissions.NamespacePermission, error) {
+		return r0, r1
+	}
+	return m
+}
+
+// ListNamespacePermissionsPaginator records the call and calls ListNamespacePermissionsPaginatorFunc.
+func (m *Service) ListNamespacePermissionsPaginator(a0 *string, a1 *string, a2 *client.PageOptions) client.Pager[namespace_permissions.NamespacePermission] {
+	m.Record("ListNamespacePermissionsPaginator", a0, a1, a2)
+	if m.ListNamespacePermissionsPaginatorFunc == nil {
+		return client.NewSlicePager[namespace_permissions.NamespacePermission](nil, mock.ErrNotStubbed)
+	}
+	return m.ListNamespacePermissionsPaginatorFunc(a0, a1, a2)
+}
+
+// OnListNamespacePermissionsPaginator stubs ListNamespacePermissionsPaginator to return the given values.
+func (m *Service) OnListNamespacePermissionsPaginator(r0 client.Pager[namespace_permissions.NamespacePermission]) *Service {
+	m.ListNamespacePermissionsPaginatorFunc = func(*string, *string, *client.PageOptions) client.Pager[namespace_permissions.NamespacePermission] {
+		return r0
+	}
+	return m
+}
+
+// CreateNamespacePermission records the call and calls CreateNamespacePermissionFunc.
+func (m *Service) CreateNamespacePermission(ctx context.Context, a0 *namespace_permissions.NamespacePermission) (*commons.EmptyResponse, error) {
+	m.Record("CreateNamespacePermission", a0)
+	if m.CreateNamespacePermissionFunc == nil {
+		return nil, mock.ErrNotStubbed
+	}
+	return m.CreateNamespacePermissionFunc(ctx, a0)
+}
+
+// OnCreateNamespacePermission stubs CreateNamespacePermission to return the given values.
+func (m *Service) OnCreateNamespacePermission(r0 *commons.EmptyResponse, r1 error) *Service {
+	m.CreateNamespacePermissionFunc = func(context.Context, *namespace_permissions.NamespacePermission) (*commons.EmptyResponse, error) {
+		return r0, r1
+	}
+	return m
+}
+
+// DeleteNamespacePermission records the call and calls DeleteNamespacePermissionFunc.
+func (m *Service) DeleteNamespacePermission(ctx context.Context, a0 *namespace_permissions.NamespacePermission) (*commons.EmptyResponse, error) {
+	m.Record("DeleteNamespacePermission", a0)
+	if m.DeleteNamespacePermissionFunc == nil {
+		return nil, mock.ErrNotStubbed
+	}
+	return m.DeleteNamespacePermissionFunc(ctx, a0)
+}
+
+// OnDeleteNamespacePermission stubs DeleteNamespacePermission to return the given values.
+func (m *Service) OnDeleteNamespacePermission(r0 *commons.EmptyResponse, r1 error) *Service {
+	m.DeleteNamespacePermissionFunc = func(context.Context, *namespace_permissions.NamespacePermission) (*commons.EmptyResponse, error) {
+		return r0, r1
+	}
+	return m
+}
@@ -2,8 +2,6 @@ package namespace_permissions
 
 import (
 	"context"
-	"encoding/json"
-	"io"
 	"net/http"
 
 	"github.com/control-monkey/controlmonkey-sdk-go/controlmonkey/client"
@@ -47,6 +45,7 @@ type NamespacePermission struct {
 
 func (s *ServiceOp) CreateNamespacePermission(ctx context.Context, input *NamespacePermission) (*commons.EmptyResponse, error) {
 	r := client.NewRequest(http.MethodPost, "/iam/org/namespacePermission")
+	r.Operation = "namespace_permissions.CreateNamespacePermission"
 	r.Obj = input
 
 	resp, err := client.RequireOK(s.Client.Do(ctx, r))
@@ -60,7 +59,25 @@ func (s *ServiceOp) CreateNamespacePermission(ctx context.Context, input *Namesp
 }
 
 func (s *ServiceOp) ListNamespacePermissions(ctx context.Context, namespaceId *string, stackId *string) ([]*NamespacePermission, error) {
+	r := listNamespacePermissionsRequest(namespaceId, stackId)
+
+	output, err := client.DoItems[NamespacePermission](ctx, s.Client, r)
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func (s *ServiceOp) ListNamespacePermissionsPaginator(namespaceId *string, stackId *string, opts *client.PageOptions) client.Pager[NamespacePermission] {
+	return client.NewPaginator[NamespacePermission](s.Client, func() *client.Request {
+		return listNamespacePermissionsRequest(namespaceId, stackId)
+	}, opts)
+}
+
+func listNamespacePermissionsRequest(namespaceId *string, stackId *string) *client.Request {
 	r := client.NewRequest(http.MethodGet, "/iam/org/namespacePermission")
+	r.Operation = "namespace_permissions.ListNamespacePermissions"
 
 	if namespaceId != nil {
 		r.Params.Set("namespaceId", *namespaceId)
@@ -69,22 +86,12 @@ func (s *ServiceOp) ListNamespacePermissions(ctx context.Context, namespaceId *s
 		r.Params.Set("stackId", *stackId)
 	}
 
-	resp, err := client.RequireOK(s.Client.Do(ctx, r))
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	output, err := namespaceUsersFromHttpResponse(resp)
-	if err != nil {
-		return nil, err
-	}
-
-	return output, nil
+	return r
 }
 
 func (s *ServiceOp) DeleteNamespacePermission(ctx context.Context, input *NamespacePermission) (*commons.EmptyResponse, error) {
 	r := client.NewRequest(http.MethodDelete, "/iam/org/namespacePermission")
+	r.Operation = "namespace_permissions.DeleteNamespacePermission"
 	r.Obj = input
 
 	resp, err := client.RequireOK(s.Client.Do(ctx, r))
@@ -99,46 +106,6 @@ func (s *ServiceOp) DeleteNamespacePermission(ctx context.Context, input *Namesp
 
 //endregion
 
-//region Private Methods
-
-func namespacePermissionFromJSON(in []byte) (*NamespacePermission, error) {
-	b := new(NamespacePermission)
-	if err := json.Unmarshal(in, b); err != nil {
-		return nil, err
-	}
-	return b, nil
-}
-
-func namespacePermissionsFromJSON(in []byte) ([]*NamespacePermission, error) {
-	var rw client.Response
-	if err := json.Unmarshal(in, &rw); err != nil {
-		return nil, err
-	}
-	out := make([]*NamespacePermission, len(rw.Response.Items))
-	if len(out) == 0 {
-		return out, nil
-	}
-	for i, rb := range rw.Response.Items {
-		b, err := namespacePermissionFromJSON(rb)
-		if err != nil {
-			return nil, err
-		}
-
-		out[i] = b
-	}
-	return out, nil
-}
-
-func namespaceUsersFromHttpResponse(resp *http.Response) ([]*NamespacePermission, error) {
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return nil, err
-	}
-	return namespacePermissionsFromJSON(body)
-}
-
-//endregion
-
 //region Setters
 
 func (o NamespacePermission) MarshalJSON() ([]byte, error) {
@@ -9,11 +9,14 @@ import (
 	"github.com/control-monkey/controlmonkey-sdk-go/services/commons"
 )
 
+//go:generate go run ../../internal/mockgen
+
 // Service provides the API operation methods for making requests to endpoints
 // of the ControlMonkey API. See this package's package overview docs for details on
 // the service.
 type Service interface {
 	ListNamespacePermissions(context.Context, *string, *string) ([]*NamespacePermission, error)
+	ListNamespacePermissionsPaginator(*string, *string, *client.PageOptions) client.Pager[NamespacePermission]
 	CreateNamespacePermission(context.Context, *NamespacePermission) (*commons.EmptyResponse, error)
 	DeleteNamespacePermission(context.Context, *NamespacePermission) (*commons.EmptyResponse, error)
 }
@@ -30,6 +33,6 @@ func New(sess *session.Session, cfgs ...*controlmonkey.Config) Service {
 	cfg.Merge(cfgs...)
 
 	return &ServiceOp{
-		Client: client.New(sess.Config),
+		Client: client.New(cfg),
 	}
 }
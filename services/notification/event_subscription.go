@@ -2,8 +2,6 @@ package notification
 
 import (
 	"context"
-	"encoding/json"
-	"io"
 	"net/http"
 
 	"github.com/control-monkey/controlmonkey-sdk-go/controlmonkey/client"
@@ -46,29 +44,32 @@ type EventSubscription struct {
 
 func (s *ServiceOp) CreateEventSubscription(ctx context.Context, input *EventSubscription) (*EventSubscription, error) {
 	r := client.NewRequest(http.MethodPost, baseUrl+subscriptionUrl)
+	r.Operation = "notification.CreateEventSubscription"
 	r.Obj = input
 
-	resp, err := client.RequireOK(s.Client.Do(ctx, r))
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
+	return client.DoOne[EventSubscription](ctx, s.Client, r)
+}
 
-	endpoint, err := eventSubscriptionsFromHttpResponse(resp)
+func (s *ServiceOp) ListEventSubscriptions(ctx context.Context, scope string, scopeId *string) ([]*EventSubscription, error) {
+	r := listEventSubscriptionsRequest(scope, scopeId)
+
+	output, err := client.DoItems[EventSubscription](ctx, s.Client, r)
 	if err != nil {
 		return nil, err
 	}
 
-	output := new(EventSubscription)
-	if len(endpoint) > 0 {
-		output = endpoint[0]
-	}
-
 	return output, nil
 }
 
-func (s *ServiceOp) ListEventSubscriptions(ctx context.Context, scope string, scopeId *string) ([]*EventSubscription, error) {
+func (s *ServiceOp) ListEventSubscriptionsPaginator(scope string, scopeId *string, opts *client.PageOptions) client.Pager[EventSubscription] {
+	return client.NewPaginator[EventSubscription](s.Client, func() *client.Request {
+		return listEventSubscriptionsRequest(scope, scopeId)
+	}, opts)
+}
+
+func listEventSubscriptionsRequest(scope string, scopeId *string) *client.Request {
 	r := client.NewRequest(http.MethodGet, baseUrl+subscriptionUrl)
+	r.Operation = "notification.ListEventSubscriptions"
 	if scope == commons.OrganizationScope {
 		r.Params.Set("orgOnly", "true")
 	} else if scopeId != nil {
@@ -77,18 +78,7 @@ func (s *ServiceOp) ListEventSubscriptions(ctx context.Context, scope string, sc
 		}
 	}
 
-	resp, err := client.RequireOK(s.Client.Do(ctx, r))
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	output, err := eventSubscriptionsFromHttpResponse(resp)
-	if err != nil {
-		return nil, err
-	}
-
-	return output, nil
+	return r
 }
 
 func (s *ServiceOp) DeleteEventSubscription(ctx context.Context, subscriptionId string) (*commons.EmptyResponse, error) {
@@ -98,6 +88,7 @@ func (s *ServiceOp) DeleteEventSubscription(ctx context.Context, subscriptionId
 	}
 
 	r := client.NewRequest(http.MethodDelete, path)
+	r.Operation = "notification.DeleteEventSubscription"
 
 	resp, err := client.RequireOK(s.Client.Do(ctx, r))
 	if err != nil {
@@ -111,46 +102,6 @@ func (s *ServiceOp) DeleteEventSubscription(ctx context.Context, subscriptionId
 
 //endregion
 
-//region Private Methods
-
-func eventSubscriptionFromJSON(in []byte) (*EventSubscription, error) {
-	b := new(EventSubscription)
-	if err := json.Unmarshal(in, b); err != nil {
-		return nil, err
-	}
-	return b, nil
-}
-
-func eventSubscriptionsFromJSON(in []byte) ([]*EventSubscription, error) {
-	var rw client.Response
-	if err := json.Unmarshal(in, &rw); err != nil {
-		return nil, err
-	}
-	out := make([]*EventSubscription, len(rw.Response.Items))
-	if len(out) == 0 {
-		return out, nil
-	}
-	for i, rb := range rw.Response.Items {
-		b, err := eventSubscriptionFromJSON(rb)
-		if err != nil {
-			return nil, err
-		}
-		out[i] = b
-	}
-
-	return out, nil
-}
-
-func eventSubscriptionsFromHttpResponse(resp *http.Response) ([]*EventSubscription, error) {
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return nil, err
-	}
-	return eventSubscriptionsFromJSON(body)
-}
-
-//endregion
-
 //region Setters
 
 func (o EventSubscription) MarshalJSON() ([]byte, error) {
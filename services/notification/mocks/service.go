@@ -0,0 +1,293 @@
+// Code generated by mockgen. DO NOT EDIT.
+
+// Package mocks provides a mock implementation of notification.Service.
+package mocks
+
+import (
+	"context"
+
+	"github.com/control-monkey/controlmonkey-sdk-go/controlmonkey/client"
+	"github.com/control-monkey/controlmonkey-sdk-go/controlmonkey/testing/mock"
+	"github.com/control-monkey/controlmonkey-sdk-go/services/commons"
+	"github.com/control-monkey/controlmonkey-sdk-go/services/notification"
+)
+
+// Service is a mock implementation of notification.Service. Every call is recorded, and
+// answered by the matching Func field if set, or with zero values and
+// mock.ErrNotStubbed otherwise. Use the On methods to stub canned responses.
+type Service struct {
+	mock.Recorder
+
+	CreateNotificationEndpointFunc         func(context.Context, *notification.Endpoint) (*notification.Endpoint, error)
+	ListNotificationEndpointsFunc          func(context.Context, *string, *string) ([]*notification.Endpoint, error)
+	ListNotificationEndpointsPaginatorFunc func(*string, *string, *client.PageOptions) client.Pager[notification.Endpoint]
+	ReadNotificationEndpointFunc           func(context.Context, string) (*notification.Endpoint, error)
+	UpdateNotificationEndpointFunc         func(context.Context, string, *notification.Endpoint) (*notification.Endpoint, error)
+	DeleteNotificationEndpointFunc         func(context.Context, string) (*commons.EmptyResponse, error)
+	ListEventSubscriptionsFunc             func(context.Context, string, *string) ([]*notification.EventSubscription, error)
+	ListEventSubscriptionsPaginatorFunc    func(string, *string, *client.PageOptions) client.Pager[notification.EventSubscription]
+	CreateEventSubscriptionFunc            func(context.Context, *notification.EventSubscription) (*notification.EventSubscription, error)
+	DeleteEventSubscriptionFunc            func(context.Context, string) (*commons.EmptyResponse, error)
+	CreateNotificationSlackAppFunc         func(context.Context, *notification.NotificationSlackApp) (*notification.NotificationSlackApp, error)
+	ListNotificationSlackAppsFunc          func(context.Context, *string, *string) ([]*notification.NotificationSlackApp, error)
+	ListNotificationSlackAppsPaginatorFunc func(*string, *string, *client.PageOptions) client.Pager[notification.NotificationSlackApp]
+	UpdateNotificationSlackAppFunc         func(context.Context, string, *notification.NotificationSlackApp) (*notification.NotificationSlackApp, error)
+	DeleteNotificationSlackAppFunc         func(context.Context, string) (*commons.EmptyResponse, error)
+}
+
+var _ notification.Service = &Service{}
+
+// CreateNotificationEndpoint records the call and calls CreateNotificationEndpointFunc.
+func (m *Service) CreateNotificationEndpoint(ctx context.Context, a0 *notification.Endpoint) (*notification.Endpoint, error) {
+	m.Record("CreateNotificationEndpoint", a0)
+	if m.CreateNotificationEndpointFunc == nil {
+		return nil, mock.ErrNotStubbed
+	}
+	return m.CreateNotificationEndpointFunc(ctx, a0)
+}
+
+// OnCreateNotificationEndpoint stubs CreateNotificationEndpoint to return the given values.
+func (m *Service) OnCreateNotificationEndpoint(r0 *notification.Endpoint, r1 error) *Service {
+	m.CreateNotificationEndpointFunc = func(context.Context, *notification.Endpoint) (*notification.Endpoint, error) {
+		return r0, r1
+	}
+	return m
+}
+
+// ListNotificationEndpoints records the call and calls ListNotificationEndpointsFunc.
+func (m *Service) ListNotificationEndpoints(ctx context.Context, a0 *string, a1 *string) ([]*notification.Endpoint, error) {
+	m.Record("ListNotificationEndpoints", a0, a1)
+	if m.ListNotificationEndpointsFunc == nil {
+		return nil, mock.ErrNotStubbed
+	}
+	return m.ListNotificationEndpointsFunc(ctx, a0, a1)
+}
+
+// OnListNotificationEndpoints stubs ListNotificationEndpoints to return the given values.
+func (m *Service) OnListNotificationEndpoints(r0 []*notification.Endpoint, r1 error) *Service {
+	m.ListNotificationEndpointsFunc = func(context.Context, *string, *string) ([]*notification.Endpoint, error) {
+		return r0, r1
+	}
+	return m
+}
+
+// ListNotificationEndpointsPaginator records the call and calls ListNotificationEndpointsPaginatorFunc.
+func (m *Service) ListNotificationEndpointsPaginator(a0 *string, a1 *string, a2 *client.PageOptions) client.Pager[notification.Endpoint] {
+	m.Record("ListNotificationEndpointsPaginator", a0, a1, a2)
+	if m.ListNotificationEndpointsPaginatorFunc == nil {
+		return client.NewSlicePager[notification.Endpoint](nil, mock.ErrNotStubbed)
+	}
+	return m.ListNotificationEndpointsPaginatorFunc(a0, a1, a2)
+}
+
+// OnListNotificationEndpointsPaginator stubs ListNotificationEndpointsPaginator to return the given values.
+func (m *Service) OnListNotificationEndpointsPaginator(r0 client.Pager[notification.Endpoint]) *Service {
+	m.ListNotificationEndpointsPaginatorFunc = func(*string, *string, *client.PageOptions) client.Pager[notification.Endpoint] {
+		return r0
+	}
+	return m
+}
+
+// ReadNotificationEndpoint records the call and calls ReadNotificationEndpointFunc.
+func (m *Service) ReadNotificationEndpoint(ctx context.Context, a0 string) (*notification.Endpoint, error) {
+	m.Record("ReadNotificationEndpoint", a0)
+	if m.ReadNotificationEndpointFunc == nil {
+		return nil, mock.ErrNotStubbed
+	}
+	return m.ReadNotificationEndpointFunc(ctx, a0)
+}
+
+// OnReadNotificationEndpoint stubs ReadNotificationEndpoint to return the given values.
+func (m *Service) OnReadNotificationEndpoint(r0 *notification.Endpoint, r1 error) *Service {
+	m.ReadNotificationEndpointFunc = func(context.Context, string) (*notification.Endpoint, error) {
+		return r0, r1
+	}
+	return m
+}
+
+// UpdateNotificationEndpoint records the call and calls UpdateNotificationEndpointFunc.
+func (m *Service) UpdateNotificationEndpoint(ctx context.Context, a0 string, a1 *notification.Endpoint) (*notification.Endpoint, error) {
+	m.Record("UpdateNotificationEndpoint", a0, a1)
+	if m.UpdateNotificationEndpointFunc == nil {
+		return nil, mock.ErrNotStubbed
+	}
+	return m.UpdateNotificationEndpointFunc(ctx, a0, a1)
+}
+
+// OnUpdateNotificationEndpoint stubs UpdateNotificationEndpoint to return the given values.
+func (m *Service) OnUpdateNotificationEndpoint(r0 *notification.Endpoint, r1 error) *Service {
+	m.UpdateNotificationEndpointFunc = func(context.Context, string, *notification.Endpoint) (*notification.Endpoint, error) {
+		return r0, r1
+	}
+	return m
+}
+
+// DeleteNotificationEndpoint records the call and calls DeleteNotificationEndpointFunc.
+func (m *Service) DeleteNotificationEndpoint(ctx context.Context, a0 string) (*commons.EmptyResponse, error) {
+	m.Record("DeleteNotificationEndpoint", a0)
+	if m.DeleteNotificationEndpointFunc == nil {
+		return nil, mock.ErrNotStubbed
+	}
+	return m.DeleteNotificationEndpointFunc(ctx, a0)
+}
+
+// OnDeleteNotificationEndpoint stubs DeleteNotificationEndpoint to return the given values.
+func (m *Service) OnDeleteNotificationEndpoint(r0 *commons.EmptyResponse, r1 error) *Service {
+	m.DeleteNotificationEndpointFunc = func(context.Context, string) (*commons.EmptyResponse, error) {
+		return r0, r1
+	}
+	return m
+}
+
+// ListEventSubscriptions records the call and calls ListEventSubscriptionsFunc.
+func (m *Service) ListEventSubscriptions(ctx context.Context, a0 string, a1 *string) ([]*notification.EventSubscription, error) {
+	m.Record("ListEventSubscriptions", a0, a1)
+	if m.ListEventSubscriptionsFunc == nil {
+		return nil, mock.ErrNotStubbed
+	}
+	return m.ListEventSubscriptionsFunc(ctx, a0, a1)
+}
+
+// OnListEventSubscriptions stubs ListEventSubscriptions to return the given values.
+func (m *Service) OnListEventSubscriptions(r0 []*notification.EventSubscription, r1 error) *Service {
+	m.ListEventSubscriptionsFunc = func(context.Context, string, *string) ([]*notification.EventSubscription, error) {
+		return r0, r1
+	}
+	return m
+}
+
+// ListEventSubscriptionsPaginator records the call and calls ListEventSubscriptionsPaginatorFunc.
+func (m *Service) ListEventSubscriptionsPaginator(a0 string, a1 *string, a2 *client.PageOptions) client.Pager[notification.EventSubscription] {
+	m.Record("ListEventSubscriptionsPaginator", a0, a1, a2)
+	if m.ListEventSubscriptionsPaginatorFunc == nil {
+		return client.NewSlicePager[notification.EventSubscription](nil, mock.ErrNotStubbed)
+	}
+	return m.ListEventSubscriptionsPaginatorFunc(a0, a1, a2)
+}
+
+// OnListEventSubscriptionsPaginator stubs ListEventSubscriptionsPaginator to return the given values.
+func (m *Service) OnListEventSubscriptionsPaginator(r0 client.Pager[notification.EventSubscription]) *Service {
+	m.ListEventSubscriptionsPaginatorFunc = func(string, *string, *client.PageOptions) client.Pager[notification.EventSubscription] {
+		return r0
+	}
+	return m
+}
+
+// CreateEventSubscription records the call and calls CreateEventSubscriptionFunc.
+func (m *Service) CreateEventSubscription(ctx context.Context, a0 *notification.EventSubscription) (*notification.EventSubscription, error) {
+	m.Record("CreateEventSubscription", a0)
+	if m.CreateEventSubscriptionFunc == nil {
+		return nil, mock.ErrNotStubbed
+	}
+	return m.CreateEventSubscriptionFunc(ctx, a0)
+}
+
+// OnCreateEventSubscription stubs CreateEventSubscription to return the given values.
+func (m *Service) OnCreateEventSubscription(r0 *notification.EventSubscription, r1 error) *Service {
+	m.CreateEventSubscriptionFunc = func(context.Context, *notification.EventSubscription) (*notification.EventSubscription, error) {
+		return r0, r1
+	}
+	return m
+}
+
+// DeleteEventSubscription records the call and calls DeleteEventSubscriptionFunc.
+func (m *Service) DeleteEventSubscription(ctx context.Context, a0 string) (*commons.EmptyResponse, error) {
+	m.Record("DeleteEventSubscription", a0)
+	if m.DeleteEventSubscriptionFunc == nil {
+		return nil, mock.ErrNotStubbed
+	}
+	return m.DeleteEventSubscriptionFunc(ctx, a0)
+}
+
+// OnDeleteEventSubscription stubs DeleteEventSubscription to return the given values.
+func (m *Service) OnDeleteEventSubscription(r0 *commons.EmptyResponse, r1 error) *Service {
+	m.DeleteEventSubscriptionFunc = func(context.Context, string) (*commons.EmptyResponse, error) {
+		return r0, r1
+	}
+	return m
+}
+
+// CreateNotificationSlackApp records the call and calls CreateNotificationSlackAppFunc.
+func (m *Service) CreateNotificationSlackApp(ctx context.Context, a0 *notification.NotificationSlackApp) (*notification.NotificationSlackApp, error) {
+	m.Record("CreateNotificationSlackApp", a0)
+	if m.CreateNotificationSlackAppFunc == nil {
+		return nil, mock.ErrNotStubbed
+	}
+	return m.CreateNotificationSlackAppFunc(ctx, a0)
+}
+
+// OnCreateNotificationSlackApp stubs CreateNotificationSlackApp to return the given values.
+func (m *Service) OnCreateNotificationSlackApp(r0 *notification.NotificationSlackApp, r1 error) *Service {
+	m.CreateNotificationSlackAppFunc = func(context.Context, *notification.NotificationSlackApp) (*notification.NotificationSlackApp, error) {
+		return r0, r1
+	}
+	return m
+}
+
+// ListNotificationSlackApps records the call and calls ListNotificationSlackAppsFunc.
+func (m *Service) ListNotificationSlackApps(ctx context.Context, a0 *string, a1 *string) ([]*notification.NotificationSlackApp, error) {
+	m.Record("ListNotificationSlackApps", a0, a1)
+	if m.ListNotificationSlackAppsFunc == nil {
+		return nil, mock.ErrNotStubbed
+	}
+	return m.ListNotificationSlackAppsFunc(ctx, a0, a1)
+}
+
+// OnListNotificationSlackApps stubs ListNotificationSlackApps to return the given values.
+func (m *Service) OnListNotificationSlackApps(r0 []*notification.NotificationSlackApp, r1 error) *Service {
+	m.ListNotificationSlackAppsFunc = func(context.Context, *string, *string) ([]*notification.NotificationSlackApp, error) {
+		return r0, r1
+	}
+	return m
+}
+
+// ListNotificationSlackAppsPaginator records the call and calls ListNotificationSlackAppsPaginatorFunc.
+func (m *Service) ListNotificationSlackAppsPaginator(a0 *string, a1 *string, a2 *client.PageOptions) client.Pager[notification.NotificationSlackApp] {
+	m.Record("ListNotificationSlackAppsPaginator", a0, a1, a2)
+	if m.ListNotificationSlackAppsPaginatorFunc == nil {
+		return client.NewSlicePager[notification.NotificationSlackApp](nil, mock.ErrNotStubbed)
+	}
+	return m.ListNotificationSlackAppsPaginatorFunc(a0, a1, a2)
+}
+
+// OnListNotificationSlackAppsPaginator stubs ListNotificationSlackAppsPaginator to return the given values.
+func (m *Service) OnListNotificationSlackAppsPaginator(r0 client.Pager[notification.NotificationSlackApp]) *Service {
+	m.ListNotificationSlackAppsPaginatorFunc = func(*string, *string, *client.PageOptions) client.Pager[notification.NotificationSlackApp] {
+		return r0
+	}
+	return m
+}
+
+// UpdateNotificationSlackApp records the call and calls UpdateNotificationSlackAppFunc.
+func (m *Service) UpdateNotificationSlackApp(ctx context.Context, a0 string, a1 *notification.NotificationSlackApp) (*notification.NotificationSlackApp, error) {
+	m.Record("UpdateNotificationSlackApp", a0, a1)
+	if m.UpdateNotificationSlackAppFunc == nil {
+		return nil, mock.ErrNotStubbed
+	}
+	return m.UpdateNotificationSlackAppFunc(ctx, a0, a1)
+}
+
+// OnUpdateNotificationSlackApp stubs UpdateNotificationSlackApp to return the given values.
+func (m *Service) OnUpdateNotificationSlackApp(r0 *notification.NotificationSlackApp, r1 error) *Service {
+	m.UpdateNotificationSlackAppFunc = func(context.Context, string, *notification.NotificationSlackApp) (*notification.NotificationSlackApp, error) {
+		return r0, r1
+	}
+	return m
+}
+
+// DeleteNotificationSlackApp records the call and calls DeleteNotificationSlackAppFunc.
+func (m *Service) DeleteNotificationSlackApp(ctx context.Context, a0 string) (*commons.EmptyResponse, error) {
+	m.Record("DeleteNotificationSlackApp", a0)
+	if m.DeleteNotificationSlackAppFunc == nil {
+		return nil, mock.ErrNotStubbed
+	}
+	return m.DeleteNotificationSlackAppFunc(ctx, a0)
+}
+
+// OnDeleteNotificationSlackApp stubs DeleteNotificationSlackApp to return the given values.
+func (m *Service) OnDeleteNotificationSlackApp(r0 *commons.EmptyResponse, r1 error) *Service {
+	m.DeleteNotificationSlackAppFunc = func(context.Context, string) (*commons.EmptyResponse, error) {
+		return r0, r1
+	}
+	return m
+}
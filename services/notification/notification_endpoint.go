@@ -2,8 +2,6 @@ package notification
 
 import (
 	"context"
-	"encoding/json"
-	"io"
 	"net/http"
 
 	"github.com/control-monkey/controlmonkey-sdk-go/controlmonkey/client"
@@ -56,29 +54,32 @@ type NotificationEndpointSlackAppConfig struct {
 
 func (s *ServiceOp) CreateNotificationEndpoint(ctx context.Context, input *Endpoint) (*Endpoint, error) {
 	r := client.NewRequest(http.MethodPost, baseUrl+endpointUrl)
+	r.Operation = "notification.CreateNotificationEndpoint"
 	r.Obj = input
 
-	resp, err := client.RequireOK(s.Client.Do(ctx, r))
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
+	return client.DoOne[Endpoint](ctx, s.Client, r)
+}
+
+func (s *ServiceOp) ListNotificationEndpoints(ctx context.Context, endpointId *string, endpointName *string) ([]*Endpoint, error) {
+	r := listNotificationEndpointsRequest(endpointId, endpointName)
 
-	endpoint, err := endpointsFromHttpResponse(resp)
+	output, err := client.DoItems[Endpoint](ctx, s.Client, r)
 	if err != nil {
 		return nil, err
 	}
 
-	output := new(Endpoint)
-	if len(endpoint) > 0 {
-		output = endpoint[0]
-	}
-
 	return output, nil
 }
 
-func (s *ServiceOp) ListNotificationEndpoints(ctx context.Context, endpointId *string, endpointName *string) ([]*Endpoint, error) {
+func (s *ServiceOp) ListNotificationEndpointsPaginator(endpointId *string, endpointName *string, opts *client.PageOptions) client.Pager[Endpoint] {
+	return client.NewPaginator[Endpoint](s.Client, func() *client.Request {
+		return listNotificationEndpointsRequest(endpointId, endpointName)
+	}, opts)
+}
+
+func listNotificationEndpointsRequest(endpointId *string, endpointName *string) *client.Request {
 	r := client.NewRequest(http.MethodGet, baseUrl+endpointUrl)
+	r.Operation = "notification.ListNotificationEndpoints"
 
 	if endpointId != nil {
 		r.Params.Set("endpointId", *endpointId)
@@ -87,18 +88,7 @@ func (s *ServiceOp) ListNotificationEndpoints(ctx context.Context, endpointId *s
 		r.Params.Set("endpointName", *endpointName)
 	}
 
-	resp, err := client.RequireOK(s.Client.Do(ctx, r))
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	output, err := endpointsFromHttpResponse(resp)
-	if err != nil {
-		return nil, err
-	}
-
-	return output, nil
+	return r
 }
 
 func (s *ServiceOp) ReadNotificationEndpoint(ctx context.Context, endpointId string) (*Endpoint, error) {
@@ -108,23 +98,8 @@ func (s *ServiceOp) ReadNotificationEndpoint(ctx context.Context, endpointId str
 	}
 
 	r := client.NewRequest(http.MethodGet, path)
-	resp, err := client.RequireOK(s.Client.Do(ctx, r))
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	endpoint, err := endpointsFromHttpResponse(resp)
-	if err != nil {
-		return nil, err
-	}
-
-	output := new(Endpoint)
-	if len(endpoint) > 0 {
-		output = endpoint[0]
-	}
-
-	return output, nil
+	r.Operation = "notification.ReadNotificationEndpoint"
+	return client.DoOne[Endpoint](ctx, s.Client, r)
 }
 
 func (s *ServiceOp) UpdateNotificationEndpoint(ctx context.Context, endpointId string, input *Endpoint) (*Endpoint, error) {
@@ -134,25 +109,10 @@ func (s *ServiceOp) UpdateNotificationEndpoint(ctx context.Context, endpointId s
 	}
 
 	r := client.NewRequest(http.MethodPut, path)
+	r.Operation = "notification.UpdateNotificationEndpoint"
 	r.Obj = input
 
-	resp, err := client.RequireOK(s.Client.Do(ctx, r))
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	endpoint, err := endpointsFromHttpResponse(resp)
-	if err != nil {
-		return nil, err
-	}
-
-	output := new(Endpoint)
-	if len(endpoint) > 0 {
-		output = endpoint[0]
-	}
-
-	return output, nil
+	return client.DoOne[Endpoint](ctx, s.Client, r)
 }
 
 func (s *ServiceOp) DeleteNotificationEndpoint(ctx context.Context, endpointId string) (*commons.EmptyResponse, error) {
@@ -162,6 +122,7 @@ func (s *ServiceOp) DeleteNotificationEndpoint(ctx context.Context, endpointId s
 	}
 
 	r := client.NewRequest(http.MethodDelete, path)
+	r.Operation = "notification.DeleteNotificationEndpoint"
 	resp, err := client.RequireOK(s.Client.Do(ctx, r))
 	if err != nil {
 		return nil, err
@@ -174,45 +135,6 @@ func (s *ServiceOp) DeleteNotificationEndpoint(ctx context.Context, endpointId s
 
 //endregion
 
-//region Private Methods
-
-func endpointFromJSON(in []byte) (*Endpoint, error) {
-	b := new(Endpoint)
-	if err := json.Unmarshal(in, b); err != nil {
-		return nil, err
-	}
-	return b, nil
-}
-
-func endpointsFromJSON(in []byte) ([]*Endpoint, error) {
-	var rw client.Response
-	if err := json.Unmarshal(in, &rw); err != nil {
-		return nil, err
-	}
-	out := make([]*Endpoint, len(rw.Response.Items))
-	if len(out) == 0 {
-		return out, nil
-	}
-	for i, rb := range rw.Response.Items {
-		b, err := endpointFromJSON(rb)
-		if err != nil {
-			return nil, err
-		}
-		out[i] = b
-	}
-	return out, nil
-}
-
-func endpointsFromHttpResponse(resp *http.Response) ([]*Endpoint, error) {
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return nil, err
-	}
-	return endpointsFromJSON(body)
-}
-
-//endregion
-
 //region Setters
 
 func (o Endpoint) MarshalJSON() ([]byte, error) {
@@ -2,8 +2,6 @@ package notification
 
 import (
 	"context"
-	"encoding/json"
-	"io"
 	"net/http"
 
 	"github.com/control-monkey/controlmonkey-sdk-go/controlmonkey/client"
@@ -31,29 +29,32 @@ type NotificationSlackApp struct {
 
 func (s *ServiceOp) CreateNotificationSlackApp(ctx context.Context, input *NotificationSlackApp) (*NotificationSlackApp, error) {
 	r := client.NewRequest(http.MethodPost, baseUrl+slackAppUrl)
+	r.Operation = "notification.CreateNotificationSlackApp"
 	r.Obj = input
 
-	resp, err := client.RequireOK(s.Client.Do(ctx, r))
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
+	return client.DoOne[NotificationSlackApp](ctx, s.Client, r)
+}
+
+func (s *ServiceOp) ListNotificationSlackApps(ctx context.Context, slackAppId *string, slackAppName *string) ([]*NotificationSlackApp, error) {
+	r := listNotificationSlackAppsRequest(slackAppId, slackAppName)
 
-	apps, err := notificationSlackAppsFromHttpResponse(resp)
+	output, err := client.DoItems[NotificationSlackApp](ctx, s.Client, r)
 	if err != nil {
 		return nil, err
 	}
 
-	output := new(NotificationSlackApp)
-	if len(apps) > 0 {
-		output = apps[0]
-	}
-
 	return output, nil
 }
 
-func (s *ServiceOp) ListNotificationSlackApps(ctx context.Context, slackAppId *string, slackAppName *string) ([]*NotificationSlackApp, error) {
+func (s *ServiceOp) ListNotificationSlackAppsPaginator(slackAppId *string, slackAppName *string, opts *client.PageOptions) client.Pager[NotificationSlackApp] {
+	return client.NewPaginator[NotificationSlackApp](s.Client, func() *client.Request {
+		return listNotificationSlackAppsRequest(slackAppId, slackAppName)
+	}, opts)
+}
+
+func listNotificationSlackAppsRequest(slackAppId *string, slackAppName *string) *client.Request {
 	r := client.NewRequest(http.MethodGet, baseUrl+slackAppUrl)
+	r.Operation = "notification.ListNotificationSlackApps"
 
 	if slackAppId != nil {
 		r.Params.Set("slackAppId", *slackAppId)
@@ -62,18 +63,7 @@ func (s *ServiceOp) ListNotificationSlackApps(ctx context.Context, slackAppId *s
 		r.Params.Set("slackAppName", *slackAppName)
 	}
 
-	resp, err := client.RequireOK(s.Client.Do(ctx, r))
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	output, err := notificationSlackAppsFromHttpResponse(resp)
-	if err != nil {
-		return nil, err
-	}
-
-	return output, nil
+	return r
 }
 
 func (s *ServiceOp) UpdateNotificationSlackApp(ctx context.Context, slackAppId string, input *NotificationSlackApp) (*NotificationSlackApp, error) {
@@ -83,25 +73,10 @@ func (s *ServiceOp) UpdateNotificationSlackApp(ctx context.Context, slackAppId s
 	}
 
 	r := client.NewRequest(http.MethodPut, path)
+	r.Operation = "notification.UpdateNotificationSlackApp"
 	r.Obj = input
 
-	resp, err := client.RequireOK(s.Client.Do(ctx, r))
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	apps, err := notificationSlackAppsFromHttpResponse(resp)
-	if err != nil {
-		return nil, err
-	}
-
-	output := new(NotificationSlackApp)
-	if len(apps) > 0 {
-		output = apps[0]
-	}
-
-	return output, nil
+	return client.DoOne[NotificationSlackApp](ctx, s.Client, r)
 }
 
 func (s *ServiceOp) DeleteNotificationSlackApp(ctx context.Context, slackAppId string) (*commons.EmptyResponse, error) {
@@ -111,6 +86,7 @@ func (s *ServiceOp) DeleteNotificationSlackApp(ctx context.Context, slackAppId s
 	}
 
 	r := client.NewRequest(http.MethodDelete, path)
+	r.Operation = "notification.DeleteNotificationSlackApp"
 	resp, err := client.RequireOK(s.Client.Do(ctx, r))
 	if err != nil {
 		return nil, err
@@ -123,45 +99,6 @@ func (s *ServiceOp) DeleteNotificationSlackApp(ctx context.Context, slackAppId s
 
 //endregion
 
-//region Private Methods
-
-func notificationSlackAppFromJSON(in []byte) (*NotificationSlackApp, error) {
-	b := new(NotificationSlackApp)
-	if err := json.Unmarshal(in, b); err != nil {
-		return nil, err
-	}
-	return b, nil
-}
-
-func notificationSlackAppsFromJSON(in []byte) ([]*NotificationSlackApp, error) {
-	var rw client.Response
-	if err := json.Unmarshal(in, &rw); err != nil {
-		return nil, err
-	}
-	out := make([]*NotificationSlackApp, len(rw.Response.Items))
-	if len(out) == 0 {
-		return out, nil
-	}
-	for i, rb := range rw.Response.Items {
-		b, err := notificationSlackAppFromJSON(rb)
-		if err != nil {
-			return nil, err
-		}
-		out[i] = b
-	}
-	return out, nil
-}
-
-func notificationSlackAppsFromHttpResponse(resp *http.Response) ([]*NotificationSlackApp, error) {
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return nil, err
-	}
-	return notificationSlackAppsFromJSON(body)
-}
-
-//endregion
-
 //region Setters
 
 func (o NotificationSlackApp) MarshalJSON() ([]byte, error) {
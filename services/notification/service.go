@@ -16,22 +16,27 @@ const (
 	slackAppUrl     = "/slackApp"
 )
 
+//go:generate go run ../../internal/mockgen
+
 // Service provides the API operation methods for making requests to endpoints
 // of the ControlMonkey API. See this package's package overview docs for details on
 // the service.
 type Service interface {
 	CreateNotificationEndpoint(context.Context, *Endpoint) (*Endpoint, error)
 	ListNotificationEndpoints(context.Context, *string, *string) ([]*Endpoint, error)
+	ListNotificationEndpointsPaginator(*string, *string, *client.PageOptions) client.Pager[Endpoint]
 	ReadNotificationEndpoint(context.Context, string) (*Endpoint, error)
 	UpdateNotificationEndpoint(context.Context, string, *Endpoint) (*Endpoint, error)
 	DeleteNotificationEndpoint(context.Context, string) (*commons.EmptyResponse, error)
 
 	ListEventSubscriptions(context.Context, string, *string) ([]*EventSubscription, error)
+	ListEventSubscriptionsPaginator(string, *string, *client.PageOptions) client.Pager[EventSubscription]
 	CreateEventSubscription(context.Context, *EventSubscription) (*EventSubscription, error)
 	DeleteEventSubscription(context.Context, string) (*commons.EmptyResponse, error)
 
 	CreateNotificationSlackApp(context.Context, *NotificationSlackApp) (*NotificationSlackApp, error)
 	ListNotificationSlackApps(context.Context, *string, *string) ([]*NotificationSlackApp, error)
+	ListNotificationSlackAppsPaginator(*string, *string, *client.PageOptions) client.Pager[NotificationSlackApp]
 	UpdateNotificationSlackApp(context.Context, string, *NotificationSlackApp) (*NotificationSlackApp, error)
 	DeleteNotificationSlackApp(context.Context, string) (*commons.EmptyResponse, error)
 }
@@ -48,6 +53,6 @@ func New(sess *session.Session, cfgs ...*controlmonkey.Config) Service {
 	cfg.Merge(cfgs...)
 
 	return &ServiceOp{
-		Client: client.New(sess.Config),
+		Client: client.New(cfg),
 	}
 }
@@ -0,0 +1,76 @@
+// Code generated by mockgen. DO NOT EDIT.
+
+// Package mocks provides a mock implementation of organization.Service.
+package mocks
+
+import (
+	"context"
+
+	"github.com/control-monkey/controlmonkey-sdk-go/controlmonkey/testing/mock"
+	"github.com/control-monkey/controlmonkey-sdk-go/services/commons"
+	"github.com/control-monkey/controlmonkey-sdk-go/services/organization"
+)
+
+// Service is a mock implementation of organization.Service. Every call is recorded, and
+// answered by the matching Func field if set, or with zero values and
+// mock.ErrNotStubbed otherwise. Use the On methods to stub canned responses.
+type Service struct {
+	mock.Recorder
+
+	ReadOrgConfigurationFunc   func(context.Context) (*organization.OrgConfiguration, error)
+	UpsertOrgConfigurationFunc func(context.Context, *organization.OrgConfiguration) (*organization.OrgConfiguration, error)
+	DeleteOrgConfigurationFunc func(context.Context) (*commons.EmptyResponse, error)
+}
+
+var _ organization.Service = &Service{}
+
+// ReadOrgConfiguration records the call and calls ReadOrgConfigurationFunc.
+func (m *Service) ReadOrgConfiguration(ctx context.Context) (*organization.OrgConfiguration, error) {
+	m.Record("ReadOrgConfiguration")
+	if m.ReadOrgConfigurationFunc == nil {
+		return nil, mock.ErrNotStubbed
+	}
+	return m.ReadOrgConfigurationFunc(ctx)
+}
+
+// OnReadOrgConfiguration stubs ReadOrgConfiguration to return the given values.
+func (m *Service) OnReadOrgConfiguration(r0 *organization.OrgConfiguration, r1 error) *Service {
+	m.ReadOrgConfigurationFunc = func(context.Context) (*organization.OrgConfiguration, error) {
+		return r0, r1
+	}
+	return m
+}
+
+// UpsertOrgConfiguration records the call and calls UpsertOrgConfigurationFunc.
+func (m *Service) UpsertOrgConfiguration(ctx context.Context, a0 *organization.OrgConfiguration) (*organization.OrgConfiguration, error) {
+	m.Record("UpsertOrgConfiguration", a0)
+	if m.UpsertOrgConfigurationFunc == nil {
+		return nil, mock.ErrNotStubbed
+	}
+	return m.UpsertOrgConfigurationFunc(ctx, a0)
+}
+
+// OnUpsertOrgConfiguration stubs UpsertOrgConfiguration to return the given values.
+func (m *Service) OnUpsertOrgConfiguration(r0 *organization.OrgConfiguration, r1 error) *Service {
+	m.UpsertOrgConfigurationFunc = func(context.Context, *organization.OrgConfiguration) (*organization.OrgConfiguration, error) {
+		return r0, r1
+	}
+	return m
+}
+
+// DeleteOrgConfiguration records the call and calls DeleteOrgConfigurationFunc.
+func (m *Service) DeleteOrgConfiguration(ctx context.Context) (*commons.EmptyResponse, error) {
+	m.Record("DeleteOrgConfiguration")
+	if m.DeleteOrgConfigurationFunc == nil {
+		return nil, mock.ErrNotStubbed
+	}
+	return m.DeleteOrgConfigurationFunc(ctx)
+}
+
+// OnDeleteOrgConfiguration stubs DeleteOrgConfiguration to return the given values.
+func (m *Service) OnDeleteOrgConfiguration(r0 *commons.EmptyResponse, r1 error) *Service {
+	m.DeleteOrgConfigurationFunc = func(context.Context) (*commons.EmptyResponse, error) {
+		return r0, r1
+	}
+	return m
+}
@@ -2,8 +2,6 @@ package organization
 
 import (
 	"context"
-	"encoding/json"
-	"io"
 	"net/http"
 
 	"github.com/control-monkey/controlmonkey-sdk-go/controlmonkey/client"
@@ -111,50 +109,21 @@ type ReportRecipients struct {
 
 func (s *ServiceOp) ReadOrgConfiguration(ctx context.Context) (*OrgConfiguration, error) {
 	r := client.NewRequest(http.MethodGet, baseUrl+configurationUrl)
-	resp, err := client.RequireOK(s.Client.Do(ctx, r))
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	orgConfiguration, err := orgConfigurationsFromHttpResponse(resp)
-	if err != nil {
-		return nil, err
-	}
-
-	output := new(OrgConfiguration)
-	if len(orgConfiguration) > 0 {
-		output = orgConfiguration[0]
-	}
-
-	return output, nil
+	r.Operation = "organization.ReadOrgConfiguration"
+	return client.DoOne[OrgConfiguration](ctx, s.Client, r)
 }
 
 func (s *ServiceOp) UpsertOrgConfiguration(ctx context.Context, input *OrgConfiguration) (*OrgConfiguration, error) {
 	r := client.NewRequest(http.MethodPut, baseUrl+configurationUrl)
+	r.Operation = "organization.UpsertOrgConfiguration"
 	r.Obj = input
 
-	resp, err := client.RequireOK(s.Client.Do(ctx, r))
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	orgConfiguration, err := orgConfigurationsFromHttpResponse(resp)
-	if err != nil {
-		return nil, err
-	}
-
-	output := new(OrgConfiguration)
-	if len(orgConfiguration) > 0 {
-		output = orgConfiguration[0]
-	}
-
-	return output, nil
+	return client.DoOne[OrgConfiguration](ctx, s.Client, r)
 }
 
 func (s *ServiceOp) DeleteOrgConfiguration(ctx context.Context) (*commons.EmptyResponse, error) {
 	r := client.NewRequest(http.MethodDelete, baseUrl+configurationUrl)
+	r.Operation = "organization.DeleteOrgConfiguration"
 	resp, err := client.RequireOK(s.Client.Do(ctx, r))
 	if err != nil {
 		return nil, err
@@ -167,45 +136,6 @@ func (s *ServiceOp) DeleteOrgConfiguration(ctx context.Context) (*commons.EmptyR
 
 //endregion
 
-//region Private Methods
-
-func orgConfigurationFromJSON(in []byte) (*OrgConfiguration, error) {
-	b := new(OrgConfiguration)
-	if err := json.Unmarshal(in, b); err != nil {
-		return nil, err
-	}
-	return b, nil
-}
-
-func orgConfigurationsFromJSON(in []byte) ([]*OrgConfiguration, error) {
-	var rw client.Response
-	if err := json.Unmarshal(in, &rw); err != nil {
-		return nil, err
-	}
-	out := make([]*OrgConfiguration, len(rw.Response.Items))
-	if len(out) == 0 {
-		return out, nil
-	}
-	for i, rb := range rw.Response.Items {
-		b, err := orgConfigurationFromJSON(rb)
-		if err != nil {
-			return nil, err
-		}
-		out[i] = b
-	}
-	return out, nil
-}
-
-func orgConfigurationsFromHttpResponse(resp *http.Response) ([]*OrgConfiguration, error) {
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return nil, err
-	}
-	return orgConfigurationsFromJSON(body)
-}
-
-//endregion
-
 //region Setters
 
 //region OrgConfiguration
@@ -0,0 +1,131 @@
+// Code generated by mockgen. DO NOT EDIT.
+
+// Package mocks provides a mock implementation of run_task.Service.
+package mocks
+
+import (
+	"context"
+
+	"github.com/control-monkey/controlmonkey-sdk-go/controlmonkey/client"
+	"github.com/control-monkey/controlmonkey-sdk-go/controlmonkey/testing/mock"
+	"github.com/control-monkey/controlmonkey-sdk-go/services/commons"
+	"github.com/control-monkey/controlmonkey-sdk-go/services/run_task"
+)
+
+// Service is a mock implementation of run_task.Service. Every call is recorded, and
+// answered by the matching Func field if set, or with zero values and
+// mock.ErrNotStubbed otherwise. Use the On methods to stub canned responses.
+type Service struct {
+	mock.Recorder
+
+	CreateRunTaskFunc         func(context.Context, *run_task.RunTask) (*run_task.RunTask, error)
+	ListRunTasksFunc          func(context.Context, *string, *string) ([]*run_task.RunTask, error)
+	ListRunTasksPaginatorFunc func(*string, *string, *client.PageOptions) client.Pager[run_task.RunTask]
+	ReadRunTaskFunc           func(context.Context, string) (*run_task.RunTask, error)
+	UpdateRunTaskFunc         func(context.Context, string, *run_task.RunTask) (*run_task.RunTask, error)
+	DeleteRunTaskFunc         func(context.Context, string) (*commons.EmptyResponse, error)
+}
+
+var _ run_task.Service = &Service{}
+
+// CreateRunTask records the call and calls CreateRunTaskFunc.
+func (m *Service) CreateRunTask(ctx context.Context, a0 *run_task.RunTask) (*run_task.RunTask, error) {
+	m.Record("CreateRunTask", a0)
+	if m.CreateRunTaskFunc == nil {
+		return nil, mock.ErrNotStubbed
+	}
+	return m.CreateRunTaskFunc(ctx, a0)
+}
+
+// OnCreateRunTask stubs CreateRunTask to return the given values.
+func (m *Service) OnCreateRunTask(r0 *run_task.RunTask, r1 error) *Service {
+	m.CreateRunTaskFunc = func(context.Context, *run_task.RunTask) (*run_task.RunTask, error) {
+		return r0, r1
+	}
+	return m
+}
+
+// ListRunTasks records the call and calls ListRunTasksFunc.
+func (m *Service) ListRunTasks(ctx context.Context, a0 *string, a1 *string) ([]*run_task.RunTask, error) {
+	m.Record("ListRunTasks", a0, a1)
+	if m.ListRunTasksFunc == nil {
+		return nil, mock.ErrNotStubbed
+	}
+	return m.ListRunTasksFunc(ctx, a0, a1)
+}
+
+// OnListRunTasks stubs ListRunTasks to return the given values.
+func (m *Service) OnListRunTasks(r0 []*run_task.RunTask, r1 error) *Service {
+	m.ListRunTasksFunc = func(context.Context, *string, *string) ([]*run_task.RunTask, error) {
+		return r0, r1
+	}
+	return m
+}
+
+// ListRunTasksPaginator records the call and calls ListRunTasksPaginatorFunc.
+func (m *Service) ListRunTasksPaginator(a0 *string, a1 *string, a2 *client.PageOptions) client.Pager[run_task.RunTask] {
+	m.Record("ListRunTasksPaginator", a0, a1, a2)
+	if m.ListRunTasksPaginatorFunc == nil {
+		return client.NewSlicePager[run_task.RunTask](nil, mock.ErrNotStubbed)
+	}
+	return m.ListRunTasksPaginatorFunc(a0, a1, a2)
+}
+
+// OnListRunTasksPaginator stubs ListRunTasksPaginator to return the given values.
+func (m *Service) OnListRunTasksPaginator(r0 client.Pager[run_task.RunTask]) *Service {
+	m.ListRunTasksPaginatorFunc = func(*string, *string, *client.PageOptions) client.Pager[run_task.RunTask] {
+		return r0
+	}
+	return m
+}
+
+// ReadRunTask records the call and calls ReadRunTaskFunc.
+func (m *Service) ReadRunTask(ctx context.Context, a0 string) (*run_task.RunTask, error) {
+	m.Record("ReadRunTask", a0)
+	if m.ReadRunTaskFunc == nil {
+		return nil, mock.ErrNotStubbed
+	}
+	return m.ReadRunTaskFunc(ctx, a0)
+}
+
+// OnReadRunTask stubs ReadRunTask to return the given values.
+func (m *Service) OnReadRunTask(r0 *run_task.RunTask, r1 error) *Service {
+	m.ReadRunTaskFunc = func(context.Context, string) (*run_task.RunTask, error) {
+		return r0, r1
+	}
+	return m
+}
+
+// UpdateRunTask records the call and calls UpdateRunTaskFunc.
+func (m *Service) UpdateRunTask(ctx context.Context, a0 string, a1 *run_task.RunTask) (*run_task.RunTask, error) {
+	m.Record("UpdateRunTask", a0, a1)
+	if m.UpdateRunTaskFunc == nil {
+		return nil, mock.ErrNotStubbed
+	}
+	return m.UpdateRunTaskFunc(ctx, a0, a1)
+}
+
+// OnUpdateRunTask stubs UpdateRunTask to return the given values.
+func (m *Service) OnUpdateRunTask(r0 *run_task.RunTask, r1 error) *Service {
+	m.UpdateRunTaskFunc = func(context.Context, string, *run_task.RunTask) (*run_task.RunTask, error) {
+		return r0, r1
+	}
+	return m
+}
+
+// DeleteRunTask records the call and calls DeleteRunTaskFunc.
+func (m *Service) DeleteRunTask(ctx context.Context, a0 string) (*commons.EmptyResponse, error) {
+	m.Record("DeleteRunTask", a0)
+	if m.DeleteRunTaskFunc == nil {
+		return nil, mock.ErrNotStubbed
+	}
+	return m.DeleteRunTaskFunc(ctx, a0)
+}
+
+// OnDeleteRunTask stubs DeleteRunTask to return the given values.
+func (m *Service) OnDeleteRunTask(r0 *commons.EmptyResponse, r1 error) *Service {
+	m.DeleteRunTaskFunc = func(context.Context, string) (*commons.EmptyResponse, error) {
+		return r0, r1
+	}
+	return m
+}
@@ -2,8 +2,6 @@ package run_task
 
 import (
 	"context"
-	"encoding/json"
-	"io"
 	"net/http"
 
 	"github.com/control-monkey/controlmonkey-sdk-go/controlmonkey/client"
@@ -34,29 +32,27 @@ type RunTask struct {
 
 func (s *ServiceOp) CreateRunTask(ctx context.Context, input *RunTask) (*RunTask, error) {
 	r := client.NewRequest(http.MethodPost, "/runTask")
+	r.Operation = "run_task.CreateRunTask"
 	r.Obj = input
 
-	resp, err := client.RequireOK(s.Client.Do(ctx, r))
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
+	return client.DoOne[RunTask](ctx, s.Client, r)
+}
 
-	runTasks, err := runTasksFromHttpResponse(resp)
-	if err != nil {
-		return nil, err
-	}
+func (s *ServiceOp) ListRunTasks(ctx context.Context, runTaskId *string, runTaskName *string) ([]*RunTask, error) {
+	r := listRunTasksRequest(runTaskId, runTaskName)
 
-	output := new(RunTask)
-	if len(runTasks) > 0 {
-		output = runTasks[0]
-	}
+	return client.DoItems[RunTask](ctx, s.Client, r)
+}
 
-	return output, nil
+func (s *ServiceOp) ListRunTasksPaginator(runTaskId *string, runTaskName *string, opts *client.PageOptions) client.Pager[RunTask] {
+	return client.NewPaginator[RunTask](s.Client, func() *client.Request {
+		return listRunTasksRequest(runTaskId, runTaskName)
+	}, opts)
 }
 
-func (s *ServiceOp) ListRunTasks(ctx context.Context, runTaskId *string, runTaskName *string) ([]*RunTask, error) {
+func listRunTasksRequest(runTaskId *string, runTaskName *string) *client.Request {
 	r := client.NewRequest(http.MethodGet, "/runTask")
+	r.Operation = "run_task.ListRunTasks"
 
 	if runTaskId != nil {
 		r.Params.Set("runTaskId", *runTaskId)
@@ -65,13 +61,7 @@ func (s *ServiceOp) ListRunTasks(ctx context.Context, runTaskId *string, runTask
 		r.Params.Set("runTaskName", *runTaskName)
 	}
 
-	resp, err := client.RequireOK(s.Client.Do(ctx, r))
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	return runTasksFromHttpResponse(resp)
+	return r
 }
 
 func (s *ServiceOp) ReadRunTask(ctx context.Context, runTaskId string) (*RunTask, error) {
@@ -81,23 +71,8 @@ func (s *ServiceOp) ReadRunTask(ctx context.Context, runTaskId string) (*RunTask
 	}
 
 	r := client.NewRequest(http.MethodGet, path)
-	resp, err := client.RequireOK(s.Client.Do(ctx, r))
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	runTasks, err := runTasksFromHttpResponse(resp)
-	if err != nil {
-		return nil, err
-	}
-
-	output := new(RunTask)
-	if len(runTasks) > 0 {
-		output = runTasks[0]
-	}
-
-	return output, nil
+	r.Operation = "run_task.ReadRunTask"
+	return client.DoOne[RunTask](ctx, s.Client, r)
 }
 
 func (s *ServiceOp) UpdateRunTask(ctx context.Context, runTaskId string, input *RunTask) (*RunTask, error) {
@@ -107,25 +82,10 @@ func (s *ServiceOp) UpdateRunTask(ctx context.Context, runTaskId string, input *
 	}
 
 	r := client.NewRequest(http.MethodPut, path)
+	r.Operation = "run_task.UpdateRunTask"
 	r.Obj = input
 
-	resp, err := client.RequireOK(s.Client.Do(ctx, r))
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	runTasks, err := runTasksFromHttpResponse(resp)
-	if err != nil {
-		return nil, err
-	}
-
-	output := new(RunTask)
-	if len(runTasks) > 0 {
-		output = runTasks[0]
-	}
-
-	return output, nil
+	return client.DoOne[RunTask](ctx, s.Client, r)
 }
 
 func (s *ServiceOp) DeleteRunTask(ctx context.Context, runTaskId string) (*commons.EmptyResponse, error) {
@@ -135,6 +95,7 @@ func (s *ServiceOp) DeleteRunTask(ctx context.Context, runTaskId string) (*commo
 	}
 
 	r := client.NewRequest(http.MethodDelete, path)
+	r.Operation = "run_task.DeleteRunTask"
 	resp, err := client.RequireOK(s.Client.Do(ctx, r))
 	if err != nil {
 		return nil, err
@@ -147,45 +108,6 @@ func (s *ServiceOp) DeleteRunTask(ctx context.Context, runTaskId string) (*commo
 
 //endregion
 
-//region Private Methods
-
-func runTaskFromJSON(in []byte) (*RunTask, error) {
-	b := new(RunTask)
-	if err := json.Unmarshal(in, b); err != nil {
-		return nil, err
-	}
-	return b, nil
-}
-
-func runTasksFromJSON(in []byte) ([]*RunTask, error) {
-	var rw client.Response
-	if err := json.Unmarshal(in, &rw); err != nil {
-		return nil, err
-	}
-	out := make([]*RunTask, len(rw.Response.Items))
-	if len(out) == 0 {
-		return out, nil
-	}
-	for i, rb := range rw.Response.Items {
-		b, err := runTaskFromJSON(rb)
-		if err != nil {
-			return nil, err
-		}
-		out[i] = b
-	}
-	return out, nil
-}
-
-func runTasksFromHttpResponse(resp *http.Response) ([]*RunTask, error) {
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return nil, err
-	}
-	return runTasksFromJSON(body)
-}
-
-//endregion
-
 //region Setters
 
 //region RunTask
@@ -9,12 +9,15 @@ import (
 	"github.com/control-monkey/controlmonkey-sdk-go/services/commons"
 )
 
+//go:generate go run ../../internal/mockgen
+
 // Service provides the API operation methods for making requests to endpoints
 // of the ControlMonkey API. See this package's package overview docs for details on
 // the service.
 type Service interface {
 	CreateRunTask(context.Context, *RunTask) (*RunTask, error)
 	ListRunTasks(context.Context, *string, *string) ([]*RunTask, error)
+	ListRunTasksPaginator(*string, *string, *client.PageOptions) client.Pager[RunTask]
 	ReadRunTask(context.Context, string) (*RunTask, error)
 	UpdateRunTask(context.Context, string, *RunTask) (*RunTask, error)
 	DeleteRunTask(context.Context, string) (*commons.EmptyResponse, error)
@@ -32,6 +35,6 @@ func New(sess *session.Session, cfgs ...*controlmonkey.Config) Service {
 	cfg.Merge(cfgs...)
 
 	return &ServiceOp{
-		Client: client.New(sess.Config),
+		Client: client.New(cfg),
 	}
 }
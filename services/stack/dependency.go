@@ -2,8 +2,6 @@ package stack
 
 import (
 	"context"
-	"encoding/json"
-	"io"
 	"net/http"
 
 	"github.com/control-monkey/controlmonkey-sdk-go/controlmonkey/client"
@@ -42,26 +40,10 @@ type DependencyRef struct {
 
 func (s *ServiceOp) CreateDependency(ctx context.Context, input *Dependency) (*Dependency, error) {
 	r := client.NewRequest(http.MethodPost, "/stack/dependency")
+	r.Operation = "stack.CreateDependency"
 	r.Obj = input
 
-	resp, err := client.RequireOK(s.Client.Do(ctx, r))
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	items, err := dependenciesFromHttpResponse(resp)
-	if err != nil {
-		return nil, err
-	}
-
-	var out *Dependency
-	if len(items) > 0 {
-		out = items[0]
-	} else {
-		out = new(Dependency)
-	}
-	return out, nil
+	return client.DoOne[Dependency](ctx, s.Client, r)
 }
 
 func (s *ServiceOp) ReadDependency(ctx context.Context, dependencyId string) (*Dependency, error) {
@@ -71,24 +53,8 @@ func (s *ServiceOp) ReadDependency(ctx context.Context, dependencyId string) (*D
 	}
 
 	r := client.NewRequest(http.MethodGet, path)
-	resp, err := client.RequireOK(s.Client.Do(ctx, r))
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	items, err := dependenciesFromHttpResponse(resp)
-	if err != nil {
-		return nil, err
-	}
-
-	var out *Dependency
-	if len(items) > 0 {
-		out = items[0]
-	} else {
-		out = new(Dependency)
-	}
-	return out, nil
+	r.Operation = "stack.ReadDependency"
+	return client.DoOne[Dependency](ctx, s.Client, r)
 }
 
 func (s *ServiceOp) UpdateDependency(ctx context.Context, dependencyId string, input *Dependency) (*Dependency, error) {
@@ -98,26 +64,10 @@ func (s *ServiceOp) UpdateDependency(ctx context.Context, dependencyId string, i
 	}
 
 	r := client.NewRequest(http.MethodPut, path)
+	r.Operation = "stack.UpdateDependency"
 	r.Obj = input
 
-	resp, err := client.RequireOK(s.Client.Do(ctx, r))
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	items, err := dependenciesFromHttpResponse(resp)
-	if err != nil {
-		return nil, err
-	}
-
-	var out *Dependency
-	if len(items) > 0 {
-		out = items[0]
-	} else {
-		out = new(Dependency)
-	}
-	return out, nil
+	return client.DoOne[Dependency](ctx, s.Client, r)
 }
 
 func (s *ServiceOp) DeleteDependency(ctx context.Context, dependencyId string) (*commons.EmptyResponse, error) {
@@ -127,6 +77,7 @@ func (s *ServiceOp) DeleteDependency(ctx context.Context, dependencyId string) (
 	}
 
 	r := client.NewRequest(http.MethodDelete, path)
+	r.Operation = "stack.DeleteDependency"
 	resp, err := client.RequireOK(s.Client.Do(ctx, r))
 	if err != nil {
 		return nil, err
@@ -139,45 +90,6 @@ func (s *ServiceOp) DeleteDependency(ctx context.Context, dependencyId string) (
 
 // endregion
 
-// region Private Methods
-
-func dependencyFromJSON(in []byte) (*Dependency, error) {
-	b := new(Dependency)
-	if err := json.Unmarshal(in, b); err != nil {
-		return nil, err
-	}
-	return b, nil
-}
-
-func dependenciesFromJSON(in []byte) ([]*Dependency, error) {
-	var rw client.Response
-	if err := json.Unmarshal(in, &rw); err != nil {
-		return nil, err
-	}
-	out := make([]*Dependency, len(rw.Response.Items))
-	if len(out) == 0 {
-		return out, nil
-	}
-	for i, rb := range rw.Response.Items {
-		b, err := dependencyFromJSON(rb)
-		if err != nil {
-			return nil, err
-		}
-		out[i] = b
-	}
-	return out, nil
-}
-
-func dependenciesFromHttpResponse(resp *http.Response) ([]*Dependency, error) {
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return nil, err
-	}
-	return dependenciesFromJSON(body)
-}
-
-// endregion
-
 // region Setters
 
 func (o Dependency) MarshalJSON() ([]byte, error) {
@@ -0,0 +1,275 @@
+// Code generated by mockgen. DO NOT EDIT.
+
+// Package mocks provides a mock implementation of stack.Service.
+package mocks
+
+import (
+	"context"
+
+	"github.com/control-monkey/controlmonkey-sdk-go/controlmonkey/client"
+	"github.com/control-monkey/controlmonkey-sdk-go/controlmonkey/testing/mock"
+	"github.com/control-monkey/controlmonkey-sdk-go/services/commons"
+	"github.com/control-monkey/controlmonkey-sdk-go/services/stack"
+)
+
+// Service is a mock implementation of stack.Service. Every call is recorded, and
+// answered by the matching Func field if set, or with zero values and
+// mock.ErrNotStubbed otherwise. Use the On methods to stub canned responses.
+type Service struct {
+	mock.Recorder
+
+	CreateStackFunc         func(context.Context, *stack.Stack) (*stack.Stack, error)
+	ListStacksFunc          func(context.Context, *string, *string, *string) ([]*stack.Stack, error)
+	ListStacksPaginatorFunc func(*string, *string, *string, *client.PageOptions) client.Pager[stack.Stack]
+	ReadStackFunc           func(context.Context, string) (*stack.Stack, error)
+	UpdateStackFunc         func(context.Context, string, *stack.Stack) (*stack.Stack, error)
+	DeleteStackFunc         func(context.Context, string) (*commons.EmptyResponse, error)
+	CreateDeploymentFunc    func(context.Context, *stack.CreateDeploymentInput) (*stack.CreateDeploymentOutput, error)
+	ReadDeploymentFunc      func(context.Context, *stack.ReadDeploymentInput) (*stack.ReadDeploymentOutput, error)
+	CreatePlanFunc          func(context.Context, *stack.CreatePlanInput) (*stack.CreatePlanOutput, error)
+	ReadPlanFunc            func(context.Context, *stack.ReadPlanInput) (*stack.ReadPlanOutput, error)
+	CreateDependencyFunc    func(context.Context, *stack.Dependency) (*stack.Dependency, error)
+	ReadDependencyFunc      func(context.Context, string) (*stack.Dependency, error)
+	UpdateDependencyFunc    func(context.Context, string, *stack.Dependency) (*stack.Dependency, error)
+	DeleteDependencyFunc    func(context.Context, string) (*commons.EmptyResponse, error)
+}
+
+var _ stack.Service = &Service{}
+
+// CreateStack records the call and calls CreateStackFunc.
+func (m *Service) CreateStack(ctx context.Context, a0 *stack.Stack) (*stack.Stack, error) {
+	m.Record("CreateStack", a0)
+	if m.CreateStackFunc == nil {
+		return nil, mock.ErrNotStubbed
+	}
+	return m.CreateStackFunc(ctx, a0)
+}
+
+// OnCreateStack stubs CreateStack to return the given values.
+func (m *Service) OnCreateStack(r0 *stack.Stack, r1 error) *Service {
+	m.CreateStackFunc = func(context.Context, *stack.Stack) (*stack.Stack, error) {
+		return r0, r1
+	}
+	return m
+}
+
+// ListStacks records the call and calls ListStacksFunc.
+func (m *Service) ListStacks(ctx context.Context, a0 *string, a1 *string, a2 *string) ([]*stack.Stack, error) {
+	m.Record("ListStacks", a0, a1, a2)
+	if m.ListStacksFunc == nil {
+		return nil, mock.ErrNotStubbed
+	}
+	return m.ListStacksFunc(ctx, a0, a1, a2)
+}
+
+// OnListStacks stubs ListStacks to return the given values.
+func (m *Service) OnListStacks(r0 []*stack.Stack, r1 error) *Service {
+	m.ListStacksFunc = func(context.Context, *string, *string, *string) ([]*stack.Stack, error) {
+		return r0, r1
+	}
+	return m
+}
+
+// ListStacksPaginator records the call and calls ListStacksPaginatorFunc.
+func (m *Service) ListStacksPaginator(a0 *string, a1 *string, a2 *string, a3 *client.PageOptions) client.Pager[stack.Stack] {
+	m.Record("ListStacksPaginator", a0, a1, a2, a3)
+	if m.ListStacksPaginatorFunc == nil {
+		return client.NewSlicePager[stack.Stack](nil, mock.ErrNotStubbed)
+	}
+	return m.ListStacksPaginatorFunc(a0, a1, a2, a3)
+}
+
+// OnListStacksPaginator stubs ListStacksPaginator to return the given values.
+func (m *Service) OnListStacksPaginator(r0 client.Pager[stack.Stack]) *Service {
+	m.ListStacksPaginatorFunc = func(*string, *string, *string, *client.PageOptions) client.Pager[stack.Stack] {
+		return r0
+	}
+	return m
+}
+
+// ReadStack records the call and calls ReadStackFunc.
+func (m *Service) ReadStack(ctx context.Context, a0 string) (*stack.Stack, error) {
+	m.Record("ReadStack", a0)
+	if m.ReadStackFunc == nil {
+		return nil, mock.ErrNotStubbed
+	}
+	return m.ReadStackFunc(ctx, a0)
+}
+
+// OnReadStack stubs ReadStack to return the given values.
+func (m *Service) OnReadStack(r0 *stack.Stack, r1 error) *Service {
+	m.ReadStackFunc = func(context.Context, string) (*stack.Stack, error) {
+		return r0, r1
+	}
+	return m
+}
+
+// UpdateStack records the call and calls UpdateStackFunc.
+func (m *Service) UpdateStack(ctx context.Context, a0 string, a1 *stack.Stack) (*stack.Stack, error) {
+	m.Record("UpdateStack", a0, a1)
+	if m.UpdateStackFunc == nil {
+		return nil, mock.ErrNotStubbed
+	}
+	return m.UpdateStackFunc(ctx, a0, a1)
+}
+
+// OnUpdateStack stubs UpdateStack to return the given values.
+func (m *Service) OnUpdateStack(r0 *stack.Stack, r1 error) *Service {
+	m.UpdateStackFunc = func(context.Context, string, *stack.Stack) (*stack.Stack, error) {
+		return r0, r1
+	}
+	return m
+}
+
+// DeleteStack records the call and calls DeleteStackFunc.
+func (m *Service) DeleteStack(ctx context.Context, a0 string) (*commons.EmptyResponse, error) {
+	m.Record("DeleteStack", a0)
+	if m.DeleteStackFunc == nil {
+		return nil, mock.ErrNotStubbed
+	}
+	return m.DeleteStackFunc(ctx, a0)
+}
+
+// OnDeleteStack stubs DeleteStack to return the given values.
+func (m *Service) OnDeleteStack(r0 *commons.EmptyResponse, r1 error) *Service {
+	m.DeleteStackFunc = func(context.Context, string) (*commons.EmptyResponse, error) {
+		return r0, r1
+	}
+	return m
+}
+
+// CreateDeployment records the call and calls CreateDeploymentFunc.
+func (m *Service) CreateDeployment(ctx context.Context, a0 *stack.CreateDeploymentInput) (*stack.CreateDeploymentOutput, error) {
+	m.Record("CreateDeployment", a0)
+	if m.CreateDeploymentFunc == nil {
+		return nil, mock.ErrNotStubbed
+	}
+	return m.CreateDeploymentFunc(ctx, a0)
+}
+
+// OnCreateDeployment stubs CreateDeployment to return the given values.
+func (m *Service) OnCreateDeployment(r0 *stack.CreateDeploymentOutput, r1 error) *Service {
+	m.CreateDeploymentFunc = func(context.Context, *stack.CreateDeploymentInput) (*stack.CreateDeploymentOutput, error) {
+		return r0, r1
+	}
+	return m
+}
+
+// ReadDeployment records the call and calls ReadDeploymentFunc.
+func (m *Service) ReadDeployment(ctx context.Context, a0 *stack.ReadDeploymentInput) (*stack.ReadDeploymentOutput, error) {
+	m.Record("ReadDeployment", a0)
+	if m.ReadDeploymentFunc == nil {
+		return nil, mock.ErrNotStubbed
+	}
+	return m.ReadDeploymentFunc(ctx, a0)
+}
+
+// OnReadDeployment stubs ReadDeployment to return the given values.
+func (m *Service) OnReadDeployment(r0 *stack.ReadDeploymentOutput, r1 error) *Service {
+	m.ReadDeploymentFunc = func(context.Context, *stack.ReadDeploymentInput) (*stack.ReadDeploymentOutput, error) {
+		return r0, r1
+	}
+	return m
+}
+
+// CreatePlan records the call and calls CreatePlanFunc.
+func (m *Service) CreatePlan(ctx context.Context, a0 *stack.CreatePlanInput) (*stack.CreatePlanOutput, error) {
+	m.Record("CreatePlan", a0)
+	if m.CreatePlanFunc == nil {
+		return nil, mock.ErrNotStubbed
+	}
+	return m.CreatePlanFunc(ctx, a0)
+}
+
+// OnCreatePlan stubs CreatePlan to return the given values.
+func (m *Service) OnCreatePlan(r0 *stack.CreatePlanOutput, r1 error) *Service {
+	m.CreatePlanFunc = func(context.Context, *stack.CreatePlanInput) (*stack.CreatePlanOutput, error) {
+		return r0, r1
+	}
+	return m
+}
+
+// ReadPlan records the call and calls ReadPlanFunc.
+func (m *Service) ReadPlan(ctx context.Context, a0 *stack.ReadPlanInput) (*stack.ReadPlanOutput, error) {
+	m.Record("ReadPlan", a0)
+	if m.ReadPlanFunc == nil {
+		return nil, mock.ErrNotStubbed
+	}
+	return m.ReadPlanFunc(ctx, a0)
+}
+
+// OnReadPlan stubs ReadPlan to return the given values.
+func (m *Service) OnReadPlan(r0 *stack.ReadPlanOutput, r1 error) *Service {
+	m.ReadPlanFunc = func(context.Context, *stack.ReadPlanInput) (*stack.ReadPlanOutput, error) {
+		return r0, r1
+	}
+	return m
+}
+
+// CreateDependency records the call and calls CreateDependencyFunc.
+func (m *Service) CreateDependency(ctx context.Context, a0 *stack.Dependency) (*stack.Dependency, error) {
+	m.Record("CreateDependency", a0)
+	if m.CreateDependencyFunc == nil {
+		return nil, mock.ErrNotStubbed
+	}
+	return m.CreateDependencyFunc(ctx, a0)
+}
+
+// OnCreateDependency stubs CreateDependency to return the given values.
+func (m *Service) OnCreateDependency(r0 *stack.Dependency, r1 error) *Service {
+	m.CreateDependencyFunc = func(context.Context, *stack.Dependency) (*stack.Dependency, error) {
+		return r0, r1
+	}
+	return m
+}
+
+// ReadDependency records the call and calls ReadDependencyFunc.
+func (m *Service) ReadDependency(ctx context.Context, a0 string) (*stack.Dependency, error) {
+	m.Record("ReadDependency", a0)
+	if m.ReadDependencyFunc == nil {
+		return nil, mock.ErrNotStubbed
+	}
+	return m.ReadDependencyFunc(ctx, a0)
+}
+
+// OnReadDependency stubs ReadDependency to return the given values.
+func (m *Service) OnReadDependency(r0 *stack.Dependency, r1 error) *Service {
+	m.ReadDependencyFunc = func(context.Context, string) (*stack.Dependency, error) {
+		return r0, r1
+	}
+	return m
+}
+
+// UpdateDependency records the call and calls UpdateDependencyFunc.
+func (m *Service) UpdateDependency(ctx context.Context, a0 string, a1 *stack.Dependency) (*stack.Dependency, error) {
+	m.Record("UpdateDependency", a0, a1)
+	if m.UpdateDependencyFunc == nil {
+		return nil, mock.ErrNotStubbed
+	}
+	return m.UpdateDependencyFunc(ctx, a0, a1)
+}
+
+// OnUpdateDependency stubs UpdateDependency to return the given values.
+func (m *Service) OnUpdateDependency(r0 *stack.Dependency, r1 error) *Service {
+	m.UpdateDependencyFunc = func(context.Context, string, *stack.Dependency) (*stack.Dependency, error) {
+		return r0, r1
+	}
+	return m
+}
+
+// DeleteDependency records the call and calls DeleteDependencyFunc.
+func (m *Service) DeleteDependency(ctx context.Context, a0 string) (*commons.EmptyResponse, error) {
+	m.Record("DeleteDependency", a0)
+	if m.DeleteDependencyFunc == nil {
+		return nil, mock.ErrNotStubbed
+	}
+	return m.DeleteDependencyFunc(ctx, a0)
+}
+
+// OnDeleteDependency stubs DeleteDependency to return the given values.
+func (m *Service) OnDeleteDependency(r0 *commons.EmptyResponse, r1 error) *Service {
+	m.DeleteDependencyFunc = func(context.Context, string) (*commons.EmptyResponse, error) {
+		return r0, r1
+	}
+	return m
+}
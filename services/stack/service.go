@@ -9,12 +9,15 @@ import (
 	"github.com/control-monkey/controlmonkey-sdk-go/services/commons"
 )
 
+//go:generate go run ../../internal/mockgen
+
 // Service provides the API operation methods for making requests to endpoints
 // of the ControlMonkey API. See this package's package overview docs for details on
 // the service.
 type Service interface {
 	CreateStack(context.Context, *Stack) (*Stack, error)
 	ListStacks(context.Context, *string, *string, *string) ([]*Stack, error)
+	ListStacksPaginator(*string, *string, *string, *client.PageOptions) client.Pager[Stack]
 	ReadStack(context.Context, string) (*Stack, error)
 	UpdateStack(context.Context, string, *Stack) (*Stack, error)
 	DeleteStack(context.Context, string) (*commons.EmptyResponse, error)
@@ -43,6 +46,6 @@ func New(sess *session.Session, cfgs ...*controlmonkey.Config) Service {
 	cfg.Merge(cfgs...)
 
 	return &ServiceOp{
-		Client: client.New(sess.Config),
+		Client: client.New(cfg),
 	}
 }
@@ -2,8 +2,6 @@ package stack
 
 import (
 	"context"
-	"encoding/json"
-	"io"
 	"net/http"
 	"time"
 
@@ -132,29 +130,32 @@ type CreateStackInput struct {
 
 func (s *ServiceOp) CreateStack(ctx context.Context, input *Stack) (*Stack, error) {
 	r := client.NewRequest(http.MethodPost, "/stack")
+	r.Operation = "stack.CreateStack"
 	r.Obj = CreateStackInput{input}
 
-	resp, err := client.RequireOK(s.Client.Do(ctx, r))
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
+	return client.DoOne[Stack](ctx, s.Client, r)
+}
+
+func (s *ServiceOp) ListStacks(ctx context.Context, stackId *string, stackName *string, namespaceId *string) ([]*Stack, error) {
+	r := listStacksRequest(stackId, stackName, namespaceId)
 
-	stack, err := stacksFromHttpResponse(resp)
+	output, err := client.DoItems[Stack](ctx, s.Client, r)
 	if err != nil {
 		return nil, err
 	}
 
-	output := new(Stack)
-	if len(stack) > 0 {
-		output = stack[0]
-	}
-
 	return output, nil
 }
 
-func (s *ServiceOp) ListStacks(ctx context.Context, stackId *string, stackName *string, namespaceId *string) ([]*Stack, error) {
+func (s *ServiceOp) ListStacksPaginator(stackId *string, stackName *string, namespaceId *string, opts *client.PageOptions) client.Pager[Stack] {
+	return client.NewPaginator[Stack](s.Client, func() *client.Request {
+		return listStacksRequest(stackId, stackName, namespaceId)
+	}, opts)
+}
+
+func listStacksRequest(stackId *string, stackName *string, namespaceId *string) *client.Request {
 	r := client.NewRequest(http.MethodGet, "/stack")
+	r.Operation = "stack.ListStacks"
 
 	if stackId != nil {
 		r.Params.Set("stackId", *stackId)
@@ -166,18 +167,7 @@ func (s *ServiceOp) ListStacks(ctx context.Context, stackId *string, stackName *
 		r.Params.Set("namespaceId", *namespaceId)
 	}
 
-	resp, err := client.RequireOK(s.Client.Do(ctx, r))
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	output, err := stacksFromHttpResponse(resp)
-	if err != nil {
-		return nil, err
-	}
-
-	return output, nil
+	return r
 }
 
 func (s *ServiceOp) ReadStack(ctx context.Context, stackId string) (*Stack, error) {
@@ -189,23 +179,8 @@ func (s *ServiceOp) ReadStack(ctx context.Context, stackId string) (*Stack, erro
 	}
 
 	r := client.NewRequest(http.MethodGet, path)
-	resp, err := client.RequireOK(s.Client.Do(ctx, r))
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	stack, err := stacksFromHttpResponse(resp)
-	if err != nil {
-		return nil, err
-	}
-
-	output := new(Stack)
-	if len(stack) > 0 {
-		output = stack[0]
-	}
-
-	return output, nil
+	r.Operation = "stack.ReadStack"
+	return client.DoOne[Stack](ctx, s.Client, r)
 }
 
 func (s *ServiceOp) UpdateStack(ctx context.Context, stackId string, input *Stack) (*Stack, error) {
@@ -215,25 +190,10 @@ func (s *ServiceOp) UpdateStack(ctx context.Context, stackId string, input *Stac
 	}
 
 	r := client.NewRequest(http.MethodPut, path)
+	r.Operation = "stack.UpdateStack"
 	r.Obj = input
 
-	resp, err := client.RequireOK(s.Client.Do(ctx, r))
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	stack, err := stacksFromHttpResponse(resp)
-	if err != nil {
-		return nil, err
-	}
-
-	output := new(Stack)
-	if len(stack) > 0 {
-		output = stack[0]
-	}
-
-	return output, nil
+	return client.DoOne[Stack](ctx, s.Client, r)
 }
 
 func (s *ServiceOp) DeleteStack(ctx context.Context, stackId string) (*commons.EmptyResponse, error) {
@@ -243,6 +203,7 @@ func (s *ServiceOp) DeleteStack(ctx context.Context, stackId string) (*commons.E
 	}
 
 	r := client.NewRequest(http.MethodDelete, path)
+	r.Operation = "stack.DeleteStack"
 	resp, err := client.RequireOK(s.Client.Do(ctx, r))
 	if err != nil {
 		return nil, err
@@ -255,45 +216,6 @@ func (s *ServiceOp) DeleteStack(ctx context.Context, stackId string) (*commons.E
 
 //endregion
 
-//region Private Methods
-
-func stackFromJSON(in []byte) (*Stack, error) {
-	b := new(Stack)
-	if err := json.Unmarshal(in, b); err != nil {
-		return nil, err
-	}
-	return b, nil
-}
-
-func stacksFromJSON(in []byte) ([]*Stack, error) {
-	var rw client.Response
-	if err := json.Unmarshal(in, &rw); err != nil {
-		return nil, err
-	}
-	out := make([]*Stack, len(rw.Response.Items))
-	if len(out) == 0 {
-		return out, nil
-	}
-	for i, rb := range rw.Response.Items {
-		b, err := stackFromJSON(rb)
-		if err != nil {
-			return nil, err
-		}
-		out[i] = b
-	}
-	return out, nil
-}
-
-func stacksFromHttpResponse(resp *http.Response) ([]*Stack, error) {
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return nil, err
-	}
-	return stacksFromJSON(body)
-}
-
-//endregion
-
 //region Setters
 
 //region Stack
@@ -679,62 +601,17 @@ type ReadPlanOutput struct {
 	Plan *Plan `json:"plan,omitempty"`
 }
 
-func planFromJSON(in []byte) (*Plan, error) {
-	b := new(Plan)
-	if err := json.Unmarshal(in, b); err != nil {
-		return nil, err
-	}
-	return b, nil
-}
-
-func plansFromJSON(in []byte) ([]*Plan, error) {
-	var rw client.Response
-	if err := json.Unmarshal(in, &rw); err != nil {
-		return nil, err
-	}
-	out := make([]*Plan, len(rw.Response.Items))
-	if len(out) == 0 {
-		return out, nil
-	}
-	for i, rb := range rw.Response.Items {
-		b, err := planFromJSON(rb)
-		if err != nil {
-			return nil, err
-		}
-		out[i] = b
-	}
-	return out, nil
-}
-
-func plansFromHttpResponse(resp *http.Response) ([]*Plan, error) {
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return nil, err
-	}
-	return plansFromJSON(body)
-}
-
 func (s *ServiceOp) CreatePlan(ctx context.Context, input *CreatePlanInput) (*CreatePlanOutput, error) {
 	r := client.NewRequest(http.MethodPost, "/stack/plan")
+	r.Operation = "stack.CreatePlan"
 	r.Obj = input
 
-	resp, err := client.RequireOK(s.Client.Do(ctx, r))
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	gs, err := plansFromHttpResponse(resp)
+	plan, err := client.DoOne[Plan](ctx, s.Client, r)
 	if err != nil {
 		return nil, err
 	}
 
-	output := new(CreatePlanOutput)
-	if len(gs) > 0 {
-		output.Plan = gs[0]
-	}
-
-	return output, nil
+	return &CreatePlanOutput{Plan: plan}, nil
 }
 
 func (s *ServiceOp) ReadPlan(ctx context.Context, input *ReadPlanInput) (*ReadPlanOutput, error) {
@@ -746,23 +623,13 @@ func (s *ServiceOp) ReadPlan(ctx context.Context, input *ReadPlanInput) (*ReadPl
 	}
 
 	r := client.NewRequest(http.MethodGet, path)
-	resp, err := client.RequireOK(s.Client.Do(ctx, r))
+	r.Operation = "stack.ReadPlan"
+	plan, err := client.DoOne[Plan](ctx, s.Client, r)
 	if err != nil {
 		return nil, err
 	}
-	defer resp.Body.Close()
-
-	gs, err := plansFromHttpResponse(resp)
-	if err != nil {
-		return nil, err
-	}
-
-	output := new(ReadPlanOutput)
-	if len(gs) > 0 {
-		output.Plan = gs[0]
-	}
 
-	return output, nil
+	return &ReadPlanOutput{Plan: plan}, nil
 }
 
 func (o Plan) MarshalJSON() ([]byte, error) {
@@ -823,62 +690,17 @@ type ReadDeploymentOutput struct {
 	Deployment *Deployment `json:"deployment,omitempty"`
 }
 
-func deploymentFromJSON(in []byte) (*Deployment, error) {
-	b := new(Deployment)
-	if err := json.Unmarshal(in, b); err != nil {
-		return nil, err
-	}
-	return b, nil
-}
-
-func deploymentsFromJSON(in []byte) ([]*Deployment, error) {
-	var rw client.Response
-	if err := json.Unmarshal(in, &rw); err != nil {
-		return nil, err
-	}
-	out := make([]*Deployment, len(rw.Response.Items))
-	if len(out) == 0 {
-		return out, nil
-	}
-	for i, rb := range rw.Response.Items {
-		b, err := deploymentFromJSON(rb)
-		if err != nil {
-			return nil, err
-		}
-		out[i] = b
-	}
-	return out, nil
-}
-
-func deploymentsFromHttpResponse(resp *http.Response) ([]*Deployment, error) {
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return nil, err
-	}
-	return deploymentsFromJSON(body)
-}
-
 func (s *ServiceOp) CreateDeployment(ctx context.Context, input *CreateDeploymentInput) (*CreateDeploymentOutput, error) {
 	r := client.NewRequest(http.MethodPost, "/stack/deployment")
+	r.Operation = "stack.CreateDeployment"
 	r.Obj = input
 
-	resp, err := client.RequireOK(s.Client.Do(ctx, r))
+	deployment, err := client.DoOne[Deployment](ctx, s.Client, r)
 	if err != nil {
 		return nil, err
 	}
-	defer resp.Body.Close()
 
-	gs, err := deploymentsFromHttpResponse(resp)
-	if err != nil {
-		return nil, err
-	}
-
-	output := new(CreateDeploymentOutput)
-	if len(gs) > 0 {
-		output.Deployment = gs[0]
-	}
-
-	return output, nil
+	return &CreateDeploymentOutput{Deployment: deployment}, nil
 }
 
 func (s *ServiceOp) ReadDeployment(ctx context.Context, input *ReadDeploymentInput) (*ReadDeploymentOutput, error) {
@@ -890,23 +712,13 @@ func (s *ServiceOp) ReadDeployment(ctx context.Context, input *ReadDeploymentInp
 	}
 
 	r := client.NewRequest(http.MethodGet, path)
-	resp, err := client.RequireOK(s.Client.Do(ctx, r))
+	r.Operation = "stack.ReadDeployment"
+	deployment, err := client.DoOne[Deployment](ctx, s.Client, r)
 	if err != nil {
 		return nil, err
 	}
-	defer resp.Body.Close()
-
-	gs, err := deploymentsFromHttpResponse(resp)
-	if err != nil {
-		return nil, err
-	}
-
-	output := new(ReadDeploymentOutput)
-	if len(gs) > 0 {
-		output.Deployment = gs[0]
-	}
 
-	return output, nil
+	return &ReadDeploymentOutput{Deployment: deployment}, nil
 }
 
 func (o Deployment) MarshalJSON() ([]byte, error) {
@@ -0,0 +1,94 @@
+// Code generated by mockgen. DO NOT EDIT.
+
+// Package mocks provides a mock implementation of stack_discovery_configuration.Service.
+package mocks
+
+import (
+	"context"
+
+	"github.com/control-monkey/controlmonkey-sdk-go/controlmonkey/testing/mock"
+	"github.com/control-monkey/controlmonkey-sdk-go/services/commons"
+	"github.com/control-monkey/controlmonkey-sdk-go/services/stack_discovery_configuration"
+)
+
+// Service is a mock implementation of stack_discovery_configuration.Service. Every call is recorded, and
+// answered by the matching Func field if set, or with zero values and
+// mock.ErrNotStubbed otherwise. Use the On methods to stub canned responses.
+type Service struct {
+	mock.Recorder
+
+	CreateStackDiscoveryConfigurationFunc func(context.Context, *stack_discovery_configuration.StackDiscoveryConfiguration) (*stack_discovery_configuration.StackDiscoveryConfiguration, error)
+	ReadStackDiscoveryConfigurationFunc   func(context.Context, string) (*stack_discovery_configuration.StackDiscoveryConfiguration, error)
+	UpdateStackDiscoveryConfigurationFunc func(context.Context, string, *stack_discovery_configuration.StackDiscoveryConfiguration) (*stack_discovery_configuration.StackDiscoveryConfiguration, error)
+	DeleteStackDiscoveryConfigurationFunc func(context.Context, string) (*commons.EmptyResponse, error)
+}
+
+var _ stack_discovery_configuration.Service = &Service{}
+
+// CreateStackDiscoveryConfiguration records the call and calls CreateStackDiscoveryConfigurationFunc.
+func (m *Service) CreateStackDiscoveryConfiguration(ctx context.Context, a0 *stack_discovery_configuration.StackDiscoveryConfiguration) (*stack_discovery_configuration.StackDiscoveryConfiguration, error) {
+	m.Record("CreateStackDiscoveryConfiguration", a0)
+	if m.CreateStackDiscoveryConfigurationFunc == nil {
+		return nil, mock.ErrNotStubbed
+	}
+	return m.CreateStackDiscoveryConfigurationFunc(ctx, a0)
+}
+
+// OnCreateStackDiscoveryConfiguration stubs CreateStackDiscoveryConfiguration to return the given values.
+func (m *Service) OnCreateStackDiscoveryConfiguration(r0 *stack_discovery_configuration.StackDiscoveryConfiguration, r1 error) *Service {
+	m.CreateStackDiscoveryConfigurationFunc = func(context.Context, *stack_discovery_configuration.StackDiscoveryConfiguration) (*stack_discovery_configuration.StackDiscoveryConfiguration, error) {
+		return r0, r1
+	}
+	return m
+}
+
+// ReadStackDiscoveryConfiguration records the call and calls ReadStackDiscoveryConfigurationFunc.
+func (m *Service) ReadStackDiscoveryConfiguration(ctx context.Context, a0 string) (*stack_discovery_configuration.StackDiscoveryConfiguration, error) {
+	m.Record("ReadStackDiscoveryConfiguration", a0)
+	if m.ReadStackDiscoveryConfigurationFunc == nil {
+		return nil, mock.ErrNotStubbed
+	}
+	return m.ReadStackDiscoveryConfigurationFunc(ctx, a0)
+}
+
+// OnReadStackDiscoveryConfiguration stubs ReadStackDiscoveryConfiguration to return the given values.
+func (m *Service) OnReadStackDiscoveryConfiguration(r0 *stack_discovery_configuration.StackDiscoveryConfiguration, r1 error) *Service {
+	m.ReadStackDiscoveryConfigurationFunc = func(context.Context, string) (*stack_discovery_configuration.StackDiscoveryConfiguration, error) {
+		return r0, r1
+	}
+	return m
+}
+
+// UpdateStackDiscoveryConfiguration records the call and calls UpdateStackDiscoveryConfigurationFunc.
+func (m *Service) UpdateStackDiscoveryConfiguration(ctx context.Context, a0 string, a1 *stack_discovery_configuration.StackDiscoveryConfiguration) (*stack_discovery_configuration.StackDiscoveryConfiguration, error) {
+	m.Record("UpdateStackDiscoveryConfiguration", a0, a1)
+	if m.UpdateStackDiscoveryConfigurationFunc == nil {
+		return nil, mock.ErrNotStubbed
+	}
+	return m.UpdateStackDiscoveryConfigurationFunc(ctx, a0, a1)
+}
+
+// OnUpdateStackDiscoveryConfiguration stubs UpdateStackDiscoveryConfiguration to return the given values.
+func (m *Service) OnUpdateStackDiscoveryConfiguration(r0 *stack_discovery_configuration.StackDiscoveryConfiguration, r1 error) *Service {
+	m.UpdateStackDiscoveryConfigurationFunc = func(context.Context, string, *stack_discovery_configuration.StackDiscoveryConfiguration) (*stack_discovery_configuration.StackDiscoveryConfiguration, error) {
+		return r0, r1
+	}
+	return m
+}
+
+// DeleteStackDiscoveryConfiguration records the call and calls DeleteStackDiscoveryConfigurationFunc.
+func (m *Service) DeleteStackDiscoveryConfiguration(ctx context.Context, a0 string) (*commons.EmptyResponse, error) {
+	m.Record("DeleteStackDiscoveryConfiguration", a0)
+	if m.DeleteStackDiscoveryConfigurationFunc == nil {
+		return nil, mock.ErrNotStubbed
+	}
+	return m.DeleteStackDiscoveryConfigurationFunc(ctx, a0)
+}
+
+// OnDeleteStackDiscoveryConfiguration stubs DeleteStackDiscoveryConfiguration to return the given values.
+func (m *Service) OnDeleteStackDiscoveryConfiguration(r0 *commons.EmptyResponse, r1 error) *Service {
+	m.DeleteStackDiscoveryConfigurationFunc = func(context.Context, string) (*commons.EmptyResponse, error) {
+		return r0, r1
+	}
+	return m
+}
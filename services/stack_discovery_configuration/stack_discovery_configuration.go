@@ -2,8 +2,6 @@ package stack_discovery_configuration
 
 import (
 	"context"
-	"encoding/json"
-	"io"
 	"net/http"
 
 	"github.com/control-monkey/controlmonkey-sdk-go/controlmonkey/client"
@@ -59,26 +57,10 @@ type StackConfig struct {
 
 func (s *ServiceOp) CreateStackDiscoveryConfiguration(ctx context.Context, input *StackDiscoveryConfiguration) (*StackDiscoveryConfiguration, error) {
 	r := client.NewRequest(http.MethodPost, "/stackDiscoveryConfiguration")
+	r.Operation = "stack_discovery_configuration.CreateStackDiscoveryConfiguration"
 	r.Obj = input
 
-	resp, err := client.RequireOK(s.Client.Do(ctx, r))
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	items, err := stackDiscoveryConfigurationsFromHttpResponse(resp)
-	if err != nil {
-		return nil, err
-	}
-
-	var out *StackDiscoveryConfiguration
-	if len(items) > 0 {
-		out = items[0]
-	} else {
-		out = new(StackDiscoveryConfiguration)
-	}
-	return out, nil
+	return client.DoOne[StackDiscoveryConfiguration](ctx, s.Client, r)
 }
 
 func (s *ServiceOp) ReadStackDiscoveryConfiguration(ctx context.Context, configId string) (*StackDiscoveryConfiguration, error) {
@@ -88,24 +70,8 @@ func (s *ServiceOp) ReadStackDiscoveryConfiguration(ctx context.Context, configI
 	}
 
 	r := client.NewRequest(http.MethodGet, path)
-	resp, err := client.RequireOK(s.Client.Do(ctx, r))
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	items, err := stackDiscoveryConfigurationsFromHttpResponse(resp)
-	if err != nil {
-		return nil, err
-	}
-
-	var out *StackDiscoveryConfiguration
-	if len(items) > 0 {
-		out = items[0]
-	} else {
-		out = new(StackDiscoveryConfiguration)
-	}
-	return out, nil
+	r.Operation = "stack_discovery_configuration.ReadStackDiscoveryConfiguration"
+	return client.DoOne[StackDiscoveryConfiguration](ctx, s.Client, r)
 }
 
 func (s *ServiceOp) UpdateStackDiscoveryConfiguration(ctx context.Context, configId string, input *StackDiscoveryConfiguration) (*StackDiscoveryConfiguration, error) {
@@ -115,26 +81,10 @@ func (s *ServiceOp) UpdateStackDiscoveryConfiguration(ctx context.Context, confi
 	}
 
 	r := client.NewRequest(http.MethodPut, path)
+	r.Operation = "stack_discovery_configuration.UpdateStackDiscoveryConfiguration"
 	r.Obj = input
 
-	resp, err := client.RequireOK(s.Client.Do(ctx, r))
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	items, err := stackDiscoveryConfigurationsFromHttpResponse(resp)
-	if err != nil {
-		return nil, err
-	}
-
-	var out *StackDiscoveryConfiguration
-	if len(items) > 0 {
-		out = items[0]
-	} else {
-		out = new(StackDiscoveryConfiguration)
-	}
-	return out, nil
+	return client.DoOne[StackDiscoveryConfiguration](ctx, s.Client, r)
 }
 
 func (s *ServiceOp) DeleteStackDiscoveryConfiguration(ctx context.Context, configId string) (*commons.EmptyResponse, error) {
@@ -144,6 +94,7 @@ func (s *ServiceOp) DeleteStackDiscoveryConfiguration(ctx context.Context, confi
 	}
 
 	r := client.NewRequest(http.MethodDelete, path)
+	r.Operation = "stack_discovery_configuration.DeleteStackDiscoveryConfiguration"
 	resp, err := client.RequireOK(s.Client.Do(ctx, r))
 	if err != nil {
 		return nil, err
@@ -156,45 +107,6 @@ func (s *ServiceOp) DeleteStackDiscoveryConfiguration(ctx context.Context, confi
 
 // endregion
 
-// region Private Methods
-
-func stackDiscoveryConfigurationFromJSON(in []byte) (*StackDiscoveryConfiguration, error) {
-	b := new(StackDiscoveryConfiguration)
-	if err := json.Unmarshal(in, b); err != nil {
-		return nil, err
-	}
-	return b, nil
-}
-
-func stackDiscoveryConfigurationsFromJSON(in []byte) ([]*StackDiscoveryConfiguration, error) {
-	var rw client.Response
-	if err := json.Unmarshal(in, &rw); err != nil {
-		return nil, err
-	}
-	out := make([]*StackDiscoveryConfiguration, len(rw.Response.Items))
-	if len(out) == 0 {
-		return out, nil
-	}
-	for i, rb := range rw.Response.Items {
-		b, err := stackDiscoveryConfigurationFromJSON(rb)
-		if err != nil {
-			return nil, err
-		}
-		out[i] = b
-	}
-	return out, nil
-}
-
-func stackDiscoveryConfigurationsFromHttpResponse(resp *http.Response) ([]*StackDiscoveryConfiguration, error) {
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return nil, err
-	}
-	return stackDiscoveryConfigurationsFromJSON(body)
-}
-
-// endregion
-
 // region Setters
 
 func (o StackDiscoveryConfiguration) MarshalJSON() ([]byte, error) {
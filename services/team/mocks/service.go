@@ -0,0 +1,203 @@
+// Code generated by mockgen. DO NOT EDIT.
+
+// Package mocks provides a mock implementation of team.Service.
+package mocks
+
+import (
+	"context"
+
+	"github.com/control-monkey/controlmonkey-sdk-go/controlmonkey/client"
+	"github.com/control-monkey/controlmonkey-sdk-go/controlmonkey/testing/mock"
+	"github.com/control-monkey/controlmonkey-sdk-go/services/commons"
+	"github.com/control-monkey/controlmonkey-sdk-go/services/team"
+)
+
+// Service is a mock implementation of team.Service. Every call is recorded, and
+// answered by the matching Func field if set, or with zero values and
+// mock.ErrNotStubbed otherwise. Use the On methods to stub canned responses.
+type Service struct {
+	mock.Recorder
+
+	CreateTeamFunc             func(context.Context, *team.Team) (*team.Team, error)
+	ListTeamsFunc              func(context.Context, *string, *string) ([]*team.Team, error)
+	ListTeamsPaginatorFunc     func(*string, *string, *client.PageOptions) client.Pager[team.Team]
+	ReadTeamFunc               func(context.Context, string) (*team.Team, error)
+	UpdateTeamFunc             func(context.Context, string, *team.Team) (*team.Team, error)
+	DeleteTeamFunc             func(context.Context, string) (*commons.EmptyResponse, error)
+	ListTeamUsersFunc          func(context.Context, string) ([]*team.TeamUser, error)
+	ListTeamUsersPaginatorFunc func(string, *client.PageOptions) client.Pager[team.TeamUser]
+	CreateTeamUserFunc         func(context.Context, *team.TeamUser) (*commons.EmptyResponse, error)
+	DeleteTeamUserFunc         func(context.Context, *team.TeamUser) (*commons.EmptyResponse, error)
+}
+
+var _ team.Service = &Service{}
+
+// CreateTeam records the call and calls CreateTeamFunc.
+func (m *Service) CreateTeam(ctx context.Context, a0 *team.Team) (*team.Team, error) {
+	m.Record("CreateTeam", a0)
+	if m.CreateTeamFunc == nil {
+		return nil, mock.ErrNotStubbed
+	}
+	return m.CreateTeamFunc(ctx, a0)
+}
+
+// OnCreateTeam stubs CreateTeam to return the given values.
+func (m *Service) OnCreateTeam(r0 *team.Team, r1 error) *Service {
+	m.CreateTeamFunc = func(context.Context, *team.Team) (*team.Team, error) {
+		return r0, r1
+	}
+	return m
+}
+
+// ListTeams records the call and calls ListTeamsFunc.
+func (m *Service) ListTeams(ctx context.Context, a0 *string, a1 *string) ([]*team.Team, error) {
+	m.Record("ListTeams", a0, a1)
+	if m.ListTeamsFunc == nil {
+		return nil, mock.ErrNotStubbed
+	}
+	return m.ListTeamsFunc(ctx, a0, a1)
+}
+
+// OnListTeams stubs ListTeams to return the given values.
+func (m *Service) OnListTeams(r0 []*team.Team, r1 error) *Service {
+	m.ListTeamsFunc = func(context.Context, *string, *string) ([]*team.Team, error) {
+		return r0, r1
+	}
+	return m
+}
+
+// ListTeamsPaginator records the call and calls ListTeamsPaginatorFunc.
+func (m *Service) ListTeamsPaginator(a0 *string, a1 *string, a2 *client.PageOptions) client.Pager[team.Team] {
+	m.Record("ListTeamsPaginator", a0, a1, a2)
+	if m.ListTeamsPaginatorFunc == nil {
+		return client.NewSlicePager[team.Team](nil, mock.ErrNotStubbed)
+	}
+	return m.ListTeamsPaginatorFunc(a0, a1, a2)
+}
+
+// OnListTeamsPaginator stubs ListTeamsPaginator to return the given values.
+func (m *Service) OnListTeamsPaginator(r0 client.Pager[team.Team]) *Service {
+	m.ListTeamsPaginatorFunc = func(*string, *string, *client.PageOptions) client.Pager[team.Team] {
+		return r0
+	}
+	return m
+}
+
+// ReadTeam records the call and calls ReadTeamFunc.
+func (m *Service) ReadTeam(ctx context.Context, a0 string) (*team.Team, error) {
+	m.Record("ReadTeam", a0)
+	if m.ReadTeamFunc == nil {
+		return nil, mock.ErrNotStubbed
+	}
+	return m.ReadTeamFunc(ctx, a0)
+}
+
+// OnReadTeam stubs ReadTeam to return the given values.
+func (m *Service) OnReadTeam(r0 *team.Team, r1 error) *Service {
+	m.ReadTeamFunc = func(context.Context, string) (*team.Team, error) {
+		return r0, r1
+	}
+	return m
+}
+
+// UpdateTeam records the call and calls UpdateTeamFunc.
+func (m *Service) UpdateTeam(ctx context.Context, a0 string, a1 *team.Team) (*team.Team, error) {
+	m.Record("UpdateTeam", a0, a1)
+	if m.UpdateTeamFunc == nil {
+		return nil, mock.ErrNotStubbed
+	}
+	return m.UpdateTeamFunc(ctx, a0, a1)
+}
+
+// OnUpdateTeam stubs UpdateTeam to return the given values.
+func (m *Service) OnUpdateTeam(r0 *team.Team, r1 error) *Service {
+	m.UpdateTeamFunc = func(context.Context, string, *team.Team) (*team.Team, error) {
+		return r0, r1
+	}
+	return m
+}
+
+// DeleteTeam records the call and calls DeleteTeamFunc.
+func (m *Service) DeleteTeam(ctx context.Context, a0 string) (*commons.EmptyResponse, error) {
+	m.Record("DeleteTeam", a0)
+	if m.DeleteTeamFunc == nil {
+		return nil, mock.ErrNotStubbed
+	}
+	return m.DeleteTeamFunc(ctx, a0)
+}
+
+// OnDeleteTeam stubs DeleteTeam to return the given values.
+func (m *Service) OnDeleteTeam(r0 *commons.EmptyResponse, r1 error) *Service {
+	m.DeleteTeamFunc = func(context.Context, string) (*commons.EmptyResponse, error) {
+		return r0, r1
+	}
+	return m
+}
+
+// ListTeamUsers records the call and calls ListTeamUsersFunc.
+func (m *Service) ListTeamUsers(ctx context.Context, a0 string) ([]*team.TeamUser, error) {
+	m.Record("ListTeamUsers", a0)
+	if m.ListTeamUsersFunc == nil {
+		return nil, mock.ErrNotStubbed
+	}
+	return m.ListTeamUsersFunc(ctx, a0)
+}
+
+// OnListTeamUsers stubs ListTeamUsers to return the given values.
+func (m *Service) OnListTeamUsers(r0 []*team.TeamUser, r1 error) *Service {
+	m.ListTeamUsersFunc = func(context.Context, string) ([]*team.TeamUser, error) {
+		return r0, r1
+	}
+	return m
+}
+
+// ListTeamUsersPaginator records the call and calls ListTeamUsersPaginatorFunc.
+func (m *Service) ListTeamUsersPaginator(a0 string, a1 *client.PageOptions) client.Pager[team.TeamUser] {
+	m.Record("ListTeamUsersPaginator", a0, a1)
+	if m.ListTeamUsersPaginatorFunc == nil {
+		return client.NewSlicePager[team.TeamUser](nil, mock.ErrNotStubbed)
+	}
+	return m.ListTeamUsersPaginatorFunc(a0, a1)
+}
+
+// OnListTeamUsersPaginator stubs ListTeamUsersPaginator to return the given values.
+func (m *Service) OnListTeamUsersPaginator(r0 client.Pager[team.TeamUser]) *Service {
+	m.ListTeamUsersPaginatorFunc = func(string, *client.PageOptions) client.Pager[team.TeamUser] {
+		return r0
+	}
+	return m
+}
+
+// CreateTeamUser records the call and calls CreateTeamUserFunc.
+func (m *Service) CreateTeamUser(ctx context.Context, a0 *team.TeamUser) (*commons.EmptyResponse, error) {
+	m.Record("CreateTeamUser", a0)
+	if m.CreateTeamUserFunc == nil {
+		return nil, mock.ErrNotStubbed
+	}
+	return m.CreateTeamUserFunc(ctx, a0)
+}
+
+// OnCreateTeamUser stubs CreateTeamUser to return the given values.
+func (m *Service) OnCreateTeamUser(r0 *commons.EmptyResponse, r1 error) *Service {
+	m.CreateTeamUserFunc = func(context.Context, *team.TeamUser) (*commons.EmptyResponse, error) {
+		return r0, r1
+	}
+	return m
+}
+
+// DeleteTeamUser records the call and calls DeleteTeamUserFunc.
+func (m *Service) DeleteTeamUser(ctx context.Context, a0 *team.TeamUser) (*commons.EmptyResponse, error) {
+	m.Record("DeleteTeamUser", a0)
+	if m.DeleteTeamUserFunc == nil {
+		return nil, mock.ErrNotStubbed
+	}
+	return m.DeleteTeamUserFunc(ctx, a0)
+}
+
+// OnDeleteTeamUser stubs DeleteTeamUser to return the given values.
+func (m *Service) OnDeleteTeamUser(r0 *commons.EmptyResponse, r1 error) *Service {
+	m.DeleteTeamUserFunc = func(context.Context, *team.TeamUser) (*commons.EmptyResponse, error) {
+		return r0, r1
+	}
+	return m
+}
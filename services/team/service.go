@@ -9,17 +9,21 @@ import (
 	"github.com/control-monkey/controlmonkey-sdk-go/services/commons"
 )
 
+//go:generate go run ../../internal/mockgen
+
 // Service provides the API operation methods for making requests to endpoints
 // of the ControlMonkey API. See this package's package overview docs for details on
 // the service.
 type Service interface {
 	CreateTeam(context.Context, *Team) (*Team, error)
 	ListTeams(context.Context, *string, *string) ([]*Team, error)
+	ListTeamsPaginator(*string, *string, *client.PageOptions) client.Pager[Team]
 	ReadTeam(context.Context, string) (*Team, error)
 	UpdateTeam(context.Context, string, *Team) (*Team, error)
 	DeleteTeam(context.Context, string) (*commons.EmptyResponse, error)
 
 	ListTeamUsers(context.Context, string) ([]*TeamUser, error)
+	ListTeamUsersPaginator(string, *client.PageOptions) client.Pager[TeamUser]
 	CreateTeamUser(context.Context, *TeamUser) (*commons.EmptyResponse, error)
 	DeleteTeamUser(context.Context, *TeamUser) (*commons.EmptyResponse, error)
 }
@@ -36,6 +40,6 @@ func New(sess *session.Session, cfgs ...*controlmonkey.Config) Service {
 	cfg.Merge(cfgs...)
 
 	return &ServiceOp{
-		Client: client.New(sess.Config),
+		Client: client.New(cfg),
 	}
 }
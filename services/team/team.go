@@ -2,8 +2,6 @@ package team
 
 import (
 	"context"
-	"encoding/json"
-	"io"
 	"net/http"
 
 	"github.com/control-monkey/controlmonkey-sdk-go/controlmonkey/client"
@@ -44,29 +42,32 @@ type Team struct {
 
 func (s *ServiceOp) CreateTeam(ctx context.Context, input *Team) (*Team, error) {
 	r := client.NewRequest(http.MethodPost, "/iam/org/team")
+	r.Operation = "team.CreateTeam"
 	r.Obj = input
 
-	resp, err := client.RequireOK(s.Client.Do(ctx, r))
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
+	return client.DoOne[Team](ctx, s.Client, r)
+}
+
+func (s *ServiceOp) ListTeams(ctx context.Context, teamId *string, teamName *string) ([]*Team, error) {
+	r := listTeamsRequest(teamId, teamName)
 
-	team, err := teamsFromHttpResponse(resp)
+	output, err := client.DoItems[Team](ctx, s.Client, r)
 	if err != nil {
 		return nil, err
 	}
 
-	output := new(Team)
-	if len(team) > 0 {
-		output = team[0]
-	}
-
 	return output, nil
 }
 
-func (s *ServiceOp) ListTeams(ctx context.Context, teamId *string, teamName *string) ([]*Team, error) {
+func (s *ServiceOp) ListTeamsPaginator(teamId *string, teamName *string, opts *client.PageOptions) client.Pager[Team] {
+	return client.NewPaginator[Team](s.Client, func() *client.Request {
+		return listTeamsRequest(teamId, teamName)
+	}, opts)
+}
+
+func listTeamsRequest(teamId *string, teamName *string) *client.Request {
 	r := client.NewRequest(http.MethodGet, "/iam/org/team")
+	r.Operation = "team.ListTeams"
 
 	if teamId != nil {
 		r.Params.Set("teamId", *teamId)
@@ -75,18 +76,7 @@ func (s *ServiceOp) ListTeams(ctx context.Context, teamId *string, teamName *str
 		r.Params.Set("teamName", *teamName)
 	}
 
-	resp, err := client.RequireOK(s.Client.Do(ctx, r))
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	output, err := teamsFromHttpResponse(resp)
-	if err != nil {
-		return nil, err
-	}
-
-	return output, nil
+	return r
 }
 
 func (s *ServiceOp) ReadTeam(ctx context.Context, teamId string) (*Team, error) {
@@ -96,23 +86,8 @@ func (s *ServiceOp) ReadTeam(ctx context.Context, teamId string) (*Team, error)
 	}
 
 	r := client.NewRequest(http.MethodGet, path)
-	resp, err := client.RequireOK(s.Client.Do(ctx, r))
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	team, err := teamsFromHttpResponse(resp)
-	if err != nil {
-		return nil, err
-	}
-
-	output := new(Team)
-	if len(team) > 0 {
-		output = team[0]
-	}
-
-	return output, nil
+	r.Operation = "team.ReadTeam"
+	return client.DoOne[Team](ctx, s.Client, r)
 }
 
 func (s *ServiceOp) UpdateTeam(ctx context.Context, teamId string, input *Team) (*Team, error) {
@@ -122,25 +97,10 @@ func (s *ServiceOp) UpdateTeam(ctx context.Context, teamId string, input *Team)
 	}
 
 	r := client.NewRequest(http.MethodPut, path)
+	r.Operation = "team.UpdateTeam"
 	r.Obj = input
 
-	resp, err := client.RequireOK(s.Client.Do(ctx, r))
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	team, err := teamsFromHttpResponse(resp)
-	if err != nil {
-		return nil, err
-	}
-
-	output := new(Team)
-	if len(team) > 0 {
-		output = team[0]
-	}
-
-	return output, nil
+	return client.DoOne[Team](ctx, s.Client, r)
 }
 
 func (s *ServiceOp) DeleteTeam(ctx context.Context, teamId string) (*commons.EmptyResponse, error) {
@@ -150,6 +110,7 @@ func (s *ServiceOp) DeleteTeam(ctx context.Context, teamId string) (*commons.Emp
 	}
 
 	r := client.NewRequest(http.MethodDelete, path)
+	r.Operation = "team.DeleteTeam"
 	resp, err := client.RequireOK(s.Client.Do(ctx, r))
 	if err != nil {
 		return nil, err
@@ -162,45 +123,6 @@ func (s *ServiceOp) DeleteTeam(ctx context.Context, teamId string) (*commons.Emp
 
 //endregion
 
-//region Private Methods
-
-func teamFromJSON(in []byte) (*Team, error) {
-	b := new(Team)
-	if err := json.Unmarshal(in, b); err != nil {
-		return nil, err
-	}
-	return b, nil
-}
-
-func teamsFromJSON(in []byte) ([]*Team, error) {
-	var rw client.Response
-	if err := json.Unmarshal(in, &rw); err != nil {
-		return nil, err
-	}
-	out := make([]*Team, len(rw.Response.Items))
-	if len(out) == 0 {
-		return out, nil
-	}
-	for i, rb := range rw.Response.Items {
-		b, err := teamFromJSON(rb)
-		if err != nil {
-			return nil, err
-		}
-		out[i] = b
-	}
-	return out, nil
-}
-
-func teamsFromHttpResponse(resp *http.Response) ([]*Team, error) {
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return nil, err
-	}
-	return teamsFromJSON(body)
-}
-
-//endregion
-
 //region Setters
 
 func (o Team) MarshalJSON() ([]byte, error) {
@@ -3,7 +3,6 @@ package team
 import (
 	"context"
 	"encoding/json"
-	"io"
 	"net/http"
 
 	"github.com/control-monkey/controlmonkey-sdk-go/controlmonkey/client"
@@ -50,6 +49,7 @@ type teamUserResponse struct {
 
 func (s *ServiceOp) CreateTeamUser(ctx context.Context, input *TeamUser) (*commons.EmptyResponse, error) {
 	r := client.NewRequest(http.MethodPost, "/iam/org/teamUser")
+	r.Operation = "team.CreateTeamUser"
 	r.Obj = input
 
 	resp, err := client.RequireOK(s.Client.Do(ctx, r))
@@ -63,25 +63,26 @@ func (s *ServiceOp) CreateTeamUser(ctx context.Context, input *TeamUser) (*commo
 }
 
 func (s *ServiceOp) ListTeamUsers(ctx context.Context, teamId string) ([]*TeamUser, error) {
-	r := client.NewRequest(http.MethodGet, "/iam/org/teamUser")
-	r.Params.Set("teamId", teamId)
+	return client.DoItemsWithDecoder(ctx, s.Client, listTeamUsersRequest(teamId), teamUserDecoder(teamId))
+}
 
-	resp, err := client.RequireOK(s.Client.Do(ctx, r))
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
+func (s *ServiceOp) ListTeamUsersPaginator(teamId string, opts *client.PageOptions) client.Pager[TeamUser] {
+	return client.NewPaginator[TeamUser](s.Client, func() *client.Request {
+		return listTeamUsersRequest(teamId)
+	}, opts).WithDecoder(teamUserDecoder(teamId))
+}
 
-	output, err := teamUsersFromHttpResponse(resp, teamId)
-	if err != nil {
-		return nil, err
-	}
+func listTeamUsersRequest(teamId string) *client.Request {
+	r := client.NewRequest(http.MethodGet, "/iam/org/teamUser")
+	r.Operation = "team.ListTeamUsers"
+	r.Params.Set("teamId", teamId)
 
-	return output, nil
+	return r
 }
 
 func (s *ServiceOp) DeleteTeamUser(ctx context.Context, input *TeamUser) (*commons.EmptyResponse, error) {
 	r := client.NewRequest(http.MethodDelete, "/iam/org/teamUser")
+	r.Operation = "team.DeleteTeamUser"
 	r.Obj = input
 
 	resp, err := client.RequireOK(s.Client.Do(ctx, r))
@@ -98,26 +99,12 @@ func (s *ServiceOp) DeleteTeamUser(ctx context.Context, input *TeamUser) (*commo
 
 //region Private Methods
 
-func teamUserFromJSON(in []byte) (*teamUserResponse, error) {
-	b := new(teamUserResponse)
-	if err := json.Unmarshal(in, b); err != nil {
-		return nil, err
-	}
-	return b, nil
-}
-
-func teamUsersFromJSON(in []byte, teamId string) ([]*TeamUser, error) {
-	var rw client.Response
-	if err := json.Unmarshal(in, &rw); err != nil {
-		return nil, err
-	}
-	out := make([]*TeamUser, len(rw.Response.Items))
-	if len(out) == 0 {
-		return out, nil
-	}
-	for i, rb := range rw.Response.Items {
-		b, err := teamUserFromJSON(rb)
-		if err != nil {
+// teamUserDecoder returns a decoder of the users of the team teamId, as the
+// API only returns their email.
+func teamUserDecoder(teamId string) func(json.RawMessage) (*TeamUser, error) {
+	return func(in json.RawMessage) (*TeamUser, error) {
+		b := new(teamUserResponse)
+		if err := json.Unmarshal(in, b); err != nil {
 			return nil, err
 		}
 
@@ -126,17 +113,8 @@ func teamUsersFromJSON(in []byte, teamId string) ([]*TeamUser, error) {
 		user.UserEmail = b.Email
 		user.TeamId = &teamId
 
-		out[i] = user
-	}
-	return out, nil
-}
-
-func teamUsersFromHttpResponse(resp *http.Response, teamId string) ([]*TeamUser, error) {
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return nil, err
+		return user, nil
 	}
-	return teamUsersFromJSON(body, teamId)
 }
 
 //endregion
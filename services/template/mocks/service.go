@@ -0,0 +1,203 @@
+// Code generated by mockgen. DO NOT EDIT.
+
+// Package mocks provides a mock implementation of template.Service.
+package mocks
+
+import (
+	"context"
+
+	"github.com/control-monkey/controlmonkey-sdk-go/controlmonkey/client"
+	"github.com/control-monkey/controlmonkey-sdk-go/controlmonkey/testing/mock"
+	"github.com/control-monkey/controlmonkey-sdk-go/services/commons"
+	"github.com/control-monkey/controlmonkey-sdk-go/services/template"
+)
+
+// Service is a mock implementation of template.Service. Every call is recorded, and
+// answered by the matching Func field if set, or with zero values and
+// mock.ErrNotStubbed otherwise. Use the On methods to stub canned responses.
+type Service struct {
+	mock.Recorder
+
+	CreateTemplateFunc                         func(context.Context, *template.Template) (*template.Template, error)
+	ListTemplatesFunc                          func(context.Context, *string, *string) ([]*template.Template, error)
+	ListTemplatesPaginatorFunc                 func(*string, *string, *client.PageOptions) client.Pager[template.Template]
+	ReadTemplateFunc                           func(context.Context, string) (*template.Template, error)
+	UpdateTemplateFunc                         func(context.Context, string, *template.Template) (*template.Template, error)
+	DeleteTemplateFunc                         func(context.Context, string) (*commons.EmptyResponse, error)
+	ListTemplateNamespaceMappingsFunc          func(context.Context, string) ([]*template.TemplateNamespaceMapping, error)
+	ListTemplateNamespaceMappingsPaginatorFunc func(string, *client.PageOptions) client.Pager[template.TemplateNamespaceMapping]
+	CreateTemplateNamespaceMappingFunc         func(context.Context, *template.TemplateNamespaceMapping) (*template.TemplateNamespaceMapping, error)
+	DeleteTemplateNamespaceMappingFunc         func(context.Context, *template.TemplateNamespaceMapping) (*commons.EmptyResponse, error)
+}
+
+var _ template.Service = &Service{}
+
+// CreateTemplate records the call and calls CreateTemplateFunc.
+func (m *Service) CreateTemplate(ctx context.Context, a0 *template.Template) (*template.Template, error) {
+	m.Record("CreateTemplate", a0)
+	if m.CreateTemplateFunc == nil {
+		return nil, mock.ErrNotStubbed
+	}
+	return m.CreateTemplateFunc(ctx, a0)
+}
+
+// OnCreateTemplate stubs CreateTemplate to return the given values.
+func (m *Service) OnCreateTemplate(r0 *template.Template, r1 error) *Service {
+	m.CreateTemplateFunc = func(context.Context, *template.Template) (*template.Template, error) {
+		return r0, r1
+	}
+	return m
+}
+
+// ListTemplates records the call and calls ListTemplatesFunc.
+func (m *Service) ListTemplates(ctx context.Context, a0 *string, a1 *string) ([]*template.Template, error) {
+	m.Record("ListTemplates", a0, a1)
+	if m.ListTemplatesFunc == nil {
+		return nil, mock.ErrNotStubbed
+	}
+	return m.ListTemplatesFunc(ctx, a0, a1)
+}
+
+// OnListTemplates stubs ListTemplates to return the given values.
+func (m *Service) OnListTemplates(r0 []*template.Template, r1 error) *Service {
+	m.ListTemplatesFunc = func(context.Context, *string, *string) ([]*template.Template, error) {
+		return r0, r1
+	}
+	return m
+}
+
+// ListTemplatesPaginator records the call and calls ListTemplatesPaginatorFunc.
+func (m *Service) ListTemplatesPaginator(a0 *string, a1 *string, a2 *client.PageOptions) client.Pager[template.Template] {
+	m.Record("ListTemplatesPaginator", a0, a1, a2)
+	if m.ListTemplatesPaginatorFunc == nil {
+		return client.NewSlicePager[template.Template](nil, mock.ErrNotStubbed)
+	}
+	return m.ListTemplatesPaginatorFunc(a0, a1, a2)
+}
+
+// OnListTemplatesPaginator stubs ListTemplatesPaginator to return the given values.
+func (m *Service) OnListTemplatesPaginator(r0 client.Pager[template.Template]) *Service {
+	m.ListTemplatesPaginatorFunc = func(*string, *string, *client.PageOptions) client.Pager[template.Template] {
+		return r0
+	}
+	return m
+}
+
+// ReadTemplate records the call and calls ReadTemplateFunc.
+func (m *Service) ReadTemplate(ctx context.Context, a0 string) (*template.Template, error) {
+	m.Record("ReadTemplate", a0)
+	if m.ReadTemplateFunc == nil {
+		return nil, mock.ErrNotStubbed
+	}
+	return m.ReadTemplateFunc(ctx, a0)
+}
+
+// OnReadTemplate stubs ReadTemplate to return the given values.
+func (m *Service) OnReadTemplate(r0 *template.Template, r1 error) *Service {
+	m.ReadTemplateFunc = func(context.Context, string) (*template.Template, error) {
+		return r0, r1
+	}
+	return m
+}
+
+// UpdateTemplate records the call and calls UpdateTemplateFunc.
+func (m *Service) UpdateTemplate(ctx context.Context, a0 string, a1 *template.Template) (*template.Template, error) {
+	m.Record("UpdateTemplate", a0, a1)
+	if m.UpdateTemplateFunc == nil {
+		return nil, mock.ErrNotStubbed
+	}
+	return m.UpdateTemplateFunc(ctx, a0, a1)
+}
+
+// OnUpdateTemplate stubs UpdateTemplate to return the given values.
+func (m *Service) OnUpdateTemplate(r0 *template.Template, r1 error) *Service {
+	m.UpdateTemplateFunc = func(context.Context, string, *template.Template) (*template.Template, error) {
+		return r0, r1
+	}
+	return m
+}
+
+// DeleteTemplate records the call and calls DeleteTemplateFunc.
+func (m *Service) DeleteTemplate(ctx context.Context, a0 string) (*commons.EmptyResponse, error) {
+	m.Record("DeleteTemplate", a0)
+	if m.DeleteTemplateFunc == nil {
+		return nil, mock.ErrNotStubbed
+	}
+	return m.DeleteTemplateFunc(ctx, a0)
+}
+
+// OnDeleteTemplate stubs DeleteTemplate to return the given values.
+func (m *Service) OnDeleteTemplate(r0 *commons.EmptyResponse, r1 error) *Service {
+	m.DeleteTemplateFunc = func(context.Context, string) (*commons.EmptyResponse, error) {
+		return r0, r1
+	}
+	return m
+}
+
+// ListTemplateNamespaceMappings records the call and calls ListTemplateNamespaceMappingsFunc.
+func (m *Service) ListTemplateNamespaceMappings(ctx context.Context, a0 string) ([]*template.TemplateNamespaceMapping, error) {
+	m.Record("ListTemplateNamespaceMappings", a0)
+	if m.ListTemplateNamespaceMappingsFunc == nil {
+		return nil, mock.ErrNotStubbed
+	}
+	return m.ListTemplateNamespaceMappingsFunc(ctx, a0)
+}
+
+// OnListTemplateNamespaceMappings stubs ListTemplateNamespaceMappings to return the given values.
+func (m *Service) OnListTemplateNamespaceMappings(r0 []*template.TemplateNamespaceMapping, r1 error) *Service {
+	m.ListTemplateNamespaceMappingsFunc = func(context.Context, string) ([]*template.TemplateNamespaceMapping, error) {
+		return r0, r1
+	}
+	return m
+}
+
+// ListTemplateNamespaceMappingsPaginator records the call and calls ListTemplateNamespaceMappingsPaginatorFunc.
+func (m *Service) ListTemplateNamespaceMappingsPaginator(a0 string, a1 *client.PageOptions) client.Pager[template.TemplateNamespaceMapping] {
+	m.Record("ListTemplateNamespaceMappingsPaginator", a0, a1)
+	if m.ListTemplateNamespaceMappingsPaginatorFunc == nil {
+		return client.NewSlicePager[template.TemplateNamespaceMapping](nil, mock.ErrNotStubbed)
+	}
+	return m.ListTemplateNamespaceMappingsPaginatorFunc(a0, a1)
+}
+
+// OnListTemplateNamespaceMappingsPaginator stubs ListTemplateNamespaceMappingsPaginator to return the given values.
+func (m *Service) OnListTemplateNamespaceMappingsPaginator(r0 client.Pager[template.TemplateNamespaceMapping]) *Service {
+	m.ListTemplateNamespaceMappingsPaginatorFunc = func(string, *client.PageOptions) client.Pager[template.TemplateNamespaceMapping] {
+		return r0
+	}
+	return m
+}
+
+// CreateTemplateNamespaceMapping records the call and calls CreateTemplateNamespaceMappingFunc.
+func (m *Service) CreateTemplateNamespaceMapping(ctx context.Context, a0 *template.TemplateNamespaceMapping) (*template.TemplateNamespaceMapping, error) {
+	m.Record("CreateTemplateNamespaceMapping", a0)
+	if m.CreateTemplateNamespaceMappingFunc == nil {
+		return nil, mock.ErrNotStubbed
+	}
+	return m.CreateTemplateNamespaceMappingFunc(ctx, a0)
+}
+
+// OnCreateTemplateNamespaceMapping stubs CreateTemplateNamespaceMapping to return the given values.
+func (m *Service) OnCreateTemplateNamespaceMapping(r0 *template.TemplateNamespaceMapping, r1 error) *Service {
+	m.CreateTemplateNamespaceMappingFunc = func(context.Context, *template.TemplateNamespaceMapping) (*template.TemplateNamespaceMapping, error) {
+		return r0, r1
+	}
+	return m
+}
+
+// DeleteTemplateNamespaceMapping records the call and calls DeleteTemplateNamespaceMappingFunc.
+func (m *Service) DeleteTemplateNamespaceMapping(ctx context.Context, a0 *template.TemplateNamespaceMapping) (*commons.EmptyResponse, error) {
+	m.Record("DeleteTemplateNamespaceMapping", a0)
+	if m.DeleteTemplateNamespaceMappingFunc == nil {
+		return nil, mock.ErrNotStubbed
+	}
+	return m.DeleteTemplateNamespaceMappingFunc(ctx, a0)
+}
+
+// OnDeleteTemplateNamespaceMapping stubs DeleteTemplateNamespaceMapping to return the given values.
+func (m *Service) OnDeleteTemplateNamespaceMapping(r0 *commons.EmptyResponse, r1 error) *Service {
+	m.DeleteTemplateNamespaceMappingFunc = func(context.Context, *template.TemplateNamespaceMapping) (*commons.EmptyResponse, error) {
+		return r0, r1
+	}
+	return m
+}
@@ -9,17 +9,21 @@ import (
 	"github.com/control-monkey/controlmonkey-sdk-go/services/commons"
 )
 
+//go:generate go run ../../internal/mockgen
+
 // Service provides the API operation methods for making requests to endpoints
 // of the ControlMonkey API. See this package's package overview docs for details on
 // the service.
 type Service interface {
 	CreateTemplate(context.Context, *Template) (*Template, error)
 	ListTemplates(context.Context, *string, *string) ([]*Template, error)
+	ListTemplatesPaginator(*string, *string, *client.PageOptions) client.Pager[Template]
 	ReadTemplate(context.Context, string) (*Template, error)
 	UpdateTemplate(context.Context, string, *Template) (*Template, error)
 	DeleteTemplate(context.Context, string) (*commons.EmptyResponse, error)
 
 	ListTemplateNamespaceMappings(context.Context, string) ([]*TemplateNamespaceMapping, error)
+	ListTemplateNamespaceMappingsPaginator(string, *client.PageOptions) client.Pager[TemplateNamespaceMapping]
 	CreateTemplateNamespaceMapping(context.Context, *TemplateNamespaceMapping) (*TemplateNamespaceMapping, error)
 	DeleteTemplateNamespaceMapping(context.Context, *TemplateNamespaceMapping) (*commons.EmptyResponse, error)
 }
@@ -36,6 +40,6 @@ func New(sess *session.Session, cfgs ...*controlmonkey.Config) Service {
 	cfg.Merge(cfgs...)
 
 	return &ServiceOp{
-		Client: client.New(sess.Config),
+		Client: client.New(cfg),
 	}
 }
@@ -2,8 +2,6 @@ package template
 
 import (
 	"context"
-	"encoding/json"
-	"io"
 	"net/http"
 
 	"github.com/control-monkey/controlmonkey-sdk-go/services/cross_models"
@@ -89,29 +87,32 @@ type ListTemplatesParams struct {
 
 func (s *ServiceOp) CreateTemplate(ctx context.Context, input *Template) (*Template, error) {
 	r := client.NewRequest(http.MethodPost, "/template")
+	r.Operation = "template.CreateTemplate"
 	r.Obj = input
 
-	resp, err := client.RequireOK(s.Client.Do(ctx, r))
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
+	return client.DoOne[Template](ctx, s.Client, r)
+}
+
+func (s *ServiceOp) ListTemplates(ctx context.Context, templateId *string, templateName *string) ([]*Template, error) {
+	r := listTemplatesRequest(templateId, templateName)
 
-	template, err := templatesFromHttpResponse(resp)
+	templates, err := client.DoItems[Template](ctx, s.Client, r)
 	if err != nil {
 		return nil, err
 	}
 
-	output := new(Template)
-	if len(template) > 0 {
-		output = template[0]
-	}
+	return templates, nil
+}
 
-	return output, nil
+func (s *ServiceOp) ListTemplatesPaginator(templateId *string, templateName *string, opts *client.PageOptions) client.Pager[Template] {
+	return client.NewPaginator[Template](s.Client, func() *client.Request {
+		return listTemplatesRequest(templateId, templateName)
+	}, opts)
 }
 
-func (s *ServiceOp) ListTemplates(ctx context.Context, templateId *string, templateName *string) ([]*Template, error) {
+func listTemplatesRequest(templateId *string, templateName *string) *client.Request {
 	r := client.NewRequest(http.MethodGet, "/template")
+	r.Operation = "template.ListTemplates"
 
 	if templateId != nil {
 		r.Params.Set("templateId", *templateId)
@@ -120,18 +121,7 @@ func (s *ServiceOp) ListTemplates(ctx context.Context, templateId *string, templ
 		r.Params.Set("templateName", *templateName)
 	}
 
-	resp, err := client.RequireOK(s.Client.Do(ctx, r))
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	templates, err := templatesFromHttpResponse(resp)
-	if err != nil {
-		return nil, err
-	}
-
-	return templates, nil
+	return r
 }
 
 func (s *ServiceOp) ReadTemplate(ctx context.Context, templateId string) (*Template, error) {
@@ -143,23 +133,8 @@ func (s *ServiceOp) ReadTemplate(ctx context.Context, templateId string) (*Templ
 	}
 
 	r := client.NewRequest(http.MethodGet, path)
-	resp, err := client.RequireOK(s.Client.Do(ctx, r))
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	template, err := templatesFromHttpResponse(resp)
-	if err != nil {
-		return nil, err
-	}
-
-	output := new(Template)
-	if len(template) > 0 {
-		output = template[0]
-	}
-
-	return output, nil
+	r.Operation = "template.ReadTemplate"
+	return client.DoOne[Template](ctx, s.Client, r)
 }
 
 func (s *ServiceOp) UpdateTemplate(ctx context.Context, templateId string, input *Template) (*Template, error) {
@@ -169,25 +144,10 @@ func (s *ServiceOp) UpdateTemplate(ctx context.Context, templateId string, input
 	}
 
 	r := client.NewRequest(http.MethodPut, path)
+	r.Operation = "template.UpdateTemplate"
 	r.Obj = input
 
-	resp, err := client.RequireOK(s.Client.Do(ctx, r))
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	template, err := templatesFromHttpResponse(resp)
-	if err != nil {
-		return nil, err
-	}
-
-	output := new(Template)
-	if len(template) > 0 {
-		output = template[0]
-	}
-
-	return output, nil
+	return client.DoOne[Template](ctx, s.Client, r)
 }
 
 func (s *ServiceOp) DeleteTemplate(ctx context.Context, templateId string) (*commons.EmptyResponse, error) {
@@ -197,6 +157,7 @@ func (s *ServiceOp) DeleteTemplate(ctx context.Context, templateId string) (*com
 	}
 
 	r := client.NewRequest(http.MethodDelete, path)
+	r.Operation = "template.DeleteTemplate"
 	resp, err := client.RequireOK(s.Client.Do(ctx, r))
 	if err != nil {
 		return nil, err
@@ -209,45 +170,6 @@ func (s *ServiceOp) DeleteTemplate(ctx context.Context, templateId string) (*com
 
 //endregion
 
-//region Private Methods
-
-func templateFromJSON(in []byte) (*Template, error) {
-	b := new(Template)
-	if err := json.Unmarshal(in, b); err != nil {
-		return nil, err
-	}
-	return b, nil
-}
-
-func templatesFromJSON(in []byte) ([]*Template, error) {
-	var rw client.Response
-	if err := json.Unmarshal(in, &rw); err != nil {
-		return nil, err
-	}
-	out := make([]*Template, len(rw.Response.Items))
-	if len(out) == 0 {
-		return out, nil
-	}
-	for i, rb := range rw.Response.Items {
-		b, err := templateFromJSON(rb)
-		if err != nil {
-			return nil, err
-		}
-		out[i] = b
-	}
-	return out, nil
-}
-
-func templatesFromHttpResponse(resp *http.Response) ([]*Template, error) {
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return nil, err
-	}
-	return templatesFromJSON(body)
-}
-
-//endregion
-
 //region Setters
 
 //region Template
@@ -2,8 +2,6 @@ package template
 
 import (
 	"context"
-	"encoding/json"
-	"io"
 	"net/http"
 
 	"github.com/control-monkey/controlmonkey-sdk-go/controlmonkey/client"
@@ -29,47 +27,40 @@ type TemplateNamespaceMapping struct {
 
 func (s *ServiceOp) CreateTemplateNamespaceMapping(ctx context.Context, input *TemplateNamespaceMapping) (*TemplateNamespaceMapping, error) {
 	r := client.NewRequest(http.MethodPost, "/template/templateNamespaceMapping")
+	r.Operation = "template.CreateTemplateNamespaceMapping"
 	r.Obj = input
 
-	resp, err := client.RequireOK(s.Client.Do(ctx, r))
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
+	return client.DoOne[TemplateNamespaceMapping](ctx, s.Client, r)
+}
 
-	templateNamespaceMapping, err := templateNamespaceMappingsFromHttpResponse(resp)
+func (s *ServiceOp) ListTemplateNamespaceMappings(ctx context.Context, templateId string) ([]*TemplateNamespaceMapping, error) {
+	r := listTemplateNamespaceMappingsRequest(templateId)
+
+	output, err := client.DoItems[TemplateNamespaceMapping](ctx, s.Client, r)
 	if err != nil {
 		return nil, err
 	}
 
-	output := new(TemplateNamespaceMapping)
-	if len(templateNamespaceMapping) > 0 {
-		output = templateNamespaceMapping[0]
-	}
-
 	return output, nil
 }
 
-func (s *ServiceOp) ListTemplateNamespaceMappings(ctx context.Context, templateId string) ([]*TemplateNamespaceMapping, error) {
+func (s *ServiceOp) ListTemplateNamespaceMappingsPaginator(templateId string, opts *client.PageOptions) client.Pager[TemplateNamespaceMapping] {
+	return client.NewPaginator[TemplateNamespaceMapping](s.Client, func() *client.Request {
+		return listTemplateNamespaceMappingsRequest(templateId)
+	}, opts)
+}
+
+func listTemplateNamespaceMappingsRequest(templateId string) *client.Request {
 	r := client.NewRequest(http.MethodGet, "/template/templateNamespaceMapping")
+	r.Operation = "template.ListTemplateNamespaceMappings"
 	r.Params.Set("templateId", templateId)
 
-	resp, err := client.RequireOK(s.Client.Do(ctx, r))
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	output, err := templateNamespaceMappingsFromHttpResponse(resp)
-	if err != nil {
-		return nil, err
-	}
-
-	return output, nil
+	return r
 }
 
 func (s *ServiceOp) DeleteTemplateNamespaceMapping(ctx context.Context, input *TemplateNamespaceMapping) (*commons.EmptyResponse, error) {
 	r := client.NewRequest(http.MethodDelete, "/template/templateNamespaceMapping")
+	r.Operation = "template.DeleteTemplateNamespaceMapping"
 	r.Obj = input
 
 	resp, err := client.RequireOK(s.Client.Do(ctx, r))
@@ -84,45 +75,6 @@ func (s *ServiceOp) DeleteTemplateNamespaceMapping(ctx context.Context, input *T
 
 //endregion
 
-//region Private Methods
-
-func templateNamespaceMappingFromJSON(in []byte) (*TemplateNamespaceMapping, error) {
-	b := new(TemplateNamespaceMapping)
-	if err := json.Unmarshal(in, b); err != nil {
-		return nil, err
-	}
-	return b, nil
-}
-
-func templateNamespaceMappingsFromJSON(in []byte) ([]*TemplateNamespaceMapping, error) {
-	var rw client.Response
-	if err := json.Unmarshal(in, &rw); err != nil {
-		return nil, err
-	}
-	out := make([]*TemplateNamespaceMapping, len(rw.Response.Items))
-	if len(out) == 0 {
-		return out, nil
-	}
-	for i, rb := range rw.Response.Items {
-		b, err := templateNamespaceMappingFromJSON(rb)
-		if err != nil {
-			return nil, err
-		}
-		out[i] = b
-	}
-	return out, nil
-}
-
-func templateNamespaceMappingsFromHttpResponse(resp *http.Response) ([]*TemplateNamespaceMapping, error) {
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return nil, err
-	}
-	return templateNamespaceMappingsFromJSON(body)
-}
-
-//endregion
-
 //region Setters
 
 func (o TemplateNamespaceMapping) MarshalJSON() ([]byte, error) {
@@ -0,0 +1,131 @@
+// Code generated by mockgen. DO NOT EDIT.
+
+// Package mocks provides a mock implementation of variable.Service.
+package mocks
+
+import (
+	"context"
+
+	"github.com/control-monkey/controlmonkey-sdk-go/controlmonkey/client"
+	"github.com/control-monkey/controlmonkey-sdk-go/controlmonkey/testing/mock"
+	"github.com/control-monkey/controlmonkey-sdk-go/services/commons"
+	"github.com/control-monkey/controlmonkey-sdk-go/services/variable"
+)
+
+// Service is a mock implementation of variable.Service. Every call is recorded, and
+// answered by the matching Func field if set, or with zero values and
+// mock.ErrNotStubbed otherwise. Use the On methods to stub canned responses.
+type Service struct {
+	mock.Recorder
+
+	ListVariablesFunc          func(context.Context, *variable.ListVariablesInput) (*variable.ListVariablesOutput, error)
+	ListVariablesPaginatorFunc func(*variable.ListVariablesInput, *client.PageOptions) client.Pager[variable.Variable]
+	CreateVariableFunc         func(context.Context, *variable.Variable) (*variable.CreateVariableOutput, error)
+	ReadVariableFunc           func(context.Context, *variable.ReadVariableInput) (*variable.ReadVariableOutput, error)
+	UpdateVariableFunc         func(context.Context, *string, *variable.Variable) (*variable.UpdateVariableOutput, error)
+	DeleteVariableFunc         func(context.Context, *variable.DeleteVariableInput) (*commons.EmptyResponse, error)
+}
+
+var _ variable.Service = &Service{}
+
+// ListVariables records the call and calls ListVariablesFunc.
+func (m *Service) ListVariables(ctx context.Context, a0 *variable.ListVariablesInput) (*variable.ListVariablesOutput, error) {
+	m.Record("ListVariables", a0)
+	if m.ListVariablesFunc == nil {
+		return nil, mock.ErrNotStubbed
+	}
+	return m.ListVariablesFunc(ctx, a0)
+}
+
+// OnListVariables stubs ListVariables to return the given values.
+func (m *Service) OnListVariables(r0 *variable.ListVariablesOutput, r1 error) *Service {
+	m.ListVariablesFunc = func(context.Context, *variable.ListVariablesInput) (*variable.ListVariablesOutput, error) {
+		return r0, r1
+	}
+	return m
+}
+
+// ListVariablesPaginator records the call and calls ListVariablesPaginatorFunc.
+func (m *Service) ListVariablesPaginator(a0 *variable.ListVariablesInput, a1 *client.PageOptions) client.Pager[variable.Variable] {
+	m.Record("ListVariablesPaginator", a0, a1)
+	if m.ListVariablesPaginatorFunc == nil {
+		return client.NewSlicePager[variable.Variable](nil, mock.ErrNotStubbed)
+	}
+	return m.ListVariablesPaginatorFunc(a0, a1)
+}
+
+// OnListVariablesPaginator stubs ListVariablesPaginator to return the given values.
+func (m *Service) OnListVariablesPaginator(r0 client.Pager[variable.Variable]) *Service {
+	m.ListVariablesPaginatorFunc = func(*variable.ListVariablesInput, *client.PageOptions) client.Pager[variable.Variable] {
+		return r0
+	}
+	return m
+}
+
+// CreateVariable records the call and calls CreateVariableFunc.
+func (m *Service) CreateVariable(ctx context.Context, a0 *variable.Variable) (*variable.CreateVariableOutput, error) {
+	m.Record("CreateVariable", a0)
+	if m.CreateVariableFunc == nil {
+		return nil, mock.ErrNotStubbed
+	}
+	return m.CreateVariableFunc(ctx, a0)
+}
+
+// OnCreateVariable stubs CreateVariable to return the given values.
+func (m *Service) OnCreateVariable(r0 *variable.CreateVariableOutput, r1 error) *Service {
+	m.CreateVariableFunc = func(context.Context, *variable.Variable) (*variable.CreateVariableOutput, error) {
+		return r0, r1
+	}
+	return m
+}
+
+// ReadVariable records the call and calls ReadVariableFunc.
+func (m *Service) ReadVariable(ctx context.Context, a0 *variable.ReadVariableInput) (*variable.ReadVariableOutput, error) {
+	m.Record("ReadVariable", a0)
+	if m.ReadVariableFunc == nil {
+		return nil, mock.ErrNotStubbed
+	}
+	return m.ReadVariableFunc(ctx, a0)
+}
+
+// OnReadVariable stubs ReadVariable to return the given values.
+func (m *Service) OnReadVariable(r0 *variable.ReadVariableOutput, r1 error) *Service {
+	m.ReadVariableFunc = func(context.Context, *variable.ReadVariableInput) (*variable.ReadVariableOutput, error) {
+		return r0, r1
+	}
+	return m
+}
+
+// UpdateVariable records the call and calls UpdateVariableFunc.
+func (m *Service) UpdateVariable(ctx context.Context, a0 *string, a1 *variable.Variable) (*variable.UpdateVariableOutput, error) {
+	m.Record("UpdateVariable", a0, a1)
+	if m.UpdateVariableFunc == nil {
+		return nil, mock.ErrNotStubbed
+	}
+	return m.UpdateVariableFunc(ctx, a0, a1)
+}
+
+// OnUpdateVariable stubs UpdateVariable to return the given values.
+func (m *Service) OnUpdateVariable(r0 *variable.UpdateVariableOutput, r1 error) *Service {
+	m.UpdateVariableFunc = func(context.Context, *string, *variable.Variable) (*variable.UpdateVariableOutput, error) {
+		return r0, r1
+	}
+	return m
+}
+
+// DeleteVariable records the call and calls DeleteVariableFunc.
+func (m *Service) DeleteVariable(ctx context.Context, a0 *variable.DeleteVariableInput) (*commons.EmptyResponse, error) {
+	m.Record("DeleteVariable", a0)
+	if m.DeleteVariableFunc == nil {
+		return nil, mock.ErrNotStubbed
+	}
+	return m.DeleteVariableFunc(ctx, a0)
+}
+
+// OnDeleteVariable stubs DeleteVariable to return the given values.
+func (m *Service) OnDeleteVariable(r0 *commons.EmptyResponse, r1 error) *Service {
+	m.DeleteVariableFunc = func(context.Context, *variable.DeleteVariableInput) (*commons.EmptyResponse, error) {
+		return r0, r1
+	}
+	return m
+}
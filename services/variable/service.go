@@ -9,11 +9,14 @@ import (
 	"github.com/control-monkey/controlmonkey-sdk-go/services/commons"
 )
 
+//go:generate go run ../../internal/mockgen
+
 // Service provides the API operation methods for making requests to endpoints
 // of the ControlMonkey API. See this package's package overview docs for details on
 // the service.
 type Service interface {
 	ListVariables(context.Context, *ListVariablesInput) (*ListVariablesOutput, error)
+	ListVariablesPaginator(*ListVariablesInput, *client.PageOptions) client.Pager[Variable]
 	CreateVariable(context.Context, *Variable) (*CreateVariableOutput, error)
 	ReadVariable(context.Context, *ReadVariableInput) (*ReadVariableOutput, error)
 	UpdateVariable(context.Context, *string, *Variable) (*UpdateVariableOutput, error)
@@ -32,6 +35,6 @@ func New(sess *session.Session, cfgs ...*controlmonkey.Config) Service {
 	cfg.Merge(cfgs...)
 
 	return &ServiceOp{
-		Client: client.New(sess.Config),
+		Client: client.New(cfg),
 	}
 }
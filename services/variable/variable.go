@@ -2,8 +2,6 @@ package variable
 
 import (
 	"context"
-	"encoding/json"
-	"io"
 	"net/http"
 	"strconv"
 
@@ -95,7 +93,25 @@ type DeleteVariableOutput struct{}
 //region Methods
 
 func (s *ServiceOp) ListVariables(ctx context.Context, input *ListVariablesInput) (*ListVariablesOutput, error) {
+	r := listVariablesRequest(input)
+
+	variables, err := client.DoItems[Variable](ctx, s.Client, r)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ListVariablesOutput{Variables: variables}, nil
+}
+
+func (s *ServiceOp) ListVariablesPaginator(input *ListVariablesInput, opts *client.PageOptions) client.Pager[Variable] {
+	return client.NewPaginator[Variable](s.Client, func() *client.Request {
+		return listVariablesRequest(input)
+	}, opts)
+}
+
+func listVariablesRequest(input *ListVariablesInput) *client.Request {
 	r := client.NewRequest(http.MethodGet, "/variable")
+	r.Operation = "variable.ListVariables"
 
 	if input.StackId != nil {
 		r.Params.Set("stackId", controlmonkey.StringValue(input.StackId))
@@ -113,41 +129,20 @@ func (s *ServiceOp) ListVariables(ctx context.Context, input *ListVariablesInput
 		r.Params.Set("orgOnly", strconv.FormatBool(controlmonkey.BoolValue(input.OrgOnly)))
 	}
 
-	resp, err := client.RequireOK(s.Client.Do(ctx, r))
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	variables, err := variablesFromHttpResponse(resp)
-	if err != nil {
-		return nil, err
-	}
-
-	return &ListVariablesOutput{Variables: variables}, nil
+	return r
 }
 
 func (s *ServiceOp) CreateVariable(ctx context.Context, input *Variable) (*CreateVariableOutput, error) {
 	r := client.NewRequest(http.MethodPost, "/variable")
+	r.Operation = "variable.CreateVariable"
 	r.Obj = input
 
-	resp, err := client.RequireOK(s.Client.Do(ctx, r))
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	variable, err := variablesFromHttpResponse(resp)
+	variable, err := client.DoOne[Variable](ctx, s.Client, r)
 	if err != nil {
 		return nil, err
 	}
 
-	output := new(CreateVariableOutput)
-	if len(variable) > 0 {
-		output.Variable = variable[0]
-	}
-
-	return output, nil
+	return &CreateVariableOutput{Variable: variable}, nil
 }
 
 func (s *ServiceOp) ReadVariable(ctx context.Context, input *ReadVariableInput) (*ReadVariableOutput, error) {
@@ -159,23 +154,13 @@ func (s *ServiceOp) ReadVariable(ctx context.Context, input *ReadVariableInput)
 	}
 
 	r := client.NewRequest(http.MethodGet, path)
-	resp, err := client.RequireOK(s.Client.Do(ctx, r))
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	variable, err := variablesFromHttpResponse(resp)
+	r.Operation = "variable.ReadVariable"
+	variable, err := client.DoOne[Variable](ctx, s.Client, r)
 	if err != nil {
 		return nil, err
 	}
 
-	output := new(ReadVariableOutput)
-	if len(variable) > 0 {
-		output.Variable = variable[0]
-	}
-
-	return output, nil
+	return &ReadVariableOutput{Variable: variable}, nil
 }
 
 func (s *ServiceOp) UpdateVariable(ctx context.Context, variableId *string, input *Variable) (*UpdateVariableOutput, error) {
@@ -187,26 +172,16 @@ func (s *ServiceOp) UpdateVariable(ctx context.Context, variableId *string, inpu
 	}
 
 	r := client.NewRequest(http.MethodPut, path)
+	r.Operation = "variable.UpdateVariable"
 
 	r.Obj = input
 
-	resp, err := client.RequireOK(s.Client.Do(ctx, r))
+	variable, err := client.DoOne[Variable](ctx, s.Client, r)
 	if err != nil {
 		return nil, err
 	}
-	defer resp.Body.Close()
 
-	variable, err := variablesFromHttpResponse(resp)
-	if err != nil {
-		return nil, err
-	}
-
-	output := new(UpdateVariableOutput)
-	if len(variable) > 0 {
-		output.Variable = variable[0]
-	}
-
-	return output, nil
+	return &UpdateVariableOutput{Variable: variable}, nil
 }
 
 func (s *ServiceOp) DeleteVariable(ctx context.Context, input *DeleteVariableInput) (*commons.EmptyResponse, error) {
@@ -218,6 +193,7 @@ func (s *ServiceOp) DeleteVariable(ctx context.Context, input *DeleteVariableInp
 	}
 
 	r := client.NewRequest(http.MethodDelete, path)
+	r.Operation = "variable.DeleteVariable"
 	resp, err := client.RequireOK(s.Client.Do(ctx, r))
 	if err != nil {
 		return nil, err
@@ -230,45 +206,6 @@ func (s *ServiceOp) DeleteVariable(ctx context.Context, input *DeleteVariableInp
 
 //endregion
 
-//region Private Methods
-
-func variableFromJSON(in []byte) (*Variable, error) {
-	b := new(Variable)
-	if err := json.Unmarshal(in, b); err != nil {
-		return nil, err
-	}
-	return b, nil
-}
-
-func variablesFromJSON(in []byte) ([]*Variable, error) {
-	var rw client.Response
-	if err := json.Unmarshal(in, &rw); err != nil {
-		return nil, err
-	}
-	out := make([]*Variable, len(rw.Response.Items))
-	if len(out) == 0 {
-		return out, nil
-	}
-	for i, rb := range rw.Response.Items {
-		b, err := variableFromJSON(rb)
-		if err != nil {
-			return nil, err
-		}
-		out[i] = b
-	}
-	return out, nil
-}
-
-func variablesFromHttpResponse(resp *http.Response) ([]*Variable, error) {
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return nil, err
-	}
-	return variablesFromJSON(body)
-}
-
-//endregion
-
 //region Setters
 
 func (o Variable) MarshalJSON() ([]byte, error) {